@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_admissionregistration_k8s_io_mutating_webhook_configuration_v1_list.example.items
+}
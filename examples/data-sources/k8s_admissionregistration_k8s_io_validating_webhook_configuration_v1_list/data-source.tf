@@ -0,0 +1,3 @@
+data "k8s_admissionregistration_k8s_io_validating_webhook_configuration_v1_list" "example" {
+  label_selector = "app=example"
+}
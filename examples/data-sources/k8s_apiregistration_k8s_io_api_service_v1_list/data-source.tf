@@ -0,0 +1,3 @@
+data "k8s_apiregistration_k8s_io_api_service_v1_list" "example" {
+  label_selector = "app=example"
+}
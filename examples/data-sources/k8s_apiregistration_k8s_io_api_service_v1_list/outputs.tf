@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_apiregistration_k8s_io_api_service_v1_list.example.items
+}
@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_apps_daemon_set_v1_list.example.items
+}
@@ -0,0 +1,4 @@
+data "k8s_apps_deployment_v1_list" "example" {
+  namespace      = "some-namespace"
+  label_selector = "app=example"
+}
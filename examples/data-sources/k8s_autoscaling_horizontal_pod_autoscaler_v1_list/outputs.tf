@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_autoscaling_horizontal_pod_autoscaler_v1_list.example.items
+}
@@ -0,0 +1,4 @@
+data "k8s_autoscaling_horizontal_pod_autoscaler_v2_list" "example" {
+  namespace      = "some-namespace"
+  label_selector = "app=example"
+}
@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_autoscaling_horizontal_pod_autoscaler_v2_list.example.items
+}
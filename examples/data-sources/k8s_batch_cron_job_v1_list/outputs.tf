@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_batch_cron_job_v1_list.example.items
+}
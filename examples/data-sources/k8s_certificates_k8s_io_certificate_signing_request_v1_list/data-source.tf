@@ -0,0 +1,3 @@
+data "k8s_certificates_k8s_io_certificate_signing_request_v1_list" "example" {
+  label_selector = "app=example"
+}
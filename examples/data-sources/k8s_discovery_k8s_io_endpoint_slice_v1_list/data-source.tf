@@ -0,0 +1,4 @@
+data "k8s_discovery_k8s_io_endpoint_slice_v1_list" "example" {
+  namespace      = "some-namespace"
+  label_selector = "app=example"
+}
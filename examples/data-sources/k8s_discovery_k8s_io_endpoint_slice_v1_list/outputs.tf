@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_discovery_k8s_io_endpoint_slice_v1_list.example.items
+}
@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_endpoints_v1_list.example.items
+}
@@ -0,0 +1,4 @@
+data "k8s_events_k8s_io_event_v1_list" "example" {
+  namespace      = "some-namespace"
+  label_selector = "app=example"
+}
@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_events_k8s_io_event_v1_list.example.items
+}
@@ -0,0 +1,3 @@
+data "k8s_flowcontrol_apiserver_k8s_io_priority_level_configuration_v1beta3_list" "example" {
+  label_selector = "app=example"
+}
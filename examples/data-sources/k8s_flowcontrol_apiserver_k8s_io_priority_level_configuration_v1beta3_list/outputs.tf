@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_flowcontrol_apiserver_k8s_io_priority_level_configuration_v1beta3_list.example.items
+}
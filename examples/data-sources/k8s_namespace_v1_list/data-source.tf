@@ -0,0 +1,3 @@
+data "k8s_namespace_v1_list" "example" {
+  label_selector = "app=example"
+}
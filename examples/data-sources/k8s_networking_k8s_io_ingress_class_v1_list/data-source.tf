@@ -0,0 +1,3 @@
+data "k8s_networking_k8s_io_ingress_class_v1_list" "example" {
+  label_selector = "app=example"
+}
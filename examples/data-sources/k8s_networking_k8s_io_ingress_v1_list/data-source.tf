@@ -0,0 +1,4 @@
+data "k8s_networking_k8s_io_ingress_v1_list" "example" {
+  namespace      = "some-namespace"
+  label_selector = "app=example"
+}
@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_networking_k8s_io_network_policy_v1_list.example.items
+}
@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_persistent_volume_claim_v1_list.example.items
+}
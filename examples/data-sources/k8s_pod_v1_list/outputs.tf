@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_pod_v1_list.example.items
+}
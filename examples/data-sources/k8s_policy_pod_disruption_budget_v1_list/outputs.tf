@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_policy_pod_disruption_budget_v1_list.example.items
+}
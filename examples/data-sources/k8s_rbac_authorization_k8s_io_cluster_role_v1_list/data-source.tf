@@ -0,0 +1,3 @@
+data "k8s_rbac_authorization_k8s_io_cluster_role_v1_list" "example" {
+  label_selector = "app=example"
+}
@@ -0,0 +1,4 @@
+data "k8s_rbac_authorization_k8s_io_role_v1_list" "example" {
+  namespace      = "some-namespace"
+  label_selector = "app=example"
+}
@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_rbac_authorization_k8s_io_role_v1_list.example.items
+}
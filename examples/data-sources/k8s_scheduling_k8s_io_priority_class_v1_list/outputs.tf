@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_scheduling_k8s_io_priority_class_v1_list.example.items
+}
@@ -0,0 +1,3 @@
+data "k8s_storage_k8s_io_storage_class_v1_list" "example" {
+  label_selector = "app=example"
+}
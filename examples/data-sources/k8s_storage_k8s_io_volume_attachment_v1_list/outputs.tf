@@ -0,0 +1,3 @@
+output "items" {
+  value = data.k8s_storage_k8s_io_volume_attachment_v1_list.example.items
+}
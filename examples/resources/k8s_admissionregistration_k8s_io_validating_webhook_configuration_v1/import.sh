@@ -0,0 +1,10 @@
+# k8s_admissionregistration_k8s_io_validating_webhook_configuration_v1 resources can be imported by specifying
+# the name of the resource.
+terraform import k8s_admissionregistration_k8s_io_validating_webhook_configuration_v1.your_name 'name'
+
+# The identifier can be prefixed with the kubeconfig context the provider
+# is configured for as well as the apiVersion and kind of the resource.
+terraform import k8s_admissionregistration_k8s_io_validating_webhook_configuration_v1.your_name 'context:admissionregistration.k8s.io/v1/ValidatingWebhookConfiguration/name'
+
+# Alternatively, resources can be imported by their UID.
+terraform import k8s_admissionregistration_k8s_io_validating_webhook_configuration_v1.your_name 'uid=<uid>'
@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_admissionregistration_k8s_io_validating_webhook_configuration_v1.example
+}
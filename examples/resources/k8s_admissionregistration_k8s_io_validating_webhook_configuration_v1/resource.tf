@@ -0,0 +1,6 @@
+resource "k8s_admissionregistration_k8s_io_validating_webhook_configuration_v1" "example" {
+  metadata = {
+    name = "some-name"
+    
+  }
+}
@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_apiregistration_k8s_io_api_service_v1.example
+}
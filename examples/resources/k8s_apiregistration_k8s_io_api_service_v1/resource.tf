@@ -0,0 +1,6 @@
+resource "k8s_apiregistration_k8s_io_api_service_v1" "example" {
+  metadata = {
+    name = "some-name"
+    
+  }
+}
@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_apps_deployment_v1.example
+}
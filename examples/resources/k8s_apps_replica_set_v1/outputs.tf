@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_apps_replica_set_v1.example
+}
@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_autoscaling_horizontal_pod_autoscaler_v1.example
+}
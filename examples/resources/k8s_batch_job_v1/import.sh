@@ -0,0 +1,10 @@
+# k8s_batch_job_v1 resources can be imported by specifying
+# the namespace and name of the resource.
+terraform import k8s_batch_job_v1.your_name 'namespace/name'
+
+# The identifier can be prefixed with the kubeconfig context the provider
+# is configured for as well as the apiVersion and kind of the resource.
+terraform import k8s_batch_job_v1.your_name 'context:batch/v1/Job/namespace/name'
+
+# Alternatively, resources can be imported by their UID.
+terraform import k8s_batch_job_v1.your_name 'uid=<uid>'
@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_batch_job_v1.example
+}
@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_certificates_k8s_io_certificate_signing_request_v1.example
+}
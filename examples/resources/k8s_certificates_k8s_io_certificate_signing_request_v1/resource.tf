@@ -0,0 +1,6 @@
+resource "k8s_certificates_k8s_io_certificate_signing_request_v1" "example" {
+  metadata = {
+    name = "some-name"
+    
+  }
+}
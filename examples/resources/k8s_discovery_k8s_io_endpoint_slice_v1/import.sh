@@ -0,0 +1,10 @@
+# k8s_discovery_k8s_io_endpoint_slice_v1 resources can be imported by specifying
+# the namespace and name of the resource.
+terraform import k8s_discovery_k8s_io_endpoint_slice_v1.your_name 'namespace/name'
+
+# The identifier can be prefixed with the kubeconfig context the provider
+# is configured for as well as the apiVersion and kind of the resource.
+terraform import k8s_discovery_k8s_io_endpoint_slice_v1.your_name 'context:discovery.k8s.io/v1/EndpointSlice/namespace/name'
+
+# Alternatively, resources can be imported by their UID.
+terraform import k8s_discovery_k8s_io_endpoint_slice_v1.your_name 'uid=<uid>'
@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_discovery_k8s_io_endpoint_slice_v1.example
+}
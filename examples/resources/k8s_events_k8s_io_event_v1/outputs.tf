@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_events_k8s_io_event_v1.example
+}
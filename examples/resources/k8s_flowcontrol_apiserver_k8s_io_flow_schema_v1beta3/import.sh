@@ -0,0 +1,10 @@
+# k8s_flowcontrol_apiserver_k8s_io_flow_schema_v1beta3 resources can be imported by specifying
+# the name of the resource.
+terraform import k8s_flowcontrol_apiserver_k8s_io_flow_schema_v1beta3.your_name 'name'
+
+# The identifier can be prefixed with the kubeconfig context the provider
+# is configured for as well as the apiVersion and kind of the resource.
+terraform import k8s_flowcontrol_apiserver_k8s_io_flow_schema_v1beta3.your_name 'context:flowcontrol.apiserver.k8s.io/v1beta3/FlowSchema/name'
+
+# Alternatively, resources can be imported by their UID.
+terraform import k8s_flowcontrol_apiserver_k8s_io_flow_schema_v1beta3.your_name 'uid=<uid>'
@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_flowcontrol_apiserver_k8s_io_flow_schema_v1beta3.example
+}
@@ -0,0 +1,6 @@
+resource "k8s_flowcontrol_apiserver_k8s_io_priority_level_configuration_v1beta3" "example" {
+  metadata = {
+    name = "some-name"
+    
+  }
+}
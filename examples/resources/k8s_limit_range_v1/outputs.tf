@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_limit_range_v1.example
+}
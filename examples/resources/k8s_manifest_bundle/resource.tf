@@ -0,0 +1,17 @@
+resource "k8s_manifest_bundle" "example" {
+  content = <<-EOT
+    apiVersion: v1
+    kind: Namespace
+    metadata:
+      name: some-namespace
+    ---
+    apiVersion: v1
+    kind: ConfigMap
+    metadata:
+      name: some-name
+    data:
+      key: value
+  EOT
+  namespace      = "some-namespace"
+  wait_for_ready = true
+}
@@ -0,0 +1,6 @@
+resource "k8s_namespace_v1" "example" {
+  metadata = {
+    name = "some-name"
+    
+  }
+}
@@ -0,0 +1,10 @@
+# k8s_networking_k8s_io_ingress_class_v1 resources can be imported by specifying
+# the name of the resource.
+terraform import k8s_networking_k8s_io_ingress_class_v1.your_name 'name'
+
+# The identifier can be prefixed with the kubeconfig context the provider
+# is configured for as well as the apiVersion and kind of the resource.
+terraform import k8s_networking_k8s_io_ingress_class_v1.your_name 'context:networking.k8s.io/v1/IngressClass/name'
+
+# Alternatively, resources can be imported by their UID.
+terraform import k8s_networking_k8s_io_ingress_class_v1.your_name 'uid=<uid>'
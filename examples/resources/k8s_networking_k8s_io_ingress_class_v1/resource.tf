@@ -0,0 +1,6 @@
+resource "k8s_networking_k8s_io_ingress_class_v1" "example" {
+  metadata = {
+    name = "some-name"
+    
+  }
+}
@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_networking_k8s_io_network_policy_v1.example
+}
@@ -0,0 +1,10 @@
+# k8s_object resources can be imported by specifying the apiVersion, kind,
+# namespace and name of the object.
+terraform import k8s_object.your_name 'apiVersion/kind/namespace/name'
+
+# Cluster-scoped objects omit the namespace.
+terraform import k8s_object.your_name 'apiVersion/kind/name'
+
+# The identifier can be prefixed with the kubeconfig context the provider
+# is configured for.
+terraform import k8s_object.your_name 'context:cert-manager.io/v1/Certificate/namespace/name'
@@ -0,0 +1,17 @@
+resource "k8s_object" "example" {
+  api_version = "cert-manager.io/v1"
+  kind        = "Certificate"
+  manifest = jsonencode({
+    metadata = {
+      name      = "some-name"
+      namespace = "some-namespace"
+    }
+    spec = {
+      secretName = "some-secret"
+      dnsNames   = ["example.com"]
+      issuerRef = {
+        name = "some-issuer"
+      }
+    }
+  })
+}
@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_persistent_volume_claim_v1.example
+}
@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_pod_v1.example
+}
@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_policy_pod_disruption_budget_v1.example
+}
@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_rbac_authorization_k8s_io_role_binding_v1.example
+}
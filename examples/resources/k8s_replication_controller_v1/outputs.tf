@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_replication_controller_v1.example
+}
@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_scheduling_k8s_io_priority_class_v1.example
+}
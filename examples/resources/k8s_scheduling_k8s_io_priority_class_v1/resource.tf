@@ -0,0 +1,6 @@
+resource "k8s_scheduling_k8s_io_priority_class_v1" "example" {
+  metadata = {
+    name = "some-name"
+    
+  }
+}
@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_secret_v1.example
+}
@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_storage_k8s_io_csi_driver_v1.example
+}
@@ -0,0 +1,6 @@
+resource "k8s_storage_k8s_io_csi_driver_v1" "example" {
+  metadata = {
+    name = "some-name"
+    
+  }
+}
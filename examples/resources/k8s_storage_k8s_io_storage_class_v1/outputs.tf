@@ -0,0 +1,3 @@
+output "resource" {
+  value = k8s_storage_k8s_io_storage_class_v1.example
+}
@@ -0,0 +1,54 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package naming
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	matchFirstCap = regexp.MustCompile("(.)([A-Z][a-z]+)")
+	matchAllCap   = regexp.MustCompile("([a-z0-9])([A-Z])")
+	matchDashes   = regexp.MustCompile("-")
+	matchDots     = regexp.MustCompile(`\.`)
+	matchSlashes  = regexp.MustCompile("/")
+	matchColons   = regexp.MustCompile(":")
+)
+
+// TerraformAttributeName returns the name of the Terraform attribute generated for the given Kubernetes field name.
+// Both the generator and the translation of Kubernetes field paths in error messages rely on these rules, thus any
+// change here affects the schema of all generated resources.
+func TerraformAttributeName(fieldName string, rootPath bool) string {
+	clean := fieldName
+	if rootPath && clean == "provisioner" {
+		clean = "k8s_provisioner"
+	}
+	if strings.HasPrefix(clean, "-") {
+		clean = strings.Replace(clean, "-", "", 1)
+	}
+	if strings.HasPrefix(clean, "3") {
+		clean = strings.Replace(clean, "3", "Three", 1)
+	}
+	if strings.HasPrefix(clean, "$") {
+		clean = strings.Replace(clean, "$", "Dollar", 1)
+	}
+	clean = strings.ReplaceAll(clean, "URL", "Url")
+	clean = strings.ReplaceAll(clean, "CIDR", "Cidr")
+	return ToSnakeCase(clean)
+}
+
+// ToSnakeCase converts camel case strings like 'minReadySeconds' into snake case like 'min_ready_seconds'. Dashes,
+// dots, slashes, and colons are replaced with underscores as well.
+func ToSnakeCase(str string) string {
+	snake := matchFirstCap.ReplaceAllString(str, "${1}_${2}")
+	snake = matchAllCap.ReplaceAllString(snake, "${1}_${2}")
+	snake = matchDashes.ReplaceAllString(snake, "_")
+	snake = matchDots.ReplaceAllString(snake, "_")
+	snake = matchSlashes.ReplaceAllString(snake, "_")
+	snake = matchColons.ReplaceAllString(snake, "_")
+	return strings.ToLower(snake)
+}
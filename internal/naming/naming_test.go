@@ -0,0 +1,100 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package naming
+
+import (
+	"testing"
+)
+
+func TestTerraformAttributeName(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		fieldName string
+		rootPath  bool
+		expected  string
+	}
+	tests := map[string]testCase{
+		"lower case": {
+			fieldName: "replicas",
+			expected:  "replicas",
+		},
+		"camel case": {
+			fieldName: "minReadySeconds",
+			expected:  "min_ready_seconds",
+		},
+		"url": {
+			fieldName: "caBundleURL",
+			expected:  "ca_bundle_url",
+		},
+		"cidr": {
+			fieldName: "podCIDRs",
+			expected:  "pod_cidrs",
+		},
+		"root provisioner": {
+			fieldName: "provisioner",
+			rootPath:  true,
+			expected:  "k8s_provisioner",
+		},
+		"nested provisioner": {
+			fieldName: "provisioner",
+			expected:  "provisioner",
+		},
+		"leading dash": {
+			fieldName: "-someField",
+			expected:  "some_field",
+		},
+		"leading three": {
+			fieldName: "3rdParty",
+			expected:  "threerd_party",
+		},
+		"leading dollar": {
+			fieldName: "$ref",
+			expected:  "dollarref",
+		},
+		"dashes and dots": {
+			fieldName: "x-kubernetes.io",
+			expected:  "x_kubernetes_io",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := TerraformAttributeName(test.fieldName, test.rootPath); got != test.expected {
+				t.Errorf("expected %q but got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestToSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		str      string
+		expected string
+	}
+	tests := map[string]testCase{
+		"camel case": {
+			str:      "StatefulSet",
+			expected: "stateful_set",
+		},
+		"group": {
+			str:      "cert-manager.io",
+			expected: "cert_manager_io",
+		},
+		"slashes and colons": {
+			str:      "a/b:c",
+			expected: "a_b_c",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := ToSnakeCase(test.str); got != test.expected {
+				t.Errorf("expected %q but got %q", test.expected, got)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -37,10 +38,20 @@ type ApplicationNetworkingK8SAwsIamauthPolicyV1Alpha1ManifestData struct {
 	Kind       *string `tfsdk:"-" json:"kind"`
 
 	Metadata struct {
-		Name        string            `tfsdk:"name" json:"name"`
-		Namespace   string            `tfsdk:"namespace" json:"namespace"`
-		Labels      map[string]string `tfsdk:"labels" json:"labels,omitempty"`
-		Annotations map[string]string `tfsdk:"annotations" json:"annotations,omitempty"`
+		Name            *string           `tfsdk:"name" json:"name,omitempty"`
+		GenerateName    *string           `tfsdk:"generate_name" json:"generateName,omitempty"`
+		Namespace       string            `tfsdk:"namespace" json:"namespace"`
+		Labels          map[string]string `tfsdk:"labels" json:"labels,omitempty"`
+		Annotations     map[string]string `tfsdk:"annotations" json:"annotations,omitempty"`
+		Finalizers      []string          `tfsdk:"finalizers" json:"finalizers,omitempty"`
+		OwnerReferences []struct {
+			ApiVersion         string `tfsdk:"api_version" json:"apiVersion"`
+			Kind               string `tfsdk:"kind" json:"kind"`
+			Name               string `tfsdk:"name" json:"name"`
+			Uid                string `tfsdk:"uid" json:"uid"`
+			Controller         *bool  `tfsdk:"controller" json:"controller,omitempty"`
+			BlockOwnerDeletion *bool  `tfsdk:"block_owner_deletion" json:"blockOwnerDeletion,omitempty"`
+		} `tfsdk:"owner_references" json:"ownerReferences,omitempty"`
 	} `tfsdk:"metadata" json:"metadata"`
 
 	Spec *struct {
@@ -81,12 +92,25 @@ func (r *ApplicationNetworkingK8SAwsIamauthPolicyV1Alpha1Manifest) Schema(_ cont
 					"name": schema.StringAttribute{
 						Description:         "Unique identifier for this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names for more details.",
 						MarkdownDescription: "Unique identifier for this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names for more details.",
-						Required:            true,
-						Optional:            false,
+						Required:            false,
+						Optional:            true,
 						Computed:            false,
 						Validators: []validator.String{
 							validators.NameValidator(),
 							stringvalidator.LengthAtLeast(1),
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("generate_name")),
+						},
+					},
+
+					"generate_name": schema.StringAttribute{
+						Description:         "Prefix used by the server to generate a unique name for this object if 'name' is not provided. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency for more details.",
+						MarkdownDescription: "Prefix used by the server to generate a unique name for this object if `name` is not provided. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency for more details.",
+						Required:            false,
+						Optional:            true,
+						Computed:            false,
+						Validators: []validator.String{
+							validators.GenerateNameValidator(),
+							stringvalidator.LengthAtLeast(1),
 						},
 					},
 
@@ -124,6 +148,82 @@ func (r *ApplicationNetworkingK8SAwsIamauthPolicyV1Alpha1Manifest) Schema(_ cont
 							validators.AnnotationValidator(),
 						},
 					},
+					"finalizers": schema.ListAttribute{
+						Description:         "Must be empty before the object is deleted from the registry. Each entry is an identifier for the responsible component that will remove the entry from the list. See https://kubernetes.io/docs/concepts/overview/working-with-objects/finalizers/ for more details.",
+						MarkdownDescription: "Must be empty before the object is deleted from the registry. Each entry is an identifier for the responsible component that will remove the entry from the list. See https://kubernetes.io/docs/concepts/overview/working-with-objects/finalizers/ for more details.",
+						ElementType:         types.StringType,
+						Required:            false,
+						Optional:            true,
+						Computed:            false,
+						Validators: []validator.List{
+							validators.FinalizerValidator(),
+						},
+					},
+					"owner_references": schema.ListNestedAttribute{
+						Description:         "List of objects depended by this object. If all objects in the list have been deleted, this object will be garbage collected. See https://kubernetes.io/docs/concepts/overview/working-with-objects/owners-dependents/ for more details.",
+						MarkdownDescription: "List of objects depended by this object. If all objects in the list have been deleted, this object will be garbage collected. See https://kubernetes.io/docs/concepts/overview/working-with-objects/owners-dependents/ for more details.",
+						Required:            false,
+						Optional:            true,
+						Computed:            false,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"api_version": schema.StringAttribute{
+									Description:         "API version of the referent.",
+									MarkdownDescription: "API version of the referent.",
+									Required:            true,
+									Optional:            false,
+									Computed:            false,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								"kind": schema.StringAttribute{
+									Description:         "Kind of the referent.",
+									MarkdownDescription: "Kind of the referent.",
+									Required:            true,
+									Optional:            false,
+									Computed:            false,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								"name": schema.StringAttribute{
+									Description:         "Name of the referent.",
+									MarkdownDescription: "Name of the referent.",
+									Required:            true,
+									Optional:            false,
+									Computed:            false,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								"uid": schema.StringAttribute{
+									Description:         "UID of the referent.",
+									MarkdownDescription: "UID of the referent.",
+									Required:            true,
+									Optional:            false,
+									Computed:            false,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								"controller": schema.BoolAttribute{
+									Description:         "If true, this reference points to the managing controller.",
+									MarkdownDescription: "If true, this reference points to the managing controller.",
+									Required:            false,
+									Optional:            true,
+									Computed:            false,
+								},
+								"block_owner_deletion": schema.BoolAttribute{
+									Description:         "If true, and if the owner has the 'foregroundDeletion' finalizer, then the owner cannot be deleted from the key-value store until this reference is removed.",
+									MarkdownDescription: "If true, and if the owner has the `foregroundDeletion` finalizer, then the owner cannot be deleted from the key-value store until this reference is removed.",
+									Required:            false,
+									Optional:            true,
+									Computed:            false,
+								},
+							},
+						},
+					},
 				},
 			},
 
@@ -0,0 +1,195 @@
+/*
+* SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+* SPDX-License-Identifier: 0BSD
+ */
+
+package apps_v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/metio/terraform-provider-k8s/internal/provider/apps_v1"
+	"github.com/metio/terraform-provider-k8s/internal/utilities"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery/cached/memory"
+	discoveryfake "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/restmapper"
+	k8sTesting "k8s.io/client-go/testing"
+	"strings"
+	"testing"
+)
+
+func TestAppsDaemonSetV1Resource_ValidateSchema(t *testing.T) {
+	ctx := context.Background()
+	schemaRequest := fwresource.SchemaRequest{}
+	schemaResponse := &fwresource.SchemaResponse{}
+
+	apps_v1.NewAppsDaemonSetV1Resource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestAppsDaemonSetV1Resource_PlanAndApply(t *testing.T) {
+	ctx := context.Background()
+	schemaResponse := &fwresource.SchemaResponse{}
+	apps_v1.NewAppsDaemonSetV1Resource().Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+	resourceSchema := schemaResponse.Schema
+
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	var patches []string
+	client.PrependReactor("patch", "*", func(action k8sTesting.Action) (bool, runtime.Object, error) {
+		patch := action.(k8sTesting.PatchAction).GetPatch()
+		patches = append(patches, string(patch))
+		object := &unstructured.Unstructured{}
+		if err := object.UnmarshalJSON(patch); err != nil {
+			return true, nil, err
+		}
+		ownedMetadata := map[string]interface{}{"f:name": map[string]interface{}{}}
+		if len(object.GetLabels()) > 0 {
+			ownedLabels := map[string]interface{}{}
+			for key := range object.GetLabels() {
+				ownedLabels["f:"+key] = map[string]interface{}{}
+			}
+			ownedMetadata["f:labels"] = ownedLabels
+		}
+		ownedFields, err := json.Marshal(map[string]interface{}{"f:metadata": ownedMetadata})
+		if err != nil {
+			return true, nil, err
+		}
+		object.SetManagedFields([]meta.ManagedFieldsEntry{
+			{
+				Manager:    "terraform-provider-k8s",
+				Operation:  meta.ManagedFieldsOperationApply,
+				FieldsType: "FieldsV1",
+				FieldsV1:   &meta.FieldsV1{Raw: ownedFields},
+			},
+		})
+		// metadata added by other managers, e.g. the 'deployment.kubernetes.io/revision' annotation of Deployments
+		labels := object.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels["example.com/other-manager"] = "value"
+		object.SetLabels(labels)
+		object.SetAnnotations(map[string]string{"example.com/other-manager": "value"})
+		object.SetFinalizers([]string{"example.com/other-manager"})
+		object.SetUID("00000000-0000-0000-0000-000000000001")
+		object.SetResourceVersion("1")
+		object.SetGeneration(1)
+		return true, object, nil
+	})
+	discoveryClient := &discoveryfake.FakeDiscovery{Fake: &k8sTesting.Fake{Resources: []*meta.APIResourceList{
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []meta.APIResource{
+				{Name: "daemonsets", Kind: "DaemonSet", Namespaced: true},
+			},
+		},
+	}}}
+
+	r := apps_v1.NewAppsDaemonSetV1Resource()
+	configureResponse := &fwresource.ConfigureResponse{}
+	r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{ProviderData: &utilities.ResourceData{
+		Client:         client,
+		FieldManager:   "terraform-provider-k8s",
+		ForceConflicts: true,
+		RESTMapper:     restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient)),
+	}}, configureResponse)
+	if configureResponse.Diagnostics.HasError() {
+		t.Fatalf("Configure method diagnostics: %+v", configureResponse.Diagnostics)
+	}
+
+	// config contains the given labels next to name and namespace, all other attributes are null
+	config := func(labels map[string]string) tfsdk.Config {
+		plan := tfsdk.Plan{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}
+		diags := plan.SetAttribute(ctx, path.Root("metadata").AtName("name"), "example")
+		diags.Append(plan.SetAttribute(ctx, path.Root("metadata").AtName("namespace"), "default")...)
+		diags.Append(plan.SetAttribute(ctx, path.Root("metadata").AtName("labels"), labels)...)
+		if diags.HasError() {
+			t.Fatalf("config diagnostics: %+v", diags)
+		}
+		return tfsdk.Config{Schema: resourceSchema, Raw: plan.Raw}
+	}
+	// plan marks computed attributes without configured value as unknown and lets the resource modify the plan
+	plan := func(config tfsdk.Config, state tfsdk.State) tfsdk.Plan {
+		raw, err := tftypes.Transform(config.Raw, func(attributePath *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+			attribute, err := resourceSchema.AttributeAtTerraformPath(ctx, attributePath)
+			if err != nil || !value.IsNull() || !attribute.IsComputed() {
+				return value, nil
+			}
+			return tftypes.NewValue(value.Type(), tftypes.UnknownValue), nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		modifyPlanResponse := &fwresource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: resourceSchema, Raw: raw}}
+		r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+			Config: config,
+			Plan:   modifyPlanResponse.Plan,
+			State:  state,
+		}, modifyPlanResponse)
+		if modifyPlanResponse.Diagnostics.HasError() {
+			t.Fatalf("ModifyPlan method diagnostics: %+v", modifyPlanResponse.Diagnostics)
+		}
+		return modifyPlanResponse.Plan
+	}
+	// assertConsistent verifies that all known planned values are applied as planned
+	assertConsistent := func(plan tfsdk.Plan, state tfsdk.State) {
+		if !state.Raw.IsFullyKnown() {
+			t.Fatalf("applied state contains unknown values: %s", state.Raw)
+		}
+		err := tftypes.Walk(plan.Raw, func(attributePath *tftypes.AttributePath, planned tftypes.Value) (bool, error) {
+			if !planned.IsFullyKnown() {
+				return true, nil
+			}
+			applied, _, err := tftypes.WalkAttributePath(state.Raw, attributePath)
+			if appliedValue, ok := applied.(tftypes.Value); err != nil || !ok || !planned.Equal(appliedValue) {
+				t.Errorf("planned %s for %s, but applied %v", planned, attributePath, applied)
+			}
+			return false, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	nullState := tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}
+	createConfig := config(nil)
+	createPlan := plan(createConfig, nullState)
+	createResponse := &fwresource.CreateResponse{State: nullState}
+	r.Create(ctx, fwresource.CreateRequest{Config: createConfig, Plan: createPlan}, createResponse)
+	if createResponse.Diagnostics.HasError() {
+		t.Fatalf("Create method diagnostics: %+v", createResponse.Diagnostics)
+	}
+	assertConsistent(createPlan, createResponse.State)
+
+	updateConfig := config(map[string]string{"app": "example"})
+	updatePlan := plan(updateConfig, createResponse.State)
+	updateResponse := &fwresource.UpdateResponse{State: createResponse.State}
+	r.Update(ctx, fwresource.UpdateRequest{Config: updateConfig, Plan: updatePlan, State: createResponse.State}, updateResponse)
+	if updateResponse.Diagnostics.HasError() {
+		t.Fatalf("Update method diagnostics: %+v", updateResponse.Diagnostics)
+	}
+	assertConsistent(updatePlan, updateResponse.State)
+
+	for _, patch := range patches {
+		if strings.Contains(patch, "example.com/other-manager") {
+			t.Errorf("applied metadata of other managers: %s", patch)
+		}
+	}
+}
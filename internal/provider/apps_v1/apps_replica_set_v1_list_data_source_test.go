@@ -0,0 +1,31 @@
+/*
+* SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+* SPDX-License-Identifier: 0BSD
+ */
+
+package apps_v1_test
+
+import (
+	"context"
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/metio/terraform-provider-k8s/internal/provider/apps_v1"
+	"testing"
+)
+
+func TestAppsReplicaSetV1ListDataSource_ValidateSchema(t *testing.T) {
+	ctx := context.Background()
+	schemaRequest := fwdatasource.SchemaRequest{}
+	schemaResponse := &fwdatasource.SchemaResponse{}
+
+	apps_v1.NewAppsReplicaSetV1ListDataSource().Schema(ctx, schemaRequest, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	diagnostics := schemaResponse.Schema.ValidateImplementation(ctx)
+
+	if diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
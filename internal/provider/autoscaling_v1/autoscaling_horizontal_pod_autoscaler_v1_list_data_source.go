@@ -0,0 +1,524 @@
+/*
+* SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+* SPDX-License-Identifier: 0BSD
+ */
+
+package autoscaling_v1
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/metio/terraform-provider-k8s/internal/utilities"
+	"github.com/metio/terraform-provider-k8s/internal/validators"
+	apiMeta "k8s.io/apimachinery/pkg/api/meta"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+var (
+	_ datasource.DataSource                   = &AutoscalingHorizontalPodAutoscalerV1ListDataSource{}
+	_ datasource.DataSourceWithConfigure      = &AutoscalingHorizontalPodAutoscalerV1ListDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &AutoscalingHorizontalPodAutoscalerV1ListDataSource{}
+)
+
+func NewAutoscalingHorizontalPodAutoscalerV1ListDataSource() datasource.DataSource {
+	return &AutoscalingHorizontalPodAutoscalerV1ListDataSource{}
+}
+
+type AutoscalingHorizontalPodAutoscalerV1ListDataSource struct {
+	kubernetesClient  dynamic.Interface
+	kubernetesContext string
+	restMapper        apiMeta.ResettableRESTMapper
+}
+
+type AutoscalingHorizontalPodAutoscalerV1ListDataSourceData struct {
+	Namespace     types.String `tfsdk:"namespace" json:"-"`
+	LabelSelector types.String `tfsdk:"label_selector" json:"-"`
+	FieldSelector types.String `tfsdk:"field_selector" json:"-"`
+	Limit         types.Int64  `tfsdk:"limit" json:"-"`
+
+	Items []struct {
+		ApiVersion *string `tfsdk:"api_version" json:"apiVersion"`
+		Kind       *string `tfsdk:"kind" json:"kind"`
+
+		Metadata struct {
+			Name            string            `tfsdk:"name" json:"name"`
+			Namespace       string            `tfsdk:"namespace" json:"namespace"`
+			Labels          map[string]string `tfsdk:"labels" json:"labels,omitempty"`
+			Annotations     map[string]string `tfsdk:"annotations" json:"annotations,omitempty"`
+			GenerateName    *string           `tfsdk:"generate_name" json:"generateName,omitempty"`
+			Finalizers      []string          `tfsdk:"finalizers" json:"finalizers,omitempty"`
+			OwnerReferences []struct {
+				ApiVersion         string `tfsdk:"api_version" json:"apiVersion"`
+				Kind               string `tfsdk:"kind" json:"kind"`
+				Name               string `tfsdk:"name" json:"name"`
+				Uid                string `tfsdk:"uid" json:"uid"`
+				Controller         *bool  `tfsdk:"controller" json:"controller,omitempty"`
+				BlockOwnerDeletion *bool  `tfsdk:"block_owner_deletion" json:"blockOwnerDeletion,omitempty"`
+			} `tfsdk:"owner_references" json:"ownerReferences,omitempty"`
+			Uid               *string `tfsdk:"uid" json:"uid,omitempty"`
+			ResourceVersion   *string `tfsdk:"resource_version" json:"resourceVersion,omitempty"`
+			Generation        *int64  `tfsdk:"generation" json:"generation,omitempty"`
+			CreationTimestamp *string `tfsdk:"creation_timestamp" json:"creationTimestamp,omitempty"`
+		} `tfsdk:"metadata" json:"metadata"`
+
+		Status *struct {
+			CurrentCPUUtilizationPercentage *int64  `tfsdk:"current_cpu_utilization_percentage" json:"currentCPUUtilizationPercentage,omitempty"`
+			CurrentReplicas                 *int64  `tfsdk:"current_replicas" json:"currentReplicas,omitempty"`
+			DesiredReplicas                 *int64  `tfsdk:"desired_replicas" json:"desiredReplicas,omitempty"`
+			LastScaleTime                   *string `tfsdk:"last_scale_time" json:"lastScaleTime,omitempty"`
+			ObservedGeneration              *int64  `tfsdk:"observed_generation" json:"observedGeneration,omitempty"`
+		} `tfsdk:"status" json:"status,omitempty"`
+
+		Spec *struct {
+			MaxReplicas    *int64 `tfsdk:"max_replicas" json:"maxReplicas,omitempty"`
+			MinReplicas    *int64 `tfsdk:"min_replicas" json:"minReplicas,omitempty"`
+			ScaleTargetRef *struct {
+				ApiVersion *string `tfsdk:"api_version" json:"apiVersion,omitempty"`
+				Kind       *string `tfsdk:"kind" json:"kind,omitempty"`
+				Name       *string `tfsdk:"name" json:"name,omitempty"`
+			} `tfsdk:"scale_target_ref" json:"scaleTargetRef,omitempty"`
+			TargetCPUUtilizationPercentage *int64 `tfsdk:"target_cpu_utilization_percentage" json:"targetCPUUtilizationPercentage,omitempty"`
+		} `tfsdk:"spec" json:"spec,omitempty"`
+	} `tfsdk:"items" json:"items"`
+}
+
+func (r *AutoscalingHorizontalPodAutoscalerV1ListDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_autoscaling_horizontal_pod_autoscaler_v1_list"
+}
+
+func (r *AutoscalingHorizontalPodAutoscalerV1ListDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description:         "Lists HorizontalPodAutoscaler objects matching the configured selectors. configuration of a horizontal pod autoscaler.",
+		MarkdownDescription: "Lists HorizontalPodAutoscaler objects matching the configured selectors. configuration of a horizontal pod autoscaler.",
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				Description:         "Only list objects in this namespace. Objects of all namespaces are listed if not set.",
+				MarkdownDescription: "Only list objects in this namespace. Objects of all namespaces are listed if not set.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+			},
+
+			"label_selector": schema.StringAttribute{
+				Description:         "Only list objects whose labels match this selector, e.g. 'app=example,tier!=frontend'. See https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors for more details.",
+				MarkdownDescription: "Only list objects whose labels match this selector, e.g. `app=example,tier!=frontend`. See https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors for more details.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
+
+			"field_selector": schema.StringAttribute{
+				Description:         "Only list objects whose fields match this selector, e.g. 'metadata.name=example'. See https://kubernetes.io/docs/concepts/overview/working-with-objects/field-selectors/ for more details.",
+				MarkdownDescription: "Only list objects whose fields match this selector, e.g. `metadata.name=example`. See https://kubernetes.io/docs/concepts/overview/working-with-objects/field-selectors/ for more details.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
+
+			"limit": schema.Int64Attribute{
+				Description:         "The maximum number of objects to request from the API server at once. All pages are read until the list is complete. Defaults to 500.",
+				MarkdownDescription: "The maximum number of objects to request from the API server at once. All pages are read until the list is complete. Defaults to `500`.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+
+			"items": schema.ListNestedAttribute{
+				Description:         "The objects matching the configured selectors.",
+				MarkdownDescription: "The objects matching the configured selectors.",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"api_version": schema.StringAttribute{
+							Description:         "The API group of the requested resource.",
+							MarkdownDescription: "The API group of the requested resource.",
+							Required:            false,
+							Optional:            false,
+							Computed:            true,
+						},
+
+						"kind": schema.StringAttribute{
+							Description:         "The type of the requested resource.",
+							MarkdownDescription: "The type of the requested resource.",
+							Required:            false,
+							Optional:            false,
+							Computed:            true,
+						},
+
+						"metadata": schema.SingleNestedAttribute{
+							Description:         "Data that helps uniquely identify this object. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata for more details.",
+							MarkdownDescription: "Data that helps uniquely identify this object. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata for more details.",
+							Required:            false,
+							Optional:            false,
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description:         "Unique identifier for this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names for more details.",
+									MarkdownDescription: "Unique identifier for this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names for more details.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"namespace": schema.StringAttribute{
+									Description:         "Namespaces provides a mechanism for isolating groups of resources within a single cluster. See https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/ for more details.",
+									MarkdownDescription: "Namespaces provides a mechanism for isolating groups of resources within a single cluster. See https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/ for more details.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"labels": schema.MapAttribute{
+									Description:         "Keys and values that can be used to organize and categorize objects. See https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/ for more details.",
+									MarkdownDescription: "Keys and values that can be used to organize and categorize objects. See https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/ for more details.",
+									ElementType:         types.StringType,
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+								"annotations": schema.MapAttribute{
+									Description:         "Keys and values that can be used by external tooling to store and retrieve arbitrary metadata about this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/ for more details.",
+									MarkdownDescription: "Keys and values that can be used by external tooling to store and retrieve arbitrary metadata about this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/ for more details.",
+									ElementType:         types.StringType,
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+								"generate_name": schema.StringAttribute{
+									Description:         "Prefix used by the server to generate a unique name for this object if 'name' is not provided. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency for more details.",
+									MarkdownDescription: "Prefix used by the server to generate a unique name for this object if `name` is not provided. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency for more details.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+								"finalizers": schema.ListAttribute{
+									Description:         "Must be empty before the object is deleted from the registry. Each entry is an identifier for the responsible component that will remove the entry from the list. See https://kubernetes.io/docs/concepts/overview/working-with-objects/finalizers/ for more details.",
+									MarkdownDescription: "Must be empty before the object is deleted from the registry. Each entry is an identifier for the responsible component that will remove the entry from the list. See https://kubernetes.io/docs/concepts/overview/working-with-objects/finalizers/ for more details.",
+									ElementType:         types.StringType,
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+								"owner_references": schema.ListNestedAttribute{
+									Description:         "List of objects depended by this object. If all objects in the list have been deleted, this object will be garbage collected. See https://kubernetes.io/docs/concepts/overview/working-with-objects/owners-dependents/ for more details.",
+									MarkdownDescription: "List of objects depended by this object. If all objects in the list have been deleted, this object will be garbage collected. See https://kubernetes.io/docs/concepts/overview/working-with-objects/owners-dependents/ for more details.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"api_version": schema.StringAttribute{
+												Description:         "API version of the referent.",
+												MarkdownDescription: "API version of the referent.",
+												Required:            false,
+												Optional:            false,
+												Computed:            true,
+											},
+											"kind": schema.StringAttribute{
+												Description:         "Kind of the referent.",
+												MarkdownDescription: "Kind of the referent.",
+												Required:            false,
+												Optional:            false,
+												Computed:            true,
+											},
+											"name": schema.StringAttribute{
+												Description:         "Name of the referent.",
+												MarkdownDescription: "Name of the referent.",
+												Required:            false,
+												Optional:            false,
+												Computed:            true,
+											},
+											"uid": schema.StringAttribute{
+												Description:         "UID of the referent.",
+												MarkdownDescription: "UID of the referent.",
+												Required:            false,
+												Optional:            false,
+												Computed:            true,
+											},
+											"controller": schema.BoolAttribute{
+												Description:         "If true, this reference points to the managing controller.",
+												MarkdownDescription: "If true, this reference points to the managing controller.",
+												Required:            false,
+												Optional:            false,
+												Computed:            true,
+											},
+											"block_owner_deletion": schema.BoolAttribute{
+												Description:         "If true, and if the owner has the 'foregroundDeletion' finalizer, then the owner cannot be deleted from the key-value store until this reference is removed.",
+												MarkdownDescription: "If true, and if the owner has the `foregroundDeletion` finalizer, then the owner cannot be deleted from the key-value store until this reference is removed.",
+												Required:            false,
+												Optional:            false,
+												Computed:            true,
+											},
+										},
+									},
+								},
+								"uid": schema.StringAttribute{
+									Description:         "The unique in time and space value for this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#uids for more details.",
+									MarkdownDescription: "The unique in time and space value for this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#uids for more details.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+								"resource_version": schema.StringAttribute{
+									Description:         "An opaque value that represents the internal version of this object. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency for more details.",
+									MarkdownDescription: "An opaque value that represents the internal version of this object. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency for more details.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+								"generation": schema.Int64Attribute{
+									Description:         "A sequence number representing a specific generation of the desired state.",
+									MarkdownDescription: "A sequence number representing a specific generation of the desired state.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+								"creation_timestamp": schema.StringAttribute{
+									Description:         "A timestamp representing the server time when this object was created in RFC3339 format.",
+									MarkdownDescription: "A timestamp representing the server time when this object was created in RFC3339 format.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+							},
+						},
+
+						"status": schema.SingleNestedAttribute{
+							Description:         "Most recently observed status of the object. Populated by the system. Read-only. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status for more details.",
+							MarkdownDescription: "Most recently observed status of the object. Populated by the system. Read-only. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status for more details.",
+							Required:            false,
+							Optional:            false,
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+
+								"current_cpu_utilization_percentage": schema.Int64Attribute{
+									Description:         "currentCPUUtilizationPercentage is the current average CPU utilization over all pods, represented as a percentage of requested CPU, e.g. 70 means that an average pod is using now 70% of its requested CPU.",
+									MarkdownDescription: "currentCPUUtilizationPercentage is the current average CPU utilization over all pods, represented as a percentage of requested CPU, e.g. 70 means that an average pod is using now 70% of its requested CPU.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"current_replicas": schema.Int64Attribute{
+									Description:         "currentReplicas is the current number of replicas of pods managed by this autoscaler.",
+									MarkdownDescription: "currentReplicas is the current number of replicas of pods managed by this autoscaler.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"desired_replicas": schema.Int64Attribute{
+									Description:         "desiredReplicas is the desired number of replicas of pods managed by this autoscaler.",
+									MarkdownDescription: "desiredReplicas is the desired number of replicas of pods managed by this autoscaler.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"last_scale_time": schema.StringAttribute{
+									Description:         "Time is a wrapper around time.Time which supports correct marshaling to YAML and JSON. Wrappers are provided for many of the factory methods that the time package offers.",
+									MarkdownDescription: "Time is a wrapper around time.Time which supports correct marshaling to YAML and JSON. Wrappers are provided for many of the factory methods that the time package offers.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"observed_generation": schema.Int64Attribute{
+									Description:         "observedGeneration is the most recent generation observed by this autoscaler.",
+									MarkdownDescription: "observedGeneration is the most recent generation observed by this autoscaler.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+							},
+						},
+
+						"spec": schema.SingleNestedAttribute{
+							Description:         "specification of a horizontal pod autoscaler.",
+							MarkdownDescription: "specification of a horizontal pod autoscaler.",
+							Attributes: map[string]schema.Attribute{
+								"max_replicas": schema.Int64Attribute{
+									Description:         "maxReplicas is the upper limit for the number of pods that can be set by the autoscaler; cannot be smaller than MinReplicas.",
+									MarkdownDescription: "maxReplicas is the upper limit for the number of pods that can be set by the autoscaler; cannot be smaller than MinReplicas.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"min_replicas": schema.Int64Attribute{
+									Description:         "minReplicas is the lower limit for the number of replicas to which the autoscaler can scale down. It defaults to 1 pod. minReplicas is allowed to be 0 if the alpha feature gate HPAScaleToZero is enabled and at least one Object or External metric is configured. Scaling is active as long as at least one metric value is available.",
+									MarkdownDescription: "minReplicas is the lower limit for the number of replicas to which the autoscaler can scale down. It defaults to 1 pod. minReplicas is allowed to be 0 if the alpha feature gate HPAScaleToZero is enabled and at least one Object or External metric is configured. Scaling is active as long as at least one metric value is available.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"scale_target_ref": schema.SingleNestedAttribute{
+									Description:         "CrossVersionObjectReference contains enough information to let you identify the referred resource.",
+									MarkdownDescription: "CrossVersionObjectReference contains enough information to let you identify the referred resource.",
+									Attributes: map[string]schema.Attribute{
+										"api_version": schema.StringAttribute{
+											Description:         "apiVersion is the API version of the referent",
+											MarkdownDescription: "apiVersion is the API version of the referent",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"kind": schema.StringAttribute{
+											Description:         "kind is the kind of the referent; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+											MarkdownDescription: "kind is the kind of the referent; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"name": schema.StringAttribute{
+											Description:         "name is the name of the referent; More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+											MarkdownDescription: "name is the name of the referent; More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"target_cpu_utilization_percentage": schema.Int64Attribute{
+									Description:         "targetCPUUtilizationPercentage is the target average CPU utilization (represented as a percentage of requested CPU) over all the pods; if not specified the default autoscaling policy will be used.",
+									MarkdownDescription: "targetCPUUtilizationPercentage is the target average CPU utilization (represented as a percentage of requested CPU) over all the pods; if not specified the default autoscaling policy will be used.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+							},
+							Required: false,
+							Optional: false,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *AutoscalingHorizontalPodAutoscalerV1ListDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	if dataSourceData, ok := request.ProviderData.(*utilities.DataSourceData); ok {
+		if dataSourceData.Offline {
+			response.Diagnostics.Append(utilities.OfflineProviderError())
+		} else {
+			r.kubernetesClient = dataSourceData.Client
+			r.kubernetesContext = dataSourceData.Context
+			r.restMapper = dataSourceData.RESTMapper
+		}
+	} else {
+		response.Diagnostics.Append(utilities.UnexpectedDataSourceDataError(request.ProviderData))
+	}
+}
+
+func (r *AutoscalingHorizontalPodAutoscalerV1ListDataSource) ValidateConfig(_ context.Context, request datasource.ValidateConfigRequest, response *datasource.ValidateConfigResponse) {
+	// the provider is not configured while validating, and data sources with unknown values are read during apply
+	if r.restMapper == nil || !request.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	if !utilities.IsKindServed(r.restMapper, "autoscaling/v1", "HorizontalPodAutoscaler") {
+		response.Diagnostics.Append(utilities.MissingKindError(r.kubernetesContext, k8sSchema.GroupVersionResource{Group: "autoscaling", Version: "v1", Resource: "horizontalpodautoscalers"}))
+	}
+}
+
+// groupVersionResource resolves the API resource of HorizontalPodAutoscaler through discovery and falls back to the plural "horizontalpodautoscalers".
+func (r *AutoscalingHorizontalPodAutoscalerV1ListDataSource) groupVersionResource() k8sSchema.GroupVersionResource {
+	return utilities.GroupVersionResource(r.restMapper, "HorizontalPodAutoscaler", k8sSchema.GroupVersionResource{Group: "autoscaling", Version: "v1", Resource: "horizontalpodautoscalers"})
+}
+
+func (r *AutoscalingHorizontalPodAutoscalerV1ListDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Read data source k8s_autoscaling_horizontal_pod_autoscaler_v1_list")
+
+	if r.kubernetesClient == nil {
+		response.Diagnostics.Append(utilities.UnknownProviderConfigurationError())
+		return
+	}
+
+	var data AutoscalingHorizontalPodAutoscalerV1ListDataSourceData
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	groupVersionResource := r.groupVersionResource()
+	var resourceClient dynamic.ResourceInterface = r.kubernetesClient.
+		Resource(groupVersionResource)
+	if data.Namespace.ValueString() != "" {
+		resourceClient = r.kubernetesClient.
+			Resource(groupVersionResource).
+			Namespace(data.Namespace.ValueString())
+	}
+	listOptions := meta.ListOptions{
+		LabelSelector: data.LabelSelector.ValueString(),
+		FieldSelector: data.FieldSelector.ValueString(),
+		Limit:         500,
+	}
+	if !data.Limit.IsNull() {
+		listOptions.Limit = data.Limit.ValueInt64()
+	}
+
+	for {
+		listResponse, err := resourceClient.List(ctx, listOptions)
+		if err != nil {
+			response.Diagnostics.Append(utilities.ListResourcesError(err))
+			return
+		}
+		listBytes, err := listResponse.MarshalJSON()
+		if err != nil {
+			response.Diagnostics.Append(utilities.MarshalJsonError(err))
+			return
+		}
+
+		var page AutoscalingHorizontalPodAutoscalerV1ListDataSourceData
+		err = json.Unmarshal(listBytes, &page)
+		if err != nil {
+			response.Diagnostics.Append(utilities.JsonUnmarshalError(err))
+			return
+		}
+		// the first page sets an empty list instead of null if no objects match
+		if data.Items == nil {
+			data.Items = page.Items
+		} else {
+			data.Items = append(data.Items, page.Items...)
+		}
+
+		listOptions.Continue = listResponse.GetContinue()
+		if listOptions.Continue == "" {
+			break
+		}
+		tflog.Debug(ctx, "Reading next page of k8s_autoscaling_horizontal_pod_autoscaler_v1_list", map[string]interface{}{
+			"items": len(data.Items),
+		})
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,1211 @@
+/*
+* SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+* SPDX-License-Identifier: 0BSD
+ */
+
+package autoscaling_v1
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/metio/terraform-provider-k8s/internal/utilities"
+	"github.com/metio/terraform-provider-k8s/internal/validators"
+	apiMeta "k8s.io/apimachinery/pkg/api/meta"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	k8sTypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/pointer"
+	"time"
+)
+
+var (
+	_ resource.Resource                = &AutoscalingHorizontalPodAutoscalerV1Resource{}
+	_ resource.ResourceWithConfigure   = &AutoscalingHorizontalPodAutoscalerV1Resource{}
+	_ resource.ResourceWithImportState = &AutoscalingHorizontalPodAutoscalerV1Resource{}
+	_ resource.ResourceWithModifyPlan  = &AutoscalingHorizontalPodAutoscalerV1Resource{}
+	_ resource.ResourceWithIdentity    = &AutoscalingHorizontalPodAutoscalerV1Resource{}
+)
+
+func NewAutoscalingHorizontalPodAutoscalerV1Resource() resource.Resource {
+	return &AutoscalingHorizontalPodAutoscalerV1Resource{}
+}
+
+type AutoscalingHorizontalPodAutoscalerV1Resource struct {
+	kubernetesClient  dynamic.Interface
+	kubernetesContext string
+	restMapper        apiMeta.ResettableRESTMapper
+	fieldManager      string
+	forceConflicts    bool
+}
+
+type AutoscalingHorizontalPodAutoscalerV1ResourceData struct {
+	ForceConflicts      types.Bool   `tfsdk:"force_conflicts" json:"-"`
+	FieldManager        types.String `tfsdk:"field_manager" json:"-"`
+	DeletionPropagation types.String `tfsdk:"deletion_propagation" json:"-"`
+	DeleteBehavior      types.String `tfsdk:"delete_behavior" json:"-"`
+	WaitForUpsert       types.List   `tfsdk:"wait_for_upsert" json:"-"`
+	WaitForDelete       types.Object `tfsdk:"wait_for_delete" json:"-"`
+	WaitForReady        types.Bool   `tfsdk:"wait_for_ready" json:"-"`
+	WaitForReadyTimeout types.Int64  `tfsdk:"wait_for_ready_timeout" json:"-"`
+
+	ApiVersion *string `tfsdk:"-" json:"apiVersion"`
+	Kind       *string `tfsdk:"-" json:"kind"`
+
+	Metadata struct {
+		Name            string            `tfsdk:"name" json:"name"`
+		Namespace       string            `tfsdk:"namespace" json:"namespace"`
+		Labels          map[string]string `tfsdk:"labels" json:"labels,omitempty"`
+		Annotations     map[string]string `tfsdk:"annotations" json:"annotations,omitempty"`
+		Finalizers      []string          `tfsdk:"finalizers" json:"finalizers,omitempty"`
+		OwnerReferences []struct {
+			ApiVersion         string `tfsdk:"api_version" json:"apiVersion"`
+			Kind               string `tfsdk:"kind" json:"kind"`
+			Name               string `tfsdk:"name" json:"name"`
+			Uid                string `tfsdk:"uid" json:"uid"`
+			Controller         *bool  `tfsdk:"controller" json:"controller,omitempty"`
+			BlockOwnerDeletion *bool  `tfsdk:"block_owner_deletion" json:"blockOwnerDeletion,omitempty"`
+		} `tfsdk:"owner_references" json:"ownerReferences,omitempty"`
+		Uid               types.String `tfsdk:"uid" json:"-"`
+		ResourceVersion   types.String `tfsdk:"resource_version" json:"-"`
+		Generation        types.Int64  `tfsdk:"generation" json:"-"`
+		CreationTimestamp types.String `tfsdk:"creation_timestamp" json:"-"`
+	} `tfsdk:"metadata" json:"metadata"`
+
+	Status types.Object `tfsdk:"status" json:"-"`
+
+	Spec *struct {
+		MaxReplicas    *int64 `tfsdk:"max_replicas" json:"maxReplicas,omitempty"`
+		MinReplicas    *int64 `tfsdk:"min_replicas" json:"minReplicas,omitempty"`
+		ScaleTargetRef *struct {
+			ApiVersion *string `tfsdk:"api_version" json:"apiVersion,omitempty"`
+			Kind       *string `tfsdk:"kind" json:"kind,omitempty"`
+			Name       *string `tfsdk:"name" json:"name,omitempty"`
+		} `tfsdk:"scale_target_ref" json:"scaleTargetRef,omitempty"`
+		TargetCPUUtilizationPercentage *int64 `tfsdk:"target_cpu_utilization_percentage" json:"targetCPUUtilizationPercentage,omitempty"`
+	} `tfsdk:"spec" json:"spec,omitempty"`
+}
+
+type AutoscalingHorizontalPodAutoscalerV1ResourceStatus struct {
+	CurrentCPUUtilizationPercentage *int64  `tfsdk:"current_cpu_utilization_percentage" json:"currentCPUUtilizationPercentage,omitempty"`
+	CurrentReplicas                 *int64  `tfsdk:"current_replicas" json:"currentReplicas,omitempty"`
+	DesiredReplicas                 *int64  `tfsdk:"desired_replicas" json:"desiredReplicas,omitempty"`
+	LastScaleTime                   *string `tfsdk:"last_scale_time" json:"lastScaleTime,omitempty"`
+	ObservedGeneration              *int64  `tfsdk:"observed_generation" json:"observedGeneration,omitempty"`
+}
+
+func (r *AutoscalingHorizontalPodAutoscalerV1Resource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_autoscaling_horizontal_pod_autoscaler_v1"
+}
+
+func (r *AutoscalingHorizontalPodAutoscalerV1Resource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description:         "configuration of a horizontal pod autoscaler.",
+		MarkdownDescription: "configuration of a horizontal pod autoscaler.",
+		Attributes: map[string]schema.Attribute{
+			"force_conflicts": schema.BoolAttribute{
+				Description:         "If 'true', server-side apply will force the changes against conflicts. If not specified uses the value from the provider configuration.",
+				MarkdownDescription: "If `true`, server-side apply will force the changes against conflicts. If not specified uses the value from the provider configuration.",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+			},
+
+			"field_manager": schema.StringAttribute{
+				Description:         "The name of the manager used to track field ownership. If not specified uses the value from the provider configuration.",
+				MarkdownDescription: "The name of the manager used to track field ownership. If not specified uses the value from the provider configuration.",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"deletion_propagation": schema.StringAttribute{
+				Description:         "Decides if a deletion will propagate to the dependents of the object, and how the garbage collector will handle the propagation.",
+				MarkdownDescription: "Decides if a deletion will propagate to the dependents of the object, and how the garbage collector will handle the propagation.",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("Orphan", "Background", "Foreground"),
+				},
+			},
+
+			"delete_behavior": schema.StringAttribute{
+				Description:         "Decides what happens to the object in the cluster when the resource is destroyed. 'delete' removes the object, 'orphan' only removes the resource from the Terraform state and leaves the object in the cluster, and 'abandon_if_annotated' refuses to destroy the resource as long as the object carries the annotation 'terraform-provider-k8s/prevent-destroy: true'. Defaults to 'delete'.",
+				MarkdownDescription: "Decides what happens to the object in the cluster when the resource is destroyed. `delete` removes the object, `orphan` only removes the resource from the Terraform state and leaves the object in the cluster, and `abandon_if_annotated` refuses to destroy the resource as long as the object carries the annotation `terraform-provider-k8s/prevent-destroy: true`. Defaults to `delete`.",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("delete", "orphan", "abandon_if_annotated"),
+				},
+			},
+
+			"wait_for_upsert": schema.ListNestedAttribute{
+				Description:         "Wait for specific conditions after create/update of resources.",
+				MarkdownDescription: "Wait for specific conditions after create/update of resources.",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"jsonpath": schema.StringAttribute{
+							Description:         "Relaxed JSONPath expression to use. See https://pkg.go.dev/k8s.io/kubectl/pkg/cmd/get#RelaxedJSONPathExpression for details.",
+							MarkdownDescription: "Relaxed JSONPath expression to use. See https://pkg.go.dev/k8s.io/kubectl/pkg/cmd/get#RelaxedJSONPathExpression for details.",
+							Required:            true,
+							Optional:            false,
+							Computed:            false,
+						},
+						"value": schema.StringAttribute{
+							Description:         "The value to wait for. If not specified, waiting will complete as soon as JSONPath expression exists and has any non-empty value.",
+							MarkdownDescription: "The value to wait for. If not specified, waiting will complete as soon as JSONPath expression exists and has any non-empty value.",
+							Required:            false,
+							Optional:            true,
+							Computed:            false,
+						},
+						"timeout": schema.Int64Attribute{
+							Description:         "The number of seconds to wait before giving up. Zero means check once and don't wait.",
+							MarkdownDescription: "The number of seconds to wait before giving up. Zero means check once and don't wait.",
+							Required:            false,
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(30),
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"poll_interval": schema.Int64Attribute{
+							Description:         "The number of seconds to wait before checking again.",
+							MarkdownDescription: "The number of seconds to wait before checking again.",
+							Required:            false,
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(5),
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+
+			"wait_for_delete": schema.SingleNestedAttribute{
+				Description:         "Wait for deletion of resources.",
+				MarkdownDescription: "Wait for deletion of resources.",
+				Required:            false,
+				Optional:            true,
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"timeout": schema.Int64Attribute{
+						Description:         "The number of seconds to wait before giving up. Zero means check once and don't wait.",
+						MarkdownDescription: "The number of seconds to wait before giving up. Zero means check once and don't wait.",
+						Required:            false,
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(30),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"poll_interval": schema.Int64Attribute{
+						Description:         "The number of seconds to wait before checking again.",
+						MarkdownDescription: "The number of seconds to wait before checking again.",
+						Required:            false,
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(5),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+				},
+			},
+
+			"wait_for_ready": schema.BoolAttribute{
+				Description:         "If 'true', create/update of resources waits until the resource is ready. Deployments, StatefulSets and DaemonSets must be rolled out, Jobs must be completed, and all other kinds must report a 'Ready' condition with status 'True'.",
+				MarkdownDescription: "If `true`, create/update of resources waits until the resource is ready. Deployments, StatefulSets and DaemonSets must be rolled out, Jobs must be completed, and all other kinds must report a `Ready` condition with status `True`.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
+
+			"wait_for_ready_timeout": schema.Int64Attribute{
+				Description:         "The number of seconds to wait for the resource to become ready. Defaults to '300'.",
+				MarkdownDescription: "The number of seconds to wait for the resource to become ready. Defaults to `300`.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+
+			"metadata": schema.SingleNestedAttribute{
+				Description:         "Data that helps uniquely identify this object. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata for more details.",
+				MarkdownDescription: "Data that helps uniquely identify this object. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata for more details.",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description:         "Unique identifier for this object. Server-side apply requires a fixed name, thus generate_name is not supported. See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names for more details.",
+						MarkdownDescription: "Unique identifier for this object. Server-side apply requires a fixed name, thus generate_name is not supported. See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names for more details.",
+						Required:            true,
+						Optional:            false,
+						Computed:            false,
+						Validators: []validator.String{
+							validators.NameValidator(),
+							stringvalidator.LengthAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+
+					"namespace": schema.StringAttribute{
+						Description:         "Namespaces provides a mechanism for isolating groups of resources within a single cluster. See https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/ for more details.",
+						MarkdownDescription: "Namespaces provides a mechanism for isolating groups of resources within a single cluster. See https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/ for more details.",
+						Required:            true,
+						Optional:            false,
+						Computed:            false,
+						Validators: []validator.String{
+							validators.NameValidator(),
+							stringvalidator.LengthAtLeast(1),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+
+					"labels": schema.MapAttribute{
+						Description:         "Keys and values that can be used to organize and categorize objects. See https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/ for more details.",
+						MarkdownDescription: "Keys and values that can be used to organize and categorize objects. See https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/ for more details.",
+						ElementType:         types.StringType,
+						Required:            false,
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.Map{
+							validators.LabelValidator(),
+						},
+					},
+					"annotations": schema.MapAttribute{
+						Description:         "Keys and values that can be used by external tooling to store and retrieve arbitrary metadata about this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/ for more details.",
+						MarkdownDescription: "Keys and values that can be used by external tooling to store and retrieve arbitrary metadata about this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/ for more details.",
+						ElementType:         types.StringType,
+						Required:            false,
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.Map{
+							validators.AnnotationValidator(),
+						},
+					},
+					"finalizers": schema.ListAttribute{
+						Description:         "Must be empty before the object is deleted from the registry. Each entry is an identifier for the responsible component that will remove the entry from the list. See https://kubernetes.io/docs/concepts/overview/working-with-objects/finalizers/ for more details.",
+						MarkdownDescription: "Must be empty before the object is deleted from the registry. Each entry is an identifier for the responsible component that will remove the entry from the list. See https://kubernetes.io/docs/concepts/overview/working-with-objects/finalizers/ for more details.",
+						ElementType:         types.StringType,
+						Required:            false,
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.List{
+							validators.FinalizerValidator(),
+						},
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"owner_references": schema.ListNestedAttribute{
+						Description:         "List of objects depended by this object. If all objects in the list have been deleted, this object will be garbage collected. See https://kubernetes.io/docs/concepts/overview/working-with-objects/owners-dependents/ for more details.",
+						MarkdownDescription: "List of objects depended by this object. If all objects in the list have been deleted, this object will be garbage collected. See https://kubernetes.io/docs/concepts/overview/working-with-objects/owners-dependents/ for more details.",
+						Required:            false,
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.UseStateForUnknown(),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"api_version": schema.StringAttribute{
+									Description:         "API version of the referent.",
+									MarkdownDescription: "API version of the referent.",
+									Required:            true,
+									Optional:            false,
+									Computed:            false,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								"kind": schema.StringAttribute{
+									Description:         "Kind of the referent.",
+									MarkdownDescription: "Kind of the referent.",
+									Required:            true,
+									Optional:            false,
+									Computed:            false,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								"name": schema.StringAttribute{
+									Description:         "Name of the referent.",
+									MarkdownDescription: "Name of the referent.",
+									Required:            true,
+									Optional:            false,
+									Computed:            false,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								"uid": schema.StringAttribute{
+									Description:         "UID of the referent.",
+									MarkdownDescription: "UID of the referent.",
+									Required:            true,
+									Optional:            false,
+									Computed:            false,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								"controller": schema.BoolAttribute{
+									Description:         "If true, this reference points to the managing controller.",
+									MarkdownDescription: "If true, this reference points to the managing controller.",
+									Required:            false,
+									Optional:            true,
+									Computed:            false,
+								},
+								"block_owner_deletion": schema.BoolAttribute{
+									Description:         "If true, and if the owner has the 'foregroundDeletion' finalizer, then the owner cannot be deleted from the key-value store until this reference is removed.",
+									MarkdownDescription: "If true, and if the owner has the `foregroundDeletion` finalizer, then the owner cannot be deleted from the key-value store until this reference is removed.",
+									Required:            false,
+									Optional:            true,
+									Computed:            false,
+								},
+							},
+						},
+					},
+					"uid": schema.StringAttribute{
+						Description:         "The unique in time and space value for this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#uids for more details.",
+						MarkdownDescription: "The unique in time and space value for this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#uids for more details.",
+						Required:            false,
+						Optional:            false,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"resource_version": schema.StringAttribute{
+						Description:         "An opaque value that represents the internal version of this object. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency for more details.",
+						MarkdownDescription: "An opaque value that represents the internal version of this object. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency for more details.",
+						Required:            false,
+						Optional:            false,
+						Computed:            true,
+					},
+					"generation": schema.Int64Attribute{
+						Description:         "A sequence number representing a specific generation of the desired state.",
+						MarkdownDescription: "A sequence number representing a specific generation of the desired state.",
+						Required:            false,
+						Optional:            false,
+						Computed:            true,
+					},
+					"creation_timestamp": schema.StringAttribute{
+						Description:         "A timestamp representing the server time when this object was created in RFC3339 format.",
+						MarkdownDescription: "A timestamp representing the server time when this object was created in RFC3339 format.",
+						Required:            false,
+						Optional:            false,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+
+			"status": schema.SingleNestedAttribute{
+				Description:         "Most recently observed status of the object. Populated by the system. Read-only. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status for more details.",
+				MarkdownDescription: "Most recently observed status of the object. Populated by the system. Read-only. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status for more details.",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+
+					"current_cpu_utilization_percentage": schema.Int64Attribute{
+						Description:         "currentCPUUtilizationPercentage is the current average CPU utilization over all pods, represented as a percentage of requested CPU, e.g. 70 means that an average pod is using now 70% of its requested CPU.",
+						MarkdownDescription: "currentCPUUtilizationPercentage is the current average CPU utilization over all pods, represented as a percentage of requested CPU, e.g. 70 means that an average pod is using now 70% of its requested CPU.",
+						Required:            false,
+						Optional:            false,
+						Computed:            true,
+					},
+
+					"current_replicas": schema.Int64Attribute{
+						Description:         "currentReplicas is the current number of replicas of pods managed by this autoscaler.",
+						MarkdownDescription: "currentReplicas is the current number of replicas of pods managed by this autoscaler.",
+						Required:            false,
+						Optional:            false,
+						Computed:            true,
+					},
+
+					"desired_replicas": schema.Int64Attribute{
+						Description:         "desiredReplicas is the desired number of replicas of pods managed by this autoscaler.",
+						MarkdownDescription: "desiredReplicas is the desired number of replicas of pods managed by this autoscaler.",
+						Required:            false,
+						Optional:            false,
+						Computed:            true,
+					},
+
+					"last_scale_time": schema.StringAttribute{
+						Description:         "Time is a wrapper around time.Time which supports correct marshaling to YAML and JSON. Wrappers are provided for many of the factory methods that the time package offers.",
+						MarkdownDescription: "Time is a wrapper around time.Time which supports correct marshaling to YAML and JSON. Wrappers are provided for many of the factory methods that the time package offers.",
+						Required:            false,
+						Optional:            false,
+						Computed:            true,
+					},
+
+					"observed_generation": schema.Int64Attribute{
+						Description:         "observedGeneration is the most recent generation observed by this autoscaler.",
+						MarkdownDescription: "observedGeneration is the most recent generation observed by this autoscaler.",
+						Required:            false,
+						Optional:            false,
+						Computed:            true,
+					},
+				},
+			},
+
+			"spec": schema.SingleNestedAttribute{
+				Description:         "specification of a horizontal pod autoscaler.",
+				MarkdownDescription: "specification of a horizontal pod autoscaler.",
+				Attributes: map[string]schema.Attribute{
+					"max_replicas": schema.Int64Attribute{
+						Description:         "maxReplicas is the upper limit for the number of pods that can be set by the autoscaler; cannot be smaller than MinReplicas.",
+						MarkdownDescription: "maxReplicas is the upper limit for the number of pods that can be set by the autoscaler; cannot be smaller than MinReplicas.",
+						Required:            true,
+						Optional:            false,
+						Computed:            false,
+					},
+
+					"min_replicas": schema.Int64Attribute{
+						Description:         "minReplicas is the lower limit for the number of replicas to which the autoscaler can scale down. It defaults to 1 pod. minReplicas is allowed to be 0 if the alpha feature gate HPAScaleToZero is enabled and at least one Object or External metric is configured. Scaling is active as long as at least one metric value is available.",
+						MarkdownDescription: "minReplicas is the lower limit for the number of replicas to which the autoscaler can scale down. It defaults to 1 pod. minReplicas is allowed to be 0 if the alpha feature gate HPAScaleToZero is enabled and at least one Object or External metric is configured. Scaling is active as long as at least one metric value is available.",
+						Required:            false,
+						Optional:            true,
+						Computed:            false,
+					},
+
+					"scale_target_ref": schema.SingleNestedAttribute{
+						Description:         "CrossVersionObjectReference contains enough information to let you identify the referred resource.",
+						MarkdownDescription: "CrossVersionObjectReference contains enough information to let you identify the referred resource.",
+						Attributes: map[string]schema.Attribute{
+							"api_version": schema.StringAttribute{
+								Description:         "apiVersion is the API version of the referent",
+								MarkdownDescription: "apiVersion is the API version of the referent",
+								Required:            false,
+								Optional:            true,
+								Computed:            false,
+							},
+
+							"kind": schema.StringAttribute{
+								Description:         "kind is the kind of the referent; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+								MarkdownDescription: "kind is the kind of the referent; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+								Required:            true,
+								Optional:            false,
+								Computed:            false,
+							},
+
+							"name": schema.StringAttribute{
+								Description:         "name is the name of the referent; More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+								MarkdownDescription: "name is the name of the referent; More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+								Required:            true,
+								Optional:            false,
+								Computed:            false,
+							},
+						},
+						Required: true,
+						Optional: false,
+						Computed: false,
+					},
+
+					"target_cpu_utilization_percentage": schema.Int64Attribute{
+						Description:         "targetCPUUtilizationPercentage is the target average CPU utilization (represented as a percentage of requested CPU) over all the pods; if not specified the default autoscaling policy will be used.",
+						MarkdownDescription: "targetCPUUtilizationPercentage is the target average CPU utilization (represented as a percentage of requested CPU) over all the pods; if not specified the default autoscaling policy will be used.",
+						Required:            false,
+						Optional:            true,
+						Computed:            false,
+					},
+				},
+				Required: false,
+				Optional: true,
+				Computed: false,
+			},
+		},
+	}
+}
+
+func (r *AutoscalingHorizontalPodAutoscalerV1Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"namespace": identityschema.StringAttribute{
+				Description:       "The namespace of the object.",
+				RequiredForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "The name of the object.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *AutoscalingHorizontalPodAutoscalerV1Resource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	if resourceData, ok := request.ProviderData.(*utilities.ResourceData); ok {
+		if resourceData.Offline {
+			response.Diagnostics.Append(utilities.OfflineProviderError())
+		} else {
+			r.kubernetesClient = resourceData.Client
+			r.kubernetesContext = resourceData.Context
+			r.restMapper = resourceData.RESTMapper
+			r.fieldManager = resourceData.FieldManager
+			r.forceConflicts = resourceData.ForceConflicts
+		}
+	} else {
+		response.Diagnostics.Append(utilities.UnexpectedResourceDataError(request.ProviderData))
+	}
+}
+
+// groupVersionResource resolves the API resource of HorizontalPodAutoscaler through discovery and falls back to the plural "horizontalpodautoscalers".
+func (r *AutoscalingHorizontalPodAutoscalerV1Resource) groupVersionResource() k8sSchema.GroupVersionResource {
+	return utilities.GroupVersionResource(r.restMapper, "HorizontalPodAutoscaler", k8sSchema.GroupVersionResource{Group: "autoscaling", Version: "v1", Resource: "horizontalpodautoscalers"})
+}
+
+func (r *AutoscalingHorizontalPodAutoscalerV1Resource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create resource k8s_autoscaling_horizontal_pod_autoscaler_v1")
+
+	if r.kubernetesClient == nil {
+		response.Diagnostics.Append(utilities.UnknownProviderConfigurationError())
+		return
+	}
+
+	var plannedFinalizers, plannedOwnerReferences types.List
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("metadata").AtName("finalizers"), &plannedFinalizers)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("metadata").AtName("owner_references"), &plannedOwnerReferences)...)
+	plan, diags := utilities.NullUnconfiguredAttributes(request.Plan, request.Config,
+		path.Root("metadata").AtName("labels"),
+		path.Root("metadata").AtName("annotations"),
+		path.Root("metadata").AtName("finalizers"),
+		path.Root("metadata").AtName("owner_references"))
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var model AutoscalingHorizontalPodAutoscalerV1ResourceData
+	response.Diagnostics.Append(plan.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	model.ApiVersion = pointer.String("autoscaling/v1")
+	model.Kind = pointer.String("HorizontalPodAutoscaler")
+
+	bytes, err := json.Marshal(model)
+	if err != nil {
+		response.Diagnostics.Append(utilities.JsonMarshalError(err))
+		return
+	}
+
+	forceConflicts := r.forceConflicts
+	if !model.ForceConflicts.IsNull() && !model.ForceConflicts.IsUnknown() {
+		forceConflicts = model.ForceConflicts.ValueBool()
+	}
+	fieldManager := r.fieldManager
+	if !model.FieldManager.IsNull() && !model.FieldManager.IsUnknown() {
+		fieldManager = model.FieldManager.ValueString()
+	}
+	patchOptions := meta.PatchOptions{
+		FieldManager:    fieldManager,
+		Force:           pointer.Bool(forceConflicts),
+		FieldValidation: "Strict",
+	}
+
+	groupVersionResource := r.groupVersionResource()
+	patchResponse, err := r.kubernetesClient.
+		Resource(groupVersionResource).
+		Namespace(model.Metadata.Namespace).
+		Patch(ctx, model.Metadata.Name, k8sTypes.ApplyPatchType, bytes, patchOptions)
+	if err != nil {
+		response.Diagnostics.Append(utilities.PatchErrors(err)...)
+		return
+	}
+
+	ownedBytes, err := utilities.OwnedFieldsJSON(patchResponse, fieldManager, bytes)
+	if err != nil {
+		response.Diagnostics.Append(utilities.ManagedFieldsError(err))
+		return
+	}
+
+	var readResponse AutoscalingHorizontalPodAutoscalerV1ResourceData
+	err = json.Unmarshal(ownedBytes, &readResponse)
+	if err != nil {
+		response.Diagnostics.Append(utilities.JsonUnmarshalError(err))
+		return
+	}
+	patchBytes, err := patchResponse.MarshalJSON()
+	if err != nil {
+		response.Diagnostics.Append(utilities.MarshalJsonError(err))
+		return
+	}
+	var statusResponse struct {
+		Status *AutoscalingHorizontalPodAutoscalerV1ResourceStatus `json:"status,omitempty"`
+	}
+	err = json.Unmarshal(patchBytes, &statusResponse)
+	if err != nil {
+		response.Diagnostics.Append(utilities.JsonUnmarshalError(err))
+		return
+	}
+
+	model.Metadata = readResponse.Metadata
+	model.Metadata.Uid = types.StringValue(string(patchResponse.GetUID()))
+	model.Metadata.ResourceVersion = types.StringValue(patchResponse.GetResourceVersion())
+	model.Metadata.Generation = types.Int64Value(patchResponse.GetGeneration())
+	model.Metadata.CreationTimestamp = types.StringValue(patchResponse.GetCreationTimestamp().UTC().Format(time.RFC3339))
+	model.Spec = readResponse.Spec
+	if model.ForceConflicts.IsUnknown() {
+		model.ForceConflicts = types.BoolNull()
+	}
+	if model.FieldManager.IsUnknown() {
+		model.FieldManager = types.StringNull()
+	}
+	if model.DeletionPropagation.IsUnknown() {
+		model.DeletionPropagation = types.StringNull()
+	}
+	if model.DeleteBehavior.IsUnknown() {
+		model.DeleteBehavior = types.StringNull()
+	}
+	if model.WaitForUpsert.IsUnknown() {
+		model.WaitForUpsert = types.ListNull(types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"jsonpath":      types.StringType,
+				"value":         types.StringType,
+				"timeout":       types.Int64Type,
+				"poll_interval": types.Int64Type,
+			},
+		})
+	}
+	if model.WaitForDelete.IsUnknown() {
+		model.WaitForDelete = types.ObjectNull(map[string]attr.Type{
+			"timeout":       types.Int64Type,
+			"poll_interval": types.Int64Type,
+		})
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+	// only configured metadata is applied. finalizers and owner references added by the server, e.g.
+	// 'kubernetes.io/pvc-protection', were planned by the server-side dry-run, so the planned values are stored
+	if !plannedFinalizers.IsUnknown() {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("metadata").AtName("finalizers"), plannedFinalizers)...)
+	}
+	if !plannedOwnerReferences.IsUnknown() {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("metadata").AtName("owner_references"), plannedOwnerReferences)...)
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("status"), statusResponse.Status)...)
+	if response.Identity != nil {
+		response.Diagnostics.Append(response.Identity.SetAttribute(ctx, path.Root("namespace"), model.Metadata.Namespace)...)
+		response.Diagnostics.Append(response.Identity.SetAttribute(ctx, path.Root("name"), model.Metadata.Name)...)
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !model.WaitForUpsert.IsNull() && !model.WaitForUpsert.IsUnknown() {
+		response.Diagnostics.Append(utilities.WaitForUpsert(ctx, r.kubernetesClient.
+			Resource(groupVersionResource).
+			Namespace(model.Metadata.Namespace), model.Metadata.Name, model.WaitForUpsert)...)
+	}
+	if model.WaitForReady.ValueBool() && !response.Diagnostics.HasError() {
+		response.Diagnostics.Append(utilities.WaitForReady(ctx, r.kubernetesClient.
+			Resource(groupVersionResource).
+			Namespace(model.Metadata.Namespace), model.Metadata.Name, model.WaitForReadyTimeout)...)
+	}
+}
+
+func (r *AutoscalingHorizontalPodAutoscalerV1Resource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	tflog.Debug(ctx, "Read resource k8s_autoscaling_horizontal_pod_autoscaler_v1")
+
+	if r.kubernetesClient == nil {
+		response.Diagnostics.Append(utilities.UnknownProviderConfigurationWarning())
+		return
+	}
+
+	var data AutoscalingHorizontalPodAutoscalerV1ResourceData
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	getResponse, err := r.kubernetesClient.
+		Resource(r.groupVersionResource()).
+		Namespace(data.Metadata.Namespace).
+		Get(ctx, data.Metadata.Name, meta.GetOptions{})
+	if utilities.IsNotFound(err) {
+		tflog.Info(ctx, "Removing resource from state since the object no longer exists")
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.Append(utilities.GetNamespacedResourceError(err, data.Metadata.Name, data.Metadata.Namespace))
+		return
+	}
+	stateBytes, err := json.Marshal(data)
+	if err != nil {
+		response.Diagnostics.Append(utilities.JsonMarshalError(err))
+		return
+	}
+	fieldManager := r.fieldManager
+	if !data.FieldManager.IsNull() && !data.FieldManager.IsUnknown() {
+		fieldManager = data.FieldManager.ValueString()
+	}
+	ownedBytes, err := utilities.OwnedFieldsJSON(getResponse, fieldManager, stateBytes)
+	if err != nil {
+		response.Diagnostics.Append(utilities.ManagedFieldsError(err))
+		return
+	}
+
+	var readResponse AutoscalingHorizontalPodAutoscalerV1ResourceData
+	err = json.Unmarshal(ownedBytes, &readResponse)
+	if err != nil {
+		response.Diagnostics.Append(utilities.JsonUnmarshalError(err))
+		return
+	}
+	getBytes, err := getResponse.MarshalJSON()
+	if err != nil {
+		response.Diagnostics.Append(utilities.MarshalJsonError(err))
+		return
+	}
+	var statusResponse struct {
+		Status *AutoscalingHorizontalPodAutoscalerV1ResourceStatus `json:"status,omitempty"`
+	}
+	err = json.Unmarshal(getBytes, &statusResponse)
+	if err != nil {
+		response.Diagnostics.Append(utilities.JsonUnmarshalError(err))
+		return
+	}
+
+	data.Metadata = readResponse.Metadata
+	data.Metadata.Uid = types.StringValue(string(getResponse.GetUID()))
+	data.Metadata.ResourceVersion = types.StringValue(getResponse.GetResourceVersion())
+	data.Metadata.Generation = types.Int64Value(getResponse.GetGeneration())
+	data.Metadata.CreationTimestamp = types.StringValue(getResponse.GetCreationTimestamp().UTC().Format(time.RFC3339))
+	data.Spec = readResponse.Spec
+	if data.ForceConflicts.IsUnknown() {
+		data.ForceConflicts = types.BoolNull()
+	}
+	if data.FieldManager.IsUnknown() {
+		data.FieldManager = types.StringNull()
+	}
+	if data.DeletionPropagation.IsUnknown() {
+		data.DeletionPropagation = types.StringNull()
+	}
+	if data.DeleteBehavior.IsUnknown() {
+		data.DeleteBehavior = types.StringNull()
+	}
+	if data.WaitForUpsert.IsUnknown() {
+		data.WaitForUpsert = types.ListNull(types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"jsonpath":      types.StringType,
+				"value":         types.StringType,
+				"timeout":       types.Int64Type,
+				"poll_interval": types.Int64Type,
+			},
+		})
+	}
+	if data.WaitForDelete.IsUnknown() {
+		data.WaitForDelete = types.ObjectNull(map[string]attr.Type{
+			"timeout":       types.Int64Type,
+			"poll_interval": types.Int64Type,
+		})
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("status"), statusResponse.Status)...)
+	if response.Identity != nil {
+		response.Diagnostics.Append(response.Identity.SetAttribute(ctx, path.Root("namespace"), data.Metadata.Namespace)...)
+		response.Diagnostics.Append(response.Identity.SetAttribute(ctx, path.Root("name"), data.Metadata.Name)...)
+	}
+}
+
+func (r *AutoscalingHorizontalPodAutoscalerV1Resource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Update resource k8s_autoscaling_horizontal_pod_autoscaler_v1")
+
+	if r.kubernetesClient == nil {
+		response.Diagnostics.Append(utilities.UnknownProviderConfigurationError())
+		return
+	}
+
+	var plannedFinalizers, plannedOwnerReferences types.List
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("metadata").AtName("finalizers"), &plannedFinalizers)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("metadata").AtName("owner_references"), &plannedOwnerReferences)...)
+	plan, diags := utilities.NullUnconfiguredAttributes(request.Plan, request.Config,
+		path.Root("metadata").AtName("labels"),
+		path.Root("metadata").AtName("annotations"),
+		path.Root("metadata").AtName("finalizers"),
+		path.Root("metadata").AtName("owner_references"))
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var model AutoscalingHorizontalPodAutoscalerV1ResourceData
+	response.Diagnostics.Append(plan.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	model.ApiVersion = pointer.String("autoscaling/v1")
+	model.Kind = pointer.String("HorizontalPodAutoscaler")
+
+	bytes, err := json.Marshal(model)
+	if err != nil {
+		response.Diagnostics.Append(utilities.JsonMarshalError(err))
+		return
+	}
+
+	forceConflicts := r.forceConflicts
+	if !model.ForceConflicts.IsNull() && !model.ForceConflicts.IsUnknown() {
+		forceConflicts = model.ForceConflicts.ValueBool()
+	}
+	fieldManager := r.fieldManager
+	if !model.FieldManager.IsNull() && !model.FieldManager.IsUnknown() {
+		fieldManager = model.FieldManager.ValueString()
+	}
+	patchOptions := meta.PatchOptions{
+		FieldManager:    fieldManager,
+		Force:           pointer.Bool(forceConflicts),
+		FieldValidation: "Strict",
+	}
+
+	groupVersionResource := r.groupVersionResource()
+	patchResponse, err := r.kubernetesClient.
+		Resource(groupVersionResource).
+		Namespace(model.Metadata.Namespace).
+		Patch(ctx, model.Metadata.Name, k8sTypes.ApplyPatchType, bytes, patchOptions)
+	if err != nil {
+		response.Diagnostics.Append(utilities.PatchErrors(err)...)
+		return
+	}
+
+	ownedBytes, err := utilities.OwnedFieldsJSON(patchResponse, fieldManager, bytes)
+	if err != nil {
+		response.Diagnostics.Append(utilities.ManagedFieldsError(err))
+		return
+	}
+
+	var readResponse AutoscalingHorizontalPodAutoscalerV1ResourceData
+	err = json.Unmarshal(ownedBytes, &readResponse)
+	if err != nil {
+		response.Diagnostics.Append(utilities.JsonUnmarshalError(err))
+		return
+	}
+	patchBytes, err := patchResponse.MarshalJSON()
+	if err != nil {
+		response.Diagnostics.Append(utilities.MarshalJsonError(err))
+		return
+	}
+	var statusResponse struct {
+		Status *AutoscalingHorizontalPodAutoscalerV1ResourceStatus `json:"status,omitempty"`
+	}
+	err = json.Unmarshal(patchBytes, &statusResponse)
+	if err != nil {
+		response.Diagnostics.Append(utilities.JsonUnmarshalError(err))
+		return
+	}
+
+	model.Metadata = readResponse.Metadata
+	model.Metadata.Uid = types.StringValue(string(patchResponse.GetUID()))
+	model.Metadata.ResourceVersion = types.StringValue(patchResponse.GetResourceVersion())
+	model.Metadata.Generation = types.Int64Value(patchResponse.GetGeneration())
+	model.Metadata.CreationTimestamp = types.StringValue(patchResponse.GetCreationTimestamp().UTC().Format(time.RFC3339))
+	model.Spec = readResponse.Spec
+	if model.ForceConflicts.IsUnknown() {
+		model.ForceConflicts = types.BoolNull()
+	}
+	if model.FieldManager.IsUnknown() {
+		model.FieldManager = types.StringNull()
+	}
+	if model.DeletionPropagation.IsUnknown() {
+		model.DeletionPropagation = types.StringNull()
+	}
+	if model.DeleteBehavior.IsUnknown() {
+		model.DeleteBehavior = types.StringNull()
+	}
+	if model.WaitForUpsert.IsUnknown() {
+		model.WaitForUpsert = types.ListNull(types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"jsonpath":      types.StringType,
+				"value":         types.StringType,
+				"timeout":       types.Int64Type,
+				"poll_interval": types.Int64Type,
+			},
+		})
+	}
+	if model.WaitForDelete.IsUnknown() {
+		model.WaitForDelete = types.ObjectNull(map[string]attr.Type{
+			"timeout":       types.Int64Type,
+			"poll_interval": types.Int64Type,
+		})
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+	// only configured metadata is applied. finalizers and owner references added by the server, e.g.
+	// 'kubernetes.io/pvc-protection', were planned by the server-side dry-run, so the planned values are stored
+	if !plannedFinalizers.IsUnknown() {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("metadata").AtName("finalizers"), plannedFinalizers)...)
+	}
+	if !plannedOwnerReferences.IsUnknown() {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("metadata").AtName("owner_references"), plannedOwnerReferences)...)
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("status"), statusResponse.Status)...)
+	if response.Identity != nil {
+		response.Diagnostics.Append(response.Identity.SetAttribute(ctx, path.Root("namespace"), model.Metadata.Namespace)...)
+		response.Diagnostics.Append(response.Identity.SetAttribute(ctx, path.Root("name"), model.Metadata.Name)...)
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !model.WaitForUpsert.IsNull() && !model.WaitForUpsert.IsUnknown() {
+		response.Diagnostics.Append(utilities.WaitForUpsert(ctx, r.kubernetesClient.
+			Resource(groupVersionResource).
+			Namespace(model.Metadata.Namespace), model.Metadata.Name, model.WaitForUpsert)...)
+	}
+	if model.WaitForReady.ValueBool() && !response.Diagnostics.HasError() {
+		response.Diagnostics.Append(utilities.WaitForReady(ctx, r.kubernetesClient.
+			Resource(groupVersionResource).
+			Namespace(model.Metadata.Namespace), model.Metadata.Name, model.WaitForReadyTimeout)...)
+	}
+}
+
+func (r *AutoscalingHorizontalPodAutoscalerV1Resource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete resource k8s_autoscaling_horizontal_pod_autoscaler_v1")
+
+	if r.kubernetesClient == nil {
+		response.Diagnostics.Append(utilities.UnknownProviderConfigurationError())
+		return
+	}
+
+	var data AutoscalingHorizontalPodAutoscalerV1ResourceData
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	groupVersionResource := r.groupVersionResource()
+	switch data.DeleteBehavior.ValueString() {
+	case "orphan":
+		tflog.Info(ctx, "Removing resource from state without deleting the object from the cluster")
+		return
+	case "abandon_if_annotated":
+		liveObject, err := r.kubernetesClient.
+			Resource(groupVersionResource).
+			Namespace(data.Metadata.Namespace).
+			Get(ctx, data.Metadata.Name, meta.GetOptions{})
+		if err != nil && !utilities.IsNotFound(err) {
+			response.Diagnostics.Append(utilities.GetNamespacedResourceError(err, data.Metadata.Name, data.Metadata.Namespace))
+			return
+		}
+		if utilities.IsDeletionProtected(liveObject) {
+			response.Diagnostics.Append(utilities.DeletionProtectedError())
+			return
+		}
+	}
+
+	deleteOptions := meta.DeleteOptions{}
+	if !data.DeletionPropagation.IsNull() && !data.DeletionPropagation.IsUnknown() {
+		deleteOptions.PropagationPolicy = utilities.MapDeletionPropagation(data.DeletionPropagation.ValueString())
+	}
+
+	err := r.kubernetesClient.
+		Resource(groupVersionResource).
+		Namespace(data.Metadata.Namespace).
+		Delete(ctx, data.Metadata.Name, deleteOptions)
+	if utilities.IsDeletionError(err) {
+		response.Diagnostics.Append(utilities.DeleteError(err))
+		return
+	}
+
+	if !data.WaitForDelete.IsNull() && !data.WaitForDelete.IsUnknown() {
+		response.Diagnostics.Append(utilities.WaitForDelete(ctx, r.kubernetesClient.
+			Resource(groupVersionResource).
+			Namespace(data.Metadata.Namespace), data.Metadata.Name, data.WaitForDelete.Attributes())...)
+	}
+}
+
+func (r *AutoscalingHorizontalPodAutoscalerV1Resource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "ModifyPlan resource k8s_autoscaling_horizontal_pod_autoscaler_v1")
+
+	if request.Plan.Raw.IsNull() || r.kubernetesClient == nil {
+		return
+	}
+
+	if !utilities.IsKindServed(r.restMapper, "autoscaling/v1", "HorizontalPodAutoscaler") {
+		if request.ClientCapabilities.DeferralAllowed {
+			tflog.Debug(ctx, "Deferring plan since the cluster does not serve HorizontalPodAutoscaler yet")
+			response.Deferred = &resource.Deferred{Reason: resource.DeferredReasonAbsentPrereq}
+			return
+		}
+		response.Diagnostics.Append(utilities.MissingKindError(r.kubernetesContext, k8sSchema.GroupVersionResource{Group: "autoscaling", Version: "v1", Resource: "horizontalpodautoscalers"}))
+		return
+	}
+
+	var model AutoscalingHorizontalPodAutoscalerV1ResourceData
+	if diags := request.Config.Get(ctx, &model); diags.HasError() {
+		tflog.Debug(ctx, "Skipping server-side dry-run since the configuration contains unknown values")
+		return
+	}
+
+	model.ApiVersion = pointer.String("autoscaling/v1")
+	model.Kind = pointer.String("HorizontalPodAutoscaler")
+
+	bytes, err := json.Marshal(model)
+	if err != nil {
+		response.Diagnostics.Append(utilities.JsonMarshalError(err))
+		return
+	}
+
+	forceConflicts := r.forceConflicts
+	if !model.ForceConflicts.IsNull() && !model.ForceConflicts.IsUnknown() {
+		forceConflicts = model.ForceConflicts.ValueBool()
+	}
+	fieldManager := r.fieldManager
+	if !model.FieldManager.IsNull() && !model.FieldManager.IsUnknown() {
+		fieldManager = model.FieldManager.ValueString()
+	}
+	patchOptions := meta.PatchOptions{
+		FieldManager:    fieldManager,
+		Force:           pointer.Bool(forceConflicts),
+		FieldValidation: "Strict",
+		DryRun:          []string{meta.DryRunAll},
+	}
+
+	patchResponse, err := r.kubernetesClient.
+		Resource(r.groupVersionResource()).
+		Namespace(model.Metadata.Namespace).
+		Patch(ctx, model.Metadata.Name, k8sTypes.ApplyPatchType, bytes, patchOptions)
+	if utilities.IsNotFound(err) {
+		tflog.Debug(ctx, "Skipping server-side dry-run since a dependency of the resource does not exist yet", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		response.Diagnostics.Append(utilities.DryRunPatchErrors(err)...)
+		return
+	}
+
+	patchBytes, err := patchResponse.MarshalJSON()
+	if err != nil {
+		response.Diagnostics.Append(utilities.MarshalJsonError(err))
+		return
+	}
+	var dryRunResponse AutoscalingHorizontalPodAutoscalerV1ResourceData
+	err = json.Unmarshal(patchBytes, &dryRunResponse)
+	if err != nil {
+		response.Diagnostics.Append(utilities.JsonUnmarshalError(err))
+		return
+	}
+	ownedBytes, err := utilities.OwnedFieldsJSON(patchResponse, fieldManager, bytes)
+	if err != nil {
+		response.Diagnostics.Append(utilities.ManagedFieldsError(err))
+		return
+	}
+	var ownedResponse AutoscalingHorizontalPodAutoscalerV1ResourceData
+	err = json.Unmarshal(ownedBytes, &ownedResponse)
+	if err != nil {
+		response.Diagnostics.Append(utilities.JsonUnmarshalError(err))
+		return
+	}
+
+	// only metadata which the server sets identically during the actual apply is planned from the dry-run. Create and
+	// Update apply configured metadata only, store the labels and annotations owned by the field manager and keep the
+	// planned finalizers and owner references, thus labels and annotations of other managers are not planned. uid,
+	// resource_version, creation_timestamp and status differ between dry-run and apply, and server-defaulted spec
+	// fields are not stored at all since the state only contains fields owned by the field manager
+	var plannedLabels, plannedAnnotations types.Map
+	var plannedFinalizers, plannedOwnerReferences types.List
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("metadata").AtName("labels"), &plannedLabels)...)
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("metadata").AtName("annotations"), &plannedAnnotations)...)
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("metadata").AtName("finalizers"), &plannedFinalizers)...)
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("metadata").AtName("owner_references"), &plannedOwnerReferences)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if plannedLabels.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("metadata").AtName("labels"), ownedResponse.Metadata.Labels)...)
+	}
+	if plannedAnnotations.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("metadata").AtName("annotations"), ownedResponse.Metadata.Annotations)...)
+	}
+	if plannedFinalizers.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("metadata").AtName("finalizers"), dryRunResponse.Metadata.Finalizers)...)
+	}
+	if plannedOwnerReferences.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("metadata").AtName("owner_references"), dryRunResponse.Metadata.OwnerReferences)...)
+	}
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("metadata").AtName("generation"), patchResponse.GetGeneration())...)
+}
+
+func (r *AutoscalingHorizontalPodAutoscalerV1Resource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	var importID utilities.ImportID
+	if request.ID == "" && request.Identity != nil {
+		response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root("namespace"), &importID.Namespace)...)
+		response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root("name"), &importID.Name)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		parsedID, err := utilities.ParseImportID(request.ID, true, r.kubernetesContext)
+		if err != nil {
+			response.Diagnostics.Append(utilities.ImportIDError(request.ID, "namespace/name", err))
+			return
+		}
+		importID = parsedID
+	}
+
+	resolvedID, err := utilities.ResolveImportID(ctx, importID, r.kubernetesClient,
+		r.groupVersionResource(),
+		r.kubernetesContext, "autoscaling/v1", "HorizontalPodAutoscaler")
+	if err != nil {
+		response.Diagnostics.Append(utilities.ImportIDError(request.ID, "namespace/name", err))
+		return
+	}
+
+	tflog.Trace(ctx, "parsed import ID", map[string]interface{}{
+		"context":   resolvedID.Context,
+		"namespace": resolvedID.Namespace,
+		"name":      resolvedID.Name,
+		"uid":       resolvedID.UID,
+	})
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("metadata").AtName("namespace"), resolvedID.Namespace)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("metadata").AtName("name"), resolvedID.Name)...)
+	if response.Identity != nil {
+		response.Diagnostics.Append(response.Identity.SetAttribute(ctx, path.Root("namespace"), resolvedID.Namespace)...)
+		response.Diagnostics.Append(response.Identity.SetAttribute(ctx, path.Root("name"), resolvedID.Name)...)
+	}
+}
@@ -0,0 +1,2231 @@
+/*
+* SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+* SPDX-License-Identifier: 0BSD
+ */
+
+package core_v1
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/metio/terraform-provider-k8s/internal/utilities"
+	"github.com/metio/terraform-provider-k8s/internal/validators"
+	apiMeta "k8s.io/apimachinery/pkg/api/meta"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+var (
+	_ datasource.DataSource                   = &PersistentVolumeV1ListDataSource{}
+	_ datasource.DataSourceWithConfigure      = &PersistentVolumeV1ListDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &PersistentVolumeV1ListDataSource{}
+)
+
+func NewPersistentVolumeV1ListDataSource() datasource.DataSource {
+	return &PersistentVolumeV1ListDataSource{}
+}
+
+type PersistentVolumeV1ListDataSource struct {
+	kubernetesClient  dynamic.Interface
+	kubernetesContext string
+	restMapper        apiMeta.ResettableRESTMapper
+}
+
+type PersistentVolumeV1ListDataSourceData struct {
+	Namespace     types.String `tfsdk:"namespace" json:"-"`
+	LabelSelector types.String `tfsdk:"label_selector" json:"-"`
+	FieldSelector types.String `tfsdk:"field_selector" json:"-"`
+	Limit         types.Int64  `tfsdk:"limit" json:"-"`
+
+	Items []struct {
+		ApiVersion *string `tfsdk:"api_version" json:"apiVersion"`
+		Kind       *string `tfsdk:"kind" json:"kind"`
+
+		Metadata struct {
+			Name            string            `tfsdk:"name" json:"name"`
+			Namespace       string            `tfsdk:"namespace" json:"namespace"`
+			Labels          map[string]string `tfsdk:"labels" json:"labels,omitempty"`
+			Annotations     map[string]string `tfsdk:"annotations" json:"annotations,omitempty"`
+			GenerateName    *string           `tfsdk:"generate_name" json:"generateName,omitempty"`
+			Finalizers      []string          `tfsdk:"finalizers" json:"finalizers,omitempty"`
+			OwnerReferences []struct {
+				ApiVersion         string `tfsdk:"api_version" json:"apiVersion"`
+				Kind               string `tfsdk:"kind" json:"kind"`
+				Name               string `tfsdk:"name" json:"name"`
+				Uid                string `tfsdk:"uid" json:"uid"`
+				Controller         *bool  `tfsdk:"controller" json:"controller,omitempty"`
+				BlockOwnerDeletion *bool  `tfsdk:"block_owner_deletion" json:"blockOwnerDeletion,omitempty"`
+			} `tfsdk:"owner_references" json:"ownerReferences,omitempty"`
+			Uid               *string `tfsdk:"uid" json:"uid,omitempty"`
+			ResourceVersion   *string `tfsdk:"resource_version" json:"resourceVersion,omitempty"`
+			Generation        *int64  `tfsdk:"generation" json:"generation,omitempty"`
+			CreationTimestamp *string `tfsdk:"creation_timestamp" json:"creationTimestamp,omitempty"`
+		} `tfsdk:"metadata" json:"metadata"`
+
+		Status *struct {
+			LastPhaseTransitionTime *string `tfsdk:"last_phase_transition_time" json:"lastPhaseTransitionTime,omitempty"`
+			Message                 *string `tfsdk:"message" json:"message,omitempty"`
+			Phase                   *string `tfsdk:"phase" json:"phase,omitempty"`
+			Reason                  *string `tfsdk:"reason" json:"reason,omitempty"`
+		} `tfsdk:"status" json:"status,omitempty"`
+
+		Spec *struct {
+			AccessModes          *[]string `tfsdk:"access_modes" json:"accessModes,omitempty"`
+			AwsElasticBlockStore *struct {
+				FsType    *string `tfsdk:"fs_type" json:"fsType,omitempty"`
+				Partition *int64  `tfsdk:"partition" json:"partition,omitempty"`
+				ReadOnly  *bool   `tfsdk:"read_only" json:"readOnly,omitempty"`
+				VolumeID  *string `tfsdk:"volume_id" json:"volumeID,omitempty"`
+			} `tfsdk:"aws_elastic_block_store" json:"awsElasticBlockStore,omitempty"`
+			AzureDisk *struct {
+				CachingMode *string `tfsdk:"caching_mode" json:"cachingMode,omitempty"`
+				DiskName    *string `tfsdk:"disk_name" json:"diskName,omitempty"`
+				DiskURI     *string `tfsdk:"disk_uri" json:"diskURI,omitempty"`
+				FsType      *string `tfsdk:"fs_type" json:"fsType,omitempty"`
+				Kind        *string `tfsdk:"kind" json:"kind,omitempty"`
+				ReadOnly    *bool   `tfsdk:"read_only" json:"readOnly,omitempty"`
+			} `tfsdk:"azure_disk" json:"azureDisk,omitempty"`
+			AzureFile *struct {
+				ReadOnly        *bool   `tfsdk:"read_only" json:"readOnly,omitempty"`
+				SecretName      *string `tfsdk:"secret_name" json:"secretName,omitempty"`
+				SecretNamespace *string `tfsdk:"secret_namespace" json:"secretNamespace,omitempty"`
+				ShareName       *string `tfsdk:"share_name" json:"shareName,omitempty"`
+			} `tfsdk:"azure_file" json:"azureFile,omitempty"`
+			Capacity *map[string]string `tfsdk:"capacity" json:"capacity,omitempty"`
+			Cephfs   *struct {
+				Monitors   *[]string `tfsdk:"monitors" json:"monitors,omitempty"`
+				Path       *string   `tfsdk:"path" json:"path,omitempty"`
+				ReadOnly   *bool     `tfsdk:"read_only" json:"readOnly,omitempty"`
+				SecretFile *string   `tfsdk:"secret_file" json:"secretFile,omitempty"`
+				SecretRef  *struct {
+					Name      *string `tfsdk:"name" json:"name,omitempty"`
+					Namespace *string `tfsdk:"namespace" json:"namespace,omitempty"`
+				} `tfsdk:"secret_ref" json:"secretRef,omitempty"`
+				User *string `tfsdk:"user" json:"user,omitempty"`
+			} `tfsdk:"cephfs" json:"cephfs,omitempty"`
+			Cinder *struct {
+				FsType    *string `tfsdk:"fs_type" json:"fsType,omitempty"`
+				ReadOnly  *bool   `tfsdk:"read_only" json:"readOnly,omitempty"`
+				SecretRef *struct {
+					Name      *string `tfsdk:"name" json:"name,omitempty"`
+					Namespace *string `tfsdk:"namespace" json:"namespace,omitempty"`
+				} `tfsdk:"secret_ref" json:"secretRef,omitempty"`
+				VolumeID *string `tfsdk:"volume_id" json:"volumeID,omitempty"`
+			} `tfsdk:"cinder" json:"cinder,omitempty"`
+			ClaimRef *struct {
+				ApiVersion      *string `tfsdk:"api_version" json:"apiVersion,omitempty"`
+				FieldPath       *string `tfsdk:"field_path" json:"fieldPath,omitempty"`
+				Kind            *string `tfsdk:"kind" json:"kind,omitempty"`
+				Name            *string `tfsdk:"name" json:"name,omitempty"`
+				Namespace       *string `tfsdk:"namespace" json:"namespace,omitempty"`
+				ResourceVersion *string `tfsdk:"resource_version" json:"resourceVersion,omitempty"`
+				Uid             *string `tfsdk:"uid" json:"uid,omitempty"`
+			} `tfsdk:"claim_ref" json:"claimRef,omitempty"`
+			Csi *struct {
+				ControllerExpandSecretRef *struct {
+					Name      *string `tfsdk:"name" json:"name,omitempty"`
+					Namespace *string `tfsdk:"namespace" json:"namespace,omitempty"`
+				} `tfsdk:"controller_expand_secret_ref" json:"controllerExpandSecretRef,omitempty"`
+				ControllerPublishSecretRef *struct {
+					Name      *string `tfsdk:"name" json:"name,omitempty"`
+					Namespace *string `tfsdk:"namespace" json:"namespace,omitempty"`
+				} `tfsdk:"controller_publish_secret_ref" json:"controllerPublishSecretRef,omitempty"`
+				Driver              *string `tfsdk:"driver" json:"driver,omitempty"`
+				FsType              *string `tfsdk:"fs_type" json:"fsType,omitempty"`
+				NodeExpandSecretRef *struct {
+					Name      *string `tfsdk:"name" json:"name,omitempty"`
+					Namespace *string `tfsdk:"namespace" json:"namespace,omitempty"`
+				} `tfsdk:"node_expand_secret_ref" json:"nodeExpandSecretRef,omitempty"`
+				NodePublishSecretRef *struct {
+					Name      *string `tfsdk:"name" json:"name,omitempty"`
+					Namespace *string `tfsdk:"namespace" json:"namespace,omitempty"`
+				} `tfsdk:"node_publish_secret_ref" json:"nodePublishSecretRef,omitempty"`
+				NodeStageSecretRef *struct {
+					Name      *string `tfsdk:"name" json:"name,omitempty"`
+					Namespace *string `tfsdk:"namespace" json:"namespace,omitempty"`
+				} `tfsdk:"node_stage_secret_ref" json:"nodeStageSecretRef,omitempty"`
+				ReadOnly         *bool              `tfsdk:"read_only" json:"readOnly,omitempty"`
+				VolumeAttributes *map[string]string `tfsdk:"volume_attributes" json:"volumeAttributes,omitempty"`
+				VolumeHandle     *string            `tfsdk:"volume_handle" json:"volumeHandle,omitempty"`
+			} `tfsdk:"csi" json:"csi,omitempty"`
+			Fc *struct {
+				FsType     *string   `tfsdk:"fs_type" json:"fsType,omitempty"`
+				Lun        *int64    `tfsdk:"lun" json:"lun,omitempty"`
+				ReadOnly   *bool     `tfsdk:"read_only" json:"readOnly,omitempty"`
+				TargetWWNs *[]string `tfsdk:"target_ww_ns" json:"targetWWNs,omitempty"`
+				Wwids      *[]string `tfsdk:"wwids" json:"wwids,omitempty"`
+			} `tfsdk:"fc" json:"fc,omitempty"`
+			FlexVolume *struct {
+				Driver    *string            `tfsdk:"driver" json:"driver,omitempty"`
+				FsType    *string            `tfsdk:"fs_type" json:"fsType,omitempty"`
+				Options   *map[string]string `tfsdk:"options" json:"options,omitempty"`
+				ReadOnly  *bool              `tfsdk:"read_only" json:"readOnly,omitempty"`
+				SecretRef *struct {
+					Name      *string `tfsdk:"name" json:"name,omitempty"`
+					Namespace *string `tfsdk:"namespace" json:"namespace,omitempty"`
+				} `tfsdk:"secret_ref" json:"secretRef,omitempty"`
+			} `tfsdk:"flex_volume" json:"flexVolume,omitempty"`
+			Flocker *struct {
+				DatasetName *string `tfsdk:"dataset_name" json:"datasetName,omitempty"`
+				DatasetUUID *string `tfsdk:"dataset_uuid" json:"datasetUUID,omitempty"`
+			} `tfsdk:"flocker" json:"flocker,omitempty"`
+			GcePersistentDisk *struct {
+				FsType    *string `tfsdk:"fs_type" json:"fsType,omitempty"`
+				Partition *int64  `tfsdk:"partition" json:"partition,omitempty"`
+				PdName    *string `tfsdk:"pd_name" json:"pdName,omitempty"`
+				ReadOnly  *bool   `tfsdk:"read_only" json:"readOnly,omitempty"`
+			} `tfsdk:"gce_persistent_disk" json:"gcePersistentDisk,omitempty"`
+			Glusterfs *struct {
+				Endpoints          *string `tfsdk:"endpoints" json:"endpoints,omitempty"`
+				EndpointsNamespace *string `tfsdk:"endpoints_namespace" json:"endpointsNamespace,omitempty"`
+				Path               *string `tfsdk:"path" json:"path,omitempty"`
+				ReadOnly           *bool   `tfsdk:"read_only" json:"readOnly,omitempty"`
+			} `tfsdk:"glusterfs" json:"glusterfs,omitempty"`
+			HostPath *struct {
+				Path *string `tfsdk:"path" json:"path,omitempty"`
+				Type *string `tfsdk:"type" json:"type,omitempty"`
+			} `tfsdk:"host_path" json:"hostPath,omitempty"`
+			Iscsi *struct {
+				ChapAuthDiscovery *bool     `tfsdk:"chap_auth_discovery" json:"chapAuthDiscovery,omitempty"`
+				ChapAuthSession   *bool     `tfsdk:"chap_auth_session" json:"chapAuthSession,omitempty"`
+				FsType            *string   `tfsdk:"fs_type" json:"fsType,omitempty"`
+				InitiatorName     *string   `tfsdk:"initiator_name" json:"initiatorName,omitempty"`
+				Iqn               *string   `tfsdk:"iqn" json:"iqn,omitempty"`
+				IscsiInterface    *string   `tfsdk:"iscsi_interface" json:"iscsiInterface,omitempty"`
+				Lun               *int64    `tfsdk:"lun" json:"lun,omitempty"`
+				Portals           *[]string `tfsdk:"portals" json:"portals,omitempty"`
+				ReadOnly          *bool     `tfsdk:"read_only" json:"readOnly,omitempty"`
+				SecretRef         *struct {
+					Name      *string `tfsdk:"name" json:"name,omitempty"`
+					Namespace *string `tfsdk:"namespace" json:"namespace,omitempty"`
+				} `tfsdk:"secret_ref" json:"secretRef,omitempty"`
+				TargetPortal *string `tfsdk:"target_portal" json:"targetPortal,omitempty"`
+			} `tfsdk:"iscsi" json:"iscsi,omitempty"`
+			Local *struct {
+				FsType *string `tfsdk:"fs_type" json:"fsType,omitempty"`
+				Path   *string `tfsdk:"path" json:"path,omitempty"`
+			} `tfsdk:"local" json:"local,omitempty"`
+			MountOptions *[]string `tfsdk:"mount_options" json:"mountOptions,omitempty"`
+			Nfs          *struct {
+				Path     *string `tfsdk:"path" json:"path,omitempty"`
+				ReadOnly *bool   `tfsdk:"read_only" json:"readOnly,omitempty"`
+				Server   *string `tfsdk:"server" json:"server,omitempty"`
+			} `tfsdk:"nfs" json:"nfs,omitempty"`
+			NodeAffinity *struct {
+				Required *struct {
+					NodeSelectorTerms *[]struct {
+						MatchExpressions *[]struct {
+							Key      *string   `tfsdk:"key" json:"key,omitempty"`
+							Operator *string   `tfsdk:"operator" json:"operator,omitempty"`
+							Values   *[]string `tfsdk:"values" json:"values,omitempty"`
+						} `tfsdk:"match_expressions" json:"matchExpressions,omitempty"`
+						MatchFields *[]struct {
+							Key      *string   `tfsdk:"key" json:"key,omitempty"`
+							Operator *string   `tfsdk:"operator" json:"operator,omitempty"`
+							Values   *[]string `tfsdk:"values" json:"values,omitempty"`
+						} `tfsdk:"match_fields" json:"matchFields,omitempty"`
+					} `tfsdk:"node_selector_terms" json:"nodeSelectorTerms,omitempty"`
+				} `tfsdk:"required" json:"required,omitempty"`
+			} `tfsdk:"node_affinity" json:"nodeAffinity,omitempty"`
+			PersistentVolumeReclaimPolicy *string `tfsdk:"persistent_volume_reclaim_policy" json:"persistentVolumeReclaimPolicy,omitempty"`
+			PhotonPersistentDisk          *struct {
+				FsType *string `tfsdk:"fs_type" json:"fsType,omitempty"`
+				PdID   *string `tfsdk:"pd_id" json:"pdID,omitempty"`
+			} `tfsdk:"photon_persistent_disk" json:"photonPersistentDisk,omitempty"`
+			PortworxVolume *struct {
+				FsType   *string `tfsdk:"fs_type" json:"fsType,omitempty"`
+				ReadOnly *bool   `tfsdk:"read_only" json:"readOnly,omitempty"`
+				VolumeID *string `tfsdk:"volume_id" json:"volumeID,omitempty"`
+			} `tfsdk:"portworx_volume" json:"portworxVolume,omitempty"`
+			Quobyte *struct {
+				Group    *string `tfsdk:"group" json:"group,omitempty"`
+				ReadOnly *bool   `tfsdk:"read_only" json:"readOnly,omitempty"`
+				Registry *string `tfsdk:"registry" json:"registry,omitempty"`
+				Tenant   *string `tfsdk:"tenant" json:"tenant,omitempty"`
+				User     *string `tfsdk:"user" json:"user,omitempty"`
+				Volume   *string `tfsdk:"volume" json:"volume,omitempty"`
+			} `tfsdk:"quobyte" json:"quobyte,omitempty"`
+			Rbd *struct {
+				FsType    *string   `tfsdk:"fs_type" json:"fsType,omitempty"`
+				Image     *string   `tfsdk:"image" json:"image,omitempty"`
+				Keyring   *string   `tfsdk:"keyring" json:"keyring,omitempty"`
+				Monitors  *[]string `tfsdk:"monitors" json:"monitors,omitempty"`
+				Pool      *string   `tfsdk:"pool" json:"pool,omitempty"`
+				ReadOnly  *bool     `tfsdk:"read_only" json:"readOnly,omitempty"`
+				SecretRef *struct {
+					Name      *string `tfsdk:"name" json:"name,omitempty"`
+					Namespace *string `tfsdk:"namespace" json:"namespace,omitempty"`
+				} `tfsdk:"secret_ref" json:"secretRef,omitempty"`
+				User *string `tfsdk:"user" json:"user,omitempty"`
+			} `tfsdk:"rbd" json:"rbd,omitempty"`
+			ScaleIO *struct {
+				FsType           *string `tfsdk:"fs_type" json:"fsType,omitempty"`
+				Gateway          *string `tfsdk:"gateway" json:"gateway,omitempty"`
+				ProtectionDomain *string `tfsdk:"protection_domain" json:"protectionDomain,omitempty"`
+				ReadOnly         *bool   `tfsdk:"read_only" json:"readOnly,omitempty"`
+				SecretRef        *struct {
+					Name      *string `tfsdk:"name" json:"name,omitempty"`
+					Namespace *string `tfsdk:"namespace" json:"namespace,omitempty"`
+				} `tfsdk:"secret_ref" json:"secretRef,omitempty"`
+				SslEnabled  *bool   `tfsdk:"ssl_enabled" json:"sslEnabled,omitempty"`
+				StorageMode *string `tfsdk:"storage_mode" json:"storageMode,omitempty"`
+				StoragePool *string `tfsdk:"storage_pool" json:"storagePool,omitempty"`
+				System      *string `tfsdk:"system" json:"system,omitempty"`
+				VolumeName  *string `tfsdk:"volume_name" json:"volumeName,omitempty"`
+			} `tfsdk:"scale_io" json:"scaleIO,omitempty"`
+			StorageClassName *string `tfsdk:"storage_class_name" json:"storageClassName,omitempty"`
+			Storageos        *struct {
+				FsType    *string `tfsdk:"fs_type" json:"fsType,omitempty"`
+				ReadOnly  *bool   `tfsdk:"read_only" json:"readOnly,omitempty"`
+				SecretRef *struct {
+					ApiVersion      *string `tfsdk:"api_version" json:"apiVersion,omitempty"`
+					FieldPath       *string `tfsdk:"field_path" json:"fieldPath,omitempty"`
+					Kind            *string `tfsdk:"kind" json:"kind,omitempty"`
+					Name            *string `tfsdk:"name" json:"name,omitempty"`
+					Namespace       *string `tfsdk:"namespace" json:"namespace,omitempty"`
+					ResourceVersion *string `tfsdk:"resource_version" json:"resourceVersion,omitempty"`
+					Uid             *string `tfsdk:"uid" json:"uid,omitempty"`
+				} `tfsdk:"secret_ref" json:"secretRef,omitempty"`
+				VolumeName      *string `tfsdk:"volume_name" json:"volumeName,omitempty"`
+				VolumeNamespace *string `tfsdk:"volume_namespace" json:"volumeNamespace,omitempty"`
+			} `tfsdk:"storageos" json:"storageos,omitempty"`
+			VolumeAttributesClassName *string `tfsdk:"volume_attributes_class_name" json:"volumeAttributesClassName,omitempty"`
+			VolumeMode                *string `tfsdk:"volume_mode" json:"volumeMode,omitempty"`
+			VsphereVolume             *struct {
+				FsType            *string `tfsdk:"fs_type" json:"fsType,omitempty"`
+				StoragePolicyID   *string `tfsdk:"storage_policy_id" json:"storagePolicyID,omitempty"`
+				StoragePolicyName *string `tfsdk:"storage_policy_name" json:"storagePolicyName,omitempty"`
+				VolumePath        *string `tfsdk:"volume_path" json:"volumePath,omitempty"`
+			} `tfsdk:"vsphere_volume" json:"vsphereVolume,omitempty"`
+		} `tfsdk:"spec" json:"spec,omitempty"`
+	} `tfsdk:"items" json:"items"`
+}
+
+func (r *PersistentVolumeV1ListDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_persistent_volume_v1_list"
+}
+
+func (r *PersistentVolumeV1ListDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description:         "Lists PersistentVolume objects matching the configured selectors. PersistentVolume (PV) is a storage resource provisioned by an administrator. It is analogous to a node. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes",
+		MarkdownDescription: "Lists PersistentVolume objects matching the configured selectors. PersistentVolume (PV) is a storage resource provisioned by an administrator. It is analogous to a node. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes",
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				Description:         "Only list objects in this namespace. Objects of all namespaces are listed if not set.",
+				MarkdownDescription: "Only list objects in this namespace. Objects of all namespaces are listed if not set.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+			},
+
+			"label_selector": schema.StringAttribute{
+				Description:         "Only list objects whose labels match this selector, e.g. 'app=example,tier!=frontend'. See https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors for more details.",
+				MarkdownDescription: "Only list objects whose labels match this selector, e.g. `app=example,tier!=frontend`. See https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#label-selectors for more details.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
+
+			"field_selector": schema.StringAttribute{
+				Description:         "Only list objects whose fields match this selector, e.g. 'metadata.name=example'. See https://kubernetes.io/docs/concepts/overview/working-with-objects/field-selectors/ for more details.",
+				MarkdownDescription: "Only list objects whose fields match this selector, e.g. `metadata.name=example`. See https://kubernetes.io/docs/concepts/overview/working-with-objects/field-selectors/ for more details.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
+
+			"limit": schema.Int64Attribute{
+				Description:         "The maximum number of objects to request from the API server at once. All pages are read until the list is complete. Defaults to 500.",
+				MarkdownDescription: "The maximum number of objects to request from the API server at once. All pages are read until the list is complete. Defaults to `500`.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+
+			"items": schema.ListNestedAttribute{
+				Description:         "The objects matching the configured selectors.",
+				MarkdownDescription: "The objects matching the configured selectors.",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"api_version": schema.StringAttribute{
+							Description:         "The API group of the requested resource.",
+							MarkdownDescription: "The API group of the requested resource.",
+							Required:            false,
+							Optional:            false,
+							Computed:            true,
+						},
+
+						"kind": schema.StringAttribute{
+							Description:         "The type of the requested resource.",
+							MarkdownDescription: "The type of the requested resource.",
+							Required:            false,
+							Optional:            false,
+							Computed:            true,
+						},
+
+						"metadata": schema.SingleNestedAttribute{
+							Description:         "Data that helps uniquely identify this object. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata for more details.",
+							MarkdownDescription: "Data that helps uniquely identify this object. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#metadata for more details.",
+							Required:            false,
+							Optional:            false,
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description:         "Unique identifier for this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names for more details.",
+									MarkdownDescription: "Unique identifier for this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names for more details.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"namespace": schema.StringAttribute{
+									Description:         "Namespaces provides a mechanism for isolating groups of resources within a single cluster. See https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/ for more details.",
+									MarkdownDescription: "Namespaces provides a mechanism for isolating groups of resources within a single cluster. See https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/ for more details.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"labels": schema.MapAttribute{
+									Description:         "Keys and values that can be used to organize and categorize objects. See https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/ for more details.",
+									MarkdownDescription: "Keys and values that can be used to organize and categorize objects. See https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/ for more details.",
+									ElementType:         types.StringType,
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+								"annotations": schema.MapAttribute{
+									Description:         "Keys and values that can be used by external tooling to store and retrieve arbitrary metadata about this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/ for more details.",
+									MarkdownDescription: "Keys and values that can be used by external tooling to store and retrieve arbitrary metadata about this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/ for more details.",
+									ElementType:         types.StringType,
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+								"generate_name": schema.StringAttribute{
+									Description:         "Prefix used by the server to generate a unique name for this object if 'name' is not provided. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency for more details.",
+									MarkdownDescription: "Prefix used by the server to generate a unique name for this object if `name` is not provided. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#idempotency for more details.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+								"finalizers": schema.ListAttribute{
+									Description:         "Must be empty before the object is deleted from the registry. Each entry is an identifier for the responsible component that will remove the entry from the list. See https://kubernetes.io/docs/concepts/overview/working-with-objects/finalizers/ for more details.",
+									MarkdownDescription: "Must be empty before the object is deleted from the registry. Each entry is an identifier for the responsible component that will remove the entry from the list. See https://kubernetes.io/docs/concepts/overview/working-with-objects/finalizers/ for more details.",
+									ElementType:         types.StringType,
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+								"owner_references": schema.ListNestedAttribute{
+									Description:         "List of objects depended by this object. If all objects in the list have been deleted, this object will be garbage collected. See https://kubernetes.io/docs/concepts/overview/working-with-objects/owners-dependents/ for more details.",
+									MarkdownDescription: "List of objects depended by this object. If all objects in the list have been deleted, this object will be garbage collected. See https://kubernetes.io/docs/concepts/overview/working-with-objects/owners-dependents/ for more details.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"api_version": schema.StringAttribute{
+												Description:         "API version of the referent.",
+												MarkdownDescription: "API version of the referent.",
+												Required:            false,
+												Optional:            false,
+												Computed:            true,
+											},
+											"kind": schema.StringAttribute{
+												Description:         "Kind of the referent.",
+												MarkdownDescription: "Kind of the referent.",
+												Required:            false,
+												Optional:            false,
+												Computed:            true,
+											},
+											"name": schema.StringAttribute{
+												Description:         "Name of the referent.",
+												MarkdownDescription: "Name of the referent.",
+												Required:            false,
+												Optional:            false,
+												Computed:            true,
+											},
+											"uid": schema.StringAttribute{
+												Description:         "UID of the referent.",
+												MarkdownDescription: "UID of the referent.",
+												Required:            false,
+												Optional:            false,
+												Computed:            true,
+											},
+											"controller": schema.BoolAttribute{
+												Description:         "If true, this reference points to the managing controller.",
+												MarkdownDescription: "If true, this reference points to the managing controller.",
+												Required:            false,
+												Optional:            false,
+												Computed:            true,
+											},
+											"block_owner_deletion": schema.BoolAttribute{
+												Description:         "If true, and if the owner has the 'foregroundDeletion' finalizer, then the owner cannot be deleted from the key-value store until this reference is removed.",
+												MarkdownDescription: "If true, and if the owner has the `foregroundDeletion` finalizer, then the owner cannot be deleted from the key-value store until this reference is removed.",
+												Required:            false,
+												Optional:            false,
+												Computed:            true,
+											},
+										},
+									},
+								},
+								"uid": schema.StringAttribute{
+									Description:         "The unique in time and space value for this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#uids for more details.",
+									MarkdownDescription: "The unique in time and space value for this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#uids for more details.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+								"resource_version": schema.StringAttribute{
+									Description:         "An opaque value that represents the internal version of this object. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency for more details.",
+									MarkdownDescription: "An opaque value that represents the internal version of this object. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency for more details.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+								"generation": schema.Int64Attribute{
+									Description:         "A sequence number representing a specific generation of the desired state.",
+									MarkdownDescription: "A sequence number representing a specific generation of the desired state.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+								"creation_timestamp": schema.StringAttribute{
+									Description:         "A timestamp representing the server time when this object was created in RFC3339 format.",
+									MarkdownDescription: "A timestamp representing the server time when this object was created in RFC3339 format.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+							},
+						},
+
+						"status": schema.SingleNestedAttribute{
+							Description:         "Most recently observed status of the object. Populated by the system. Read-only. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status for more details.",
+							MarkdownDescription: "Most recently observed status of the object. Populated by the system. Read-only. See https://github.com/kubernetes/community/blob/master/contributors/devel/sig-architecture/api-conventions.md#spec-and-status for more details.",
+							Required:            false,
+							Optional:            false,
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+
+								"last_phase_transition_time": schema.StringAttribute{
+									Description:         "Time is a wrapper around time.Time which supports correct marshaling to YAML and JSON. Wrappers are provided for many of the factory methods that the time package offers.",
+									MarkdownDescription: "Time is a wrapper around time.Time which supports correct marshaling to YAML and JSON. Wrappers are provided for many of the factory methods that the time package offers.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"message": schema.StringAttribute{
+									Description:         "message is a human-readable message indicating details about why the volume is in this state.",
+									MarkdownDescription: "message is a human-readable message indicating details about why the volume is in this state.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"phase": schema.StringAttribute{
+									Description:         "phase indicates if a volume is available, bound to a claim, or released by a claim. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#phase",
+									MarkdownDescription: "phase indicates if a volume is available, bound to a claim, or released by a claim. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#phase",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"reason": schema.StringAttribute{
+									Description:         "reason is a brief CamelCase string that describes any failure and is meant for machine parsing and tidy display in the CLI.",
+									MarkdownDescription: "reason is a brief CamelCase string that describes any failure and is meant for machine parsing and tidy display in the CLI.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+							},
+						},
+
+						"spec": schema.SingleNestedAttribute{
+							Description:         "PersistentVolumeSpec is the specification of a persistent volume.",
+							MarkdownDescription: "PersistentVolumeSpec is the specification of a persistent volume.",
+							Attributes: map[string]schema.Attribute{
+								"access_modes": schema.ListAttribute{
+									Description:         "accessModes contains all ways the volume can be mounted. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes",
+									MarkdownDescription: "accessModes contains all ways the volume can be mounted. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes",
+									ElementType:         types.StringType,
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"aws_elastic_block_store": schema.SingleNestedAttribute{
+									Description:         "Represents a Persistent Disk resource in AWS. An AWS EBS disk must exist before mounting to a container. The disk must also be in the same AWS zone as the kubelet. An AWS EBS disk can only be mounted as read/write once. AWS EBS volumes support ownership management and SELinux relabeling.",
+									MarkdownDescription: "Represents a Persistent Disk resource in AWS. An AWS EBS disk must exist before mounting to a container. The disk must also be in the same AWS zone as the kubelet. An AWS EBS disk can only be mounted as read/write once. AWS EBS volumes support ownership management and SELinux relabeling.",
+									Attributes: map[string]schema.Attribute{
+										"fs_type": schema.StringAttribute{
+											Description:         "fsType is the filesystem type of the volume that you want to mount. Tip: Ensure that the filesystem type is supported by the host operating system. Examples: 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified. More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore",
+											MarkdownDescription: "fsType is the filesystem type of the volume that you want to mount. Tip: Ensure that the filesystem type is supported by the host operating system. Examples: 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified. More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"partition": schema.Int64Attribute{
+											Description:         "partition is the partition in the volume that you want to mount. If omitted, the default is to mount by volume name. Examples: For volume /dev/sda1, you specify the partition as '1'. Similarly, the volume partition for /dev/sda is '0' (or you can leave the property empty).",
+											MarkdownDescription: "partition is the partition in the volume that you want to mount. If omitted, the default is to mount by volume name. Examples: For volume /dev/sda1, you specify the partition as '1'. Similarly, the volume partition for /dev/sda is '0' (or you can leave the property empty).",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly value true will force the readOnly setting in VolumeMounts. More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore",
+											MarkdownDescription: "readOnly value true will force the readOnly setting in VolumeMounts. More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"volume_id": schema.StringAttribute{
+											Description:         "volumeID is unique ID of the persistent disk resource in AWS (Amazon EBS volume). More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore",
+											MarkdownDescription: "volumeID is unique ID of the persistent disk resource in AWS (Amazon EBS volume). More info: https://kubernetes.io/docs/concepts/storage/volumes#awselasticblockstore",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"azure_disk": schema.SingleNestedAttribute{
+									Description:         "AzureDisk represents an Azure Data Disk mount on the host and bind mount to the pod.",
+									MarkdownDescription: "AzureDisk represents an Azure Data Disk mount on the host and bind mount to the pod.",
+									Attributes: map[string]schema.Attribute{
+										"caching_mode": schema.StringAttribute{
+											Description:         "cachingMode is the Host Caching mode: None, Read Only, Read Write.",
+											MarkdownDescription: "cachingMode is the Host Caching mode: None, Read Only, Read Write.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"disk_name": schema.StringAttribute{
+											Description:         "diskName is the Name of the data disk in the blob storage",
+											MarkdownDescription: "diskName is the Name of the data disk in the blob storage",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"disk_uri": schema.StringAttribute{
+											Description:         "diskURI is the URI of data disk in the blob storage",
+											MarkdownDescription: "diskURI is the URI of data disk in the blob storage",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"fs_type": schema.StringAttribute{
+											Description:         "fsType is Filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified.",
+											MarkdownDescription: "fsType is Filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"kind": schema.StringAttribute{
+											Description:         "kind expected values are Shared: multiple blob disks per storage account Dedicated: single blob disk per storage account Managed: azure managed data disk (only in managed availability set). defaults to shared",
+											MarkdownDescription: "kind expected values are Shared: multiple blob disks per storage account Dedicated: single blob disk per storage account Managed: azure managed data disk (only in managed availability set). defaults to shared",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly Defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts.",
+											MarkdownDescription: "readOnly Defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"azure_file": schema.SingleNestedAttribute{
+									Description:         "AzureFile represents an Azure File Service mount on the host and bind mount to the pod.",
+									MarkdownDescription: "AzureFile represents an Azure File Service mount on the host and bind mount to the pod.",
+									Attributes: map[string]schema.Attribute{
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts.",
+											MarkdownDescription: "readOnly defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"secret_name": schema.StringAttribute{
+											Description:         "secretName is the name of secret that contains Azure Storage Account Name and Key",
+											MarkdownDescription: "secretName is the name of secret that contains Azure Storage Account Name and Key",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"secret_namespace": schema.StringAttribute{
+											Description:         "secretNamespace is the namespace of the secret that contains Azure Storage Account Name and Key default is the same as the Pod",
+											MarkdownDescription: "secretNamespace is the namespace of the secret that contains Azure Storage Account Name and Key default is the same as the Pod",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"share_name": schema.StringAttribute{
+											Description:         "shareName is the azure Share Name",
+											MarkdownDescription: "shareName is the azure Share Name",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"capacity": schema.MapAttribute{
+									Description:         "capacity is the description of the persistent volume's resources and capacity. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#capacity",
+									MarkdownDescription: "capacity is the description of the persistent volume's resources and capacity. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#capacity",
+									ElementType:         types.StringType,
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"cephfs": schema.SingleNestedAttribute{
+									Description:         "Represents a Ceph Filesystem mount that lasts the lifetime of a pod Cephfs volumes do not support ownership management or SELinux relabeling.",
+									MarkdownDescription: "Represents a Ceph Filesystem mount that lasts the lifetime of a pod Cephfs volumes do not support ownership management or SELinux relabeling.",
+									Attributes: map[string]schema.Attribute{
+										"monitors": schema.ListAttribute{
+											Description:         "monitors is Required: Monitors is a collection of Ceph monitors More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it",
+											MarkdownDescription: "monitors is Required: Monitors is a collection of Ceph monitors More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it",
+											ElementType:         types.StringType,
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"path": schema.StringAttribute{
+											Description:         "path is Optional: Used as the mounted root, rather than the full Ceph tree, default is /",
+											MarkdownDescription: "path is Optional: Used as the mounted root, rather than the full Ceph tree, default is /",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly is Optional: Defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts. More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it",
+											MarkdownDescription: "readOnly is Optional: Defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts. More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"secret_file": schema.StringAttribute{
+											Description:         "secretFile is Optional: SecretFile is the path to key ring for User, default is /etc/ceph/user.secret More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it",
+											MarkdownDescription: "secretFile is Optional: SecretFile is the path to key ring for User, default is /etc/ceph/user.secret More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"secret_ref": schema.SingleNestedAttribute{
+											Description:         "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											MarkdownDescription: "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											Attributes: map[string]schema.Attribute{
+												"name": schema.StringAttribute{
+													Description:         "name is unique within a namespace to reference a secret resource.",
+													MarkdownDescription: "name is unique within a namespace to reference a secret resource.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"namespace": schema.StringAttribute{
+													Description:         "namespace defines the space within which the secret name must be unique.",
+													MarkdownDescription: "namespace defines the space within which the secret name must be unique.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+											},
+											Required: false,
+											Optional: false,
+											Computed: true,
+										},
+
+										"user": schema.StringAttribute{
+											Description:         "user is Optional: User is the rados user name, default is admin More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it",
+											MarkdownDescription: "user is Optional: User is the rados user name, default is admin More info: https://examples.k8s.io/volumes/cephfs/README.md#how-to-use-it",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"cinder": schema.SingleNestedAttribute{
+									Description:         "Represents a cinder volume resource in Openstack. A Cinder volume must exist before mounting to a container. The volume must also be in the same region as the kubelet. Cinder volumes support ownership management and SELinux relabeling.",
+									MarkdownDescription: "Represents a cinder volume resource in Openstack. A Cinder volume must exist before mounting to a container. The volume must also be in the same region as the kubelet. Cinder volumes support ownership management and SELinux relabeling.",
+									Attributes: map[string]schema.Attribute{
+										"fs_type": schema.StringAttribute{
+											Description:         "fsType Filesystem type to mount. Must be a filesystem type supported by the host operating system. Examples: 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified. More info: https://examples.k8s.io/mysql-cinder-pd/README.md",
+											MarkdownDescription: "fsType Filesystem type to mount. Must be a filesystem type supported by the host operating system. Examples: 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified. More info: https://examples.k8s.io/mysql-cinder-pd/README.md",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly is Optional: Defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts. More info: https://examples.k8s.io/mysql-cinder-pd/README.md",
+											MarkdownDescription: "readOnly is Optional: Defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts. More info: https://examples.k8s.io/mysql-cinder-pd/README.md",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"secret_ref": schema.SingleNestedAttribute{
+											Description:         "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											MarkdownDescription: "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											Attributes: map[string]schema.Attribute{
+												"name": schema.StringAttribute{
+													Description:         "name is unique within a namespace to reference a secret resource.",
+													MarkdownDescription: "name is unique within a namespace to reference a secret resource.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"namespace": schema.StringAttribute{
+													Description:         "namespace defines the space within which the secret name must be unique.",
+													MarkdownDescription: "namespace defines the space within which the secret name must be unique.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+											},
+											Required: false,
+											Optional: false,
+											Computed: true,
+										},
+
+										"volume_id": schema.StringAttribute{
+											Description:         "volumeID used to identify the volume in cinder. More info: https://examples.k8s.io/mysql-cinder-pd/README.md",
+											MarkdownDescription: "volumeID used to identify the volume in cinder. More info: https://examples.k8s.io/mysql-cinder-pd/README.md",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"claim_ref": schema.SingleNestedAttribute{
+									Description:         "ObjectReference contains enough information to let you inspect or modify the referred object.",
+									MarkdownDescription: "ObjectReference contains enough information to let you inspect or modify the referred object.",
+									Attributes: map[string]schema.Attribute{
+										"api_version": schema.StringAttribute{
+											Description:         "API version of the referent.",
+											MarkdownDescription: "API version of the referent.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"field_path": schema.StringAttribute{
+											Description:         "If referring to a piece of an object instead of an entire object, this string should contain a valid JSON/Go field access statement, such as desiredState.manifest.containers[2]. For example, if the object reference is to a container within a pod, this would take on a value like: 'spec.containers{name}' (where 'name' refers to the name of the container that triggered the event) or if no container name is specified 'spec.containers[2]' (container with index 2 in this pod). This syntax is chosen only to have some well-defined way of referencing a part of an object.",
+											MarkdownDescription: "If referring to a piece of an object instead of an entire object, this string should contain a valid JSON/Go field access statement, such as desiredState.manifest.containers[2]. For example, if the object reference is to a container within a pod, this would take on a value like: 'spec.containers{name}' (where 'name' refers to the name of the container that triggered the event) or if no container name is specified 'spec.containers[2]' (container with index 2 in this pod). This syntax is chosen only to have some well-defined way of referencing a part of an object.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"kind": schema.StringAttribute{
+											Description:         "Kind of the referent. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+											MarkdownDescription: "Kind of the referent. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"name": schema.StringAttribute{
+											Description:         "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+											MarkdownDescription: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"namespace": schema.StringAttribute{
+											Description:         "Namespace of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/",
+											MarkdownDescription: "Namespace of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"resource_version": schema.StringAttribute{
+											Description:         "Specific resourceVersion to which this reference is made, if any. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency",
+											MarkdownDescription: "Specific resourceVersion to which this reference is made, if any. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"uid": schema.StringAttribute{
+											Description:         "UID of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#uids",
+											MarkdownDescription: "UID of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#uids",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"csi": schema.SingleNestedAttribute{
+									Description:         "Represents storage that is managed by an external CSI volume driver (Beta feature)",
+									MarkdownDescription: "Represents storage that is managed by an external CSI volume driver (Beta feature)",
+									Attributes: map[string]schema.Attribute{
+										"controller_expand_secret_ref": schema.SingleNestedAttribute{
+											Description:         "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											MarkdownDescription: "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											Attributes: map[string]schema.Attribute{
+												"name": schema.StringAttribute{
+													Description:         "name is unique within a namespace to reference a secret resource.",
+													MarkdownDescription: "name is unique within a namespace to reference a secret resource.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"namespace": schema.StringAttribute{
+													Description:         "namespace defines the space within which the secret name must be unique.",
+													MarkdownDescription: "namespace defines the space within which the secret name must be unique.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+											},
+											Required: false,
+											Optional: false,
+											Computed: true,
+										},
+
+										"controller_publish_secret_ref": schema.SingleNestedAttribute{
+											Description:         "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											MarkdownDescription: "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											Attributes: map[string]schema.Attribute{
+												"name": schema.StringAttribute{
+													Description:         "name is unique within a namespace to reference a secret resource.",
+													MarkdownDescription: "name is unique within a namespace to reference a secret resource.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"namespace": schema.StringAttribute{
+													Description:         "namespace defines the space within which the secret name must be unique.",
+													MarkdownDescription: "namespace defines the space within which the secret name must be unique.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+											},
+											Required: false,
+											Optional: false,
+											Computed: true,
+										},
+
+										"driver": schema.StringAttribute{
+											Description:         "driver is the name of the driver to use for this volume. Required.",
+											MarkdownDescription: "driver is the name of the driver to use for this volume. Required.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"fs_type": schema.StringAttribute{
+											Description:         "fsType to mount. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'.",
+											MarkdownDescription: "fsType to mount. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"node_expand_secret_ref": schema.SingleNestedAttribute{
+											Description:         "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											MarkdownDescription: "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											Attributes: map[string]schema.Attribute{
+												"name": schema.StringAttribute{
+													Description:         "name is unique within a namespace to reference a secret resource.",
+													MarkdownDescription: "name is unique within a namespace to reference a secret resource.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"namespace": schema.StringAttribute{
+													Description:         "namespace defines the space within which the secret name must be unique.",
+													MarkdownDescription: "namespace defines the space within which the secret name must be unique.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+											},
+											Required: false,
+											Optional: false,
+											Computed: true,
+										},
+
+										"node_publish_secret_ref": schema.SingleNestedAttribute{
+											Description:         "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											MarkdownDescription: "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											Attributes: map[string]schema.Attribute{
+												"name": schema.StringAttribute{
+													Description:         "name is unique within a namespace to reference a secret resource.",
+													MarkdownDescription: "name is unique within a namespace to reference a secret resource.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"namespace": schema.StringAttribute{
+													Description:         "namespace defines the space within which the secret name must be unique.",
+													MarkdownDescription: "namespace defines the space within which the secret name must be unique.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+											},
+											Required: false,
+											Optional: false,
+											Computed: true,
+										},
+
+										"node_stage_secret_ref": schema.SingleNestedAttribute{
+											Description:         "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											MarkdownDescription: "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											Attributes: map[string]schema.Attribute{
+												"name": schema.StringAttribute{
+													Description:         "name is unique within a namespace to reference a secret resource.",
+													MarkdownDescription: "name is unique within a namespace to reference a secret resource.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"namespace": schema.StringAttribute{
+													Description:         "namespace defines the space within which the secret name must be unique.",
+													MarkdownDescription: "namespace defines the space within which the secret name must be unique.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+											},
+											Required: false,
+											Optional: false,
+											Computed: true,
+										},
+
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly value to pass to ControllerPublishVolumeRequest. Defaults to false (read/write).",
+											MarkdownDescription: "readOnly value to pass to ControllerPublishVolumeRequest. Defaults to false (read/write).",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"volume_attributes": schema.MapAttribute{
+											Description:         "volumeAttributes of the volume to publish.",
+											MarkdownDescription: "volumeAttributes of the volume to publish.",
+											ElementType:         types.StringType,
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"volume_handle": schema.StringAttribute{
+											Description:         "volumeHandle is the unique volume name returned by the CSI volume plugin’s CreateVolume to refer to the volume on all subsequent calls. Required.",
+											MarkdownDescription: "volumeHandle is the unique volume name returned by the CSI volume plugin’s CreateVolume to refer to the volume on all subsequent calls. Required.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"fc": schema.SingleNestedAttribute{
+									Description:         "Represents a Fibre Channel volume. Fibre Channel volumes can only be mounted as read/write once. Fibre Channel volumes support ownership management and SELinux relabeling.",
+									MarkdownDescription: "Represents a Fibre Channel volume. Fibre Channel volumes can only be mounted as read/write once. Fibre Channel volumes support ownership management and SELinux relabeling.",
+									Attributes: map[string]schema.Attribute{
+										"fs_type": schema.StringAttribute{
+											Description:         "fsType is the filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified.",
+											MarkdownDescription: "fsType is the filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"lun": schema.Int64Attribute{
+											Description:         "lun is Optional: FC target lun number",
+											MarkdownDescription: "lun is Optional: FC target lun number",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly is Optional: Defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts.",
+											MarkdownDescription: "readOnly is Optional: Defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"target_ww_ns": schema.ListAttribute{
+											Description:         "targetWWNs is Optional: FC target worldwide names (WWNs)",
+											MarkdownDescription: "targetWWNs is Optional: FC target worldwide names (WWNs)",
+											ElementType:         types.StringType,
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"wwids": schema.ListAttribute{
+											Description:         "wwids Optional: FC volume world wide identifiers (wwids) Either wwids or combination of targetWWNs and lun must be set, but not both simultaneously.",
+											MarkdownDescription: "wwids Optional: FC volume world wide identifiers (wwids) Either wwids or combination of targetWWNs and lun must be set, but not both simultaneously.",
+											ElementType:         types.StringType,
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"flex_volume": schema.SingleNestedAttribute{
+									Description:         "FlexPersistentVolumeSource represents a generic persistent volume resource that is provisioned/attached using an exec based plugin.",
+									MarkdownDescription: "FlexPersistentVolumeSource represents a generic persistent volume resource that is provisioned/attached using an exec based plugin.",
+									Attributes: map[string]schema.Attribute{
+										"driver": schema.StringAttribute{
+											Description:         "driver is the name of the driver to use for this volume.",
+											MarkdownDescription: "driver is the name of the driver to use for this volume.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"fs_type": schema.StringAttribute{
+											Description:         "fsType is the Filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'. The default filesystem depends on FlexVolume script.",
+											MarkdownDescription: "fsType is the Filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'. The default filesystem depends on FlexVolume script.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"options": schema.MapAttribute{
+											Description:         "options is Optional: this field holds extra command options if any.",
+											MarkdownDescription: "options is Optional: this field holds extra command options if any.",
+											ElementType:         types.StringType,
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly is Optional: defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts.",
+											MarkdownDescription: "readOnly is Optional: defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"secret_ref": schema.SingleNestedAttribute{
+											Description:         "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											MarkdownDescription: "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											Attributes: map[string]schema.Attribute{
+												"name": schema.StringAttribute{
+													Description:         "name is unique within a namespace to reference a secret resource.",
+													MarkdownDescription: "name is unique within a namespace to reference a secret resource.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"namespace": schema.StringAttribute{
+													Description:         "namespace defines the space within which the secret name must be unique.",
+													MarkdownDescription: "namespace defines the space within which the secret name must be unique.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+											},
+											Required: false,
+											Optional: false,
+											Computed: true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"flocker": schema.SingleNestedAttribute{
+									Description:         "Represents a Flocker volume mounted by the Flocker agent. One and only one of datasetName and datasetUUID should be set. Flocker volumes do not support ownership management or SELinux relabeling.",
+									MarkdownDescription: "Represents a Flocker volume mounted by the Flocker agent. One and only one of datasetName and datasetUUID should be set. Flocker volumes do not support ownership management or SELinux relabeling.",
+									Attributes: map[string]schema.Attribute{
+										"dataset_name": schema.StringAttribute{
+											Description:         "datasetName is Name of the dataset stored as metadata -> name on the dataset for Flocker should be considered as deprecated",
+											MarkdownDescription: "datasetName is Name of the dataset stored as metadata -> name on the dataset for Flocker should be considered as deprecated",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"dataset_uuid": schema.StringAttribute{
+											Description:         "datasetUUID is the UUID of the dataset. This is unique identifier of a Flocker dataset",
+											MarkdownDescription: "datasetUUID is the UUID of the dataset. This is unique identifier of a Flocker dataset",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"gce_persistent_disk": schema.SingleNestedAttribute{
+									Description:         "Represents a Persistent Disk resource in Google Compute Engine. A GCE PD must exist before mounting to a container. The disk must also be in the same GCE project and zone as the kubelet. A GCE PD can only be mounted as read/write once or read-only many times. GCE PDs support ownership management and SELinux relabeling.",
+									MarkdownDescription: "Represents a Persistent Disk resource in Google Compute Engine. A GCE PD must exist before mounting to a container. The disk must also be in the same GCE project and zone as the kubelet. A GCE PD can only be mounted as read/write once or read-only many times. GCE PDs support ownership management and SELinux relabeling.",
+									Attributes: map[string]schema.Attribute{
+										"fs_type": schema.StringAttribute{
+											Description:         "fsType is filesystem type of the volume that you want to mount. Tip: Ensure that the filesystem type is supported by the host operating system. Examples: 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified. More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk",
+											MarkdownDescription: "fsType is filesystem type of the volume that you want to mount. Tip: Ensure that the filesystem type is supported by the host operating system. Examples: 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified. More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"partition": schema.Int64Attribute{
+											Description:         "partition is the partition in the volume that you want to mount. If omitted, the default is to mount by volume name. Examples: For volume /dev/sda1, you specify the partition as '1'. Similarly, the volume partition for /dev/sda is '0' (or you can leave the property empty). More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk",
+											MarkdownDescription: "partition is the partition in the volume that you want to mount. If omitted, the default is to mount by volume name. Examples: For volume /dev/sda1, you specify the partition as '1'. Similarly, the volume partition for /dev/sda is '0' (or you can leave the property empty). More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"pd_name": schema.StringAttribute{
+											Description:         "pdName is unique name of the PD resource in GCE. Used to identify the disk in GCE. More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk",
+											MarkdownDescription: "pdName is unique name of the PD resource in GCE. Used to identify the disk in GCE. More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly here will force the ReadOnly setting in VolumeMounts. Defaults to false. More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk",
+											MarkdownDescription: "readOnly here will force the ReadOnly setting in VolumeMounts. Defaults to false. More info: https://kubernetes.io/docs/concepts/storage/volumes#gcepersistentdisk",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"glusterfs": schema.SingleNestedAttribute{
+									Description:         "Represents a Glusterfs mount that lasts the lifetime of a pod. Glusterfs volumes do not support ownership management or SELinux relabeling.",
+									MarkdownDescription: "Represents a Glusterfs mount that lasts the lifetime of a pod. Glusterfs volumes do not support ownership management or SELinux relabeling.",
+									Attributes: map[string]schema.Attribute{
+										"endpoints": schema.StringAttribute{
+											Description:         "endpoints is the endpoint name that details Glusterfs topology. More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod",
+											MarkdownDescription: "endpoints is the endpoint name that details Glusterfs topology. More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"endpoints_namespace": schema.StringAttribute{
+											Description:         "endpointsNamespace is the namespace that contains Glusterfs endpoint. If this field is empty, the EndpointNamespace defaults to the same namespace as the bound PVC. More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod",
+											MarkdownDescription: "endpointsNamespace is the namespace that contains Glusterfs endpoint. If this field is empty, the EndpointNamespace defaults to the same namespace as the bound PVC. More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"path": schema.StringAttribute{
+											Description:         "path is the Glusterfs volume path. More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod",
+											MarkdownDescription: "path is the Glusterfs volume path. More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly here will force the Glusterfs volume to be mounted with read-only permissions. Defaults to false. More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod",
+											MarkdownDescription: "readOnly here will force the Glusterfs volume to be mounted with read-only permissions. Defaults to false. More info: https://examples.k8s.io/volumes/glusterfs/README.md#create-a-pod",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"host_path": schema.SingleNestedAttribute{
+									Description:         "Represents a host path mapped into a pod. Host path volumes do not support ownership management or SELinux relabeling.",
+									MarkdownDescription: "Represents a host path mapped into a pod. Host path volumes do not support ownership management or SELinux relabeling.",
+									Attributes: map[string]schema.Attribute{
+										"path": schema.StringAttribute{
+											Description:         "path of the directory on the host. If the path is a symlink, it will follow the link to the real path. More info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath",
+											MarkdownDescription: "path of the directory on the host. If the path is a symlink, it will follow the link to the real path. More info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"type": schema.StringAttribute{
+											Description:         "type for HostPath Volume Defaults to '' More info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath",
+											MarkdownDescription: "type for HostPath Volume Defaults to '' More info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"iscsi": schema.SingleNestedAttribute{
+									Description:         "ISCSIPersistentVolumeSource represents an ISCSI disk. ISCSI volumes can only be mounted as read/write once. ISCSI volumes support ownership management and SELinux relabeling.",
+									MarkdownDescription: "ISCSIPersistentVolumeSource represents an ISCSI disk. ISCSI volumes can only be mounted as read/write once. ISCSI volumes support ownership management and SELinux relabeling.",
+									Attributes: map[string]schema.Attribute{
+										"chap_auth_discovery": schema.BoolAttribute{
+											Description:         "chapAuthDiscovery defines whether support iSCSI Discovery CHAP authentication",
+											MarkdownDescription: "chapAuthDiscovery defines whether support iSCSI Discovery CHAP authentication",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"chap_auth_session": schema.BoolAttribute{
+											Description:         "chapAuthSession defines whether support iSCSI Session CHAP authentication",
+											MarkdownDescription: "chapAuthSession defines whether support iSCSI Session CHAP authentication",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"fs_type": schema.StringAttribute{
+											Description:         "fsType is the filesystem type of the volume that you want to mount. Tip: Ensure that the filesystem type is supported by the host operating system. Examples: 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified. More info: https://kubernetes.io/docs/concepts/storage/volumes#iscsi",
+											MarkdownDescription: "fsType is the filesystem type of the volume that you want to mount. Tip: Ensure that the filesystem type is supported by the host operating system. Examples: 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified. More info: https://kubernetes.io/docs/concepts/storage/volumes#iscsi",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"initiator_name": schema.StringAttribute{
+											Description:         "initiatorName is the custom iSCSI Initiator Name. If initiatorName is specified with iscsiInterface simultaneously, new iSCSI interface <target portal>:<volume name> will be created for the connection.",
+											MarkdownDescription: "initiatorName is the custom iSCSI Initiator Name. If initiatorName is specified with iscsiInterface simultaneously, new iSCSI interface <target portal>:<volume name> will be created for the connection.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"iqn": schema.StringAttribute{
+											Description:         "iqn is Target iSCSI Qualified Name.",
+											MarkdownDescription: "iqn is Target iSCSI Qualified Name.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"iscsi_interface": schema.StringAttribute{
+											Description:         "iscsiInterface is the interface Name that uses an iSCSI transport. Defaults to 'default' (tcp).",
+											MarkdownDescription: "iscsiInterface is the interface Name that uses an iSCSI transport. Defaults to 'default' (tcp).",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"lun": schema.Int64Attribute{
+											Description:         "lun is iSCSI Target Lun number.",
+											MarkdownDescription: "lun is iSCSI Target Lun number.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"portals": schema.ListAttribute{
+											Description:         "portals is the iSCSI Target Portal List. The Portal is either an IP or ip_addr:port if the port is other than default (typically TCP ports 860 and 3260).",
+											MarkdownDescription: "portals is the iSCSI Target Portal List. The Portal is either an IP or ip_addr:port if the port is other than default (typically TCP ports 860 and 3260).",
+											ElementType:         types.StringType,
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly here will force the ReadOnly setting in VolumeMounts. Defaults to false.",
+											MarkdownDescription: "readOnly here will force the ReadOnly setting in VolumeMounts. Defaults to false.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"secret_ref": schema.SingleNestedAttribute{
+											Description:         "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											MarkdownDescription: "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											Attributes: map[string]schema.Attribute{
+												"name": schema.StringAttribute{
+													Description:         "name is unique within a namespace to reference a secret resource.",
+													MarkdownDescription: "name is unique within a namespace to reference a secret resource.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"namespace": schema.StringAttribute{
+													Description:         "namespace defines the space within which the secret name must be unique.",
+													MarkdownDescription: "namespace defines the space within which the secret name must be unique.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+											},
+											Required: false,
+											Optional: false,
+											Computed: true,
+										},
+
+										"target_portal": schema.StringAttribute{
+											Description:         "targetPortal is iSCSI Target Portal. The Portal is either an IP or ip_addr:port if the port is other than default (typically TCP ports 860 and 3260).",
+											MarkdownDescription: "targetPortal is iSCSI Target Portal. The Portal is either an IP or ip_addr:port if the port is other than default (typically TCP ports 860 and 3260).",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"local": schema.SingleNestedAttribute{
+									Description:         "Local represents directly-attached storage with node affinity (Beta feature)",
+									MarkdownDescription: "Local represents directly-attached storage with node affinity (Beta feature)",
+									Attributes: map[string]schema.Attribute{
+										"fs_type": schema.StringAttribute{
+											Description:         "fsType is the filesystem type to mount. It applies only when the Path is a block device. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'. The default value is to auto-select a filesystem if unspecified.",
+											MarkdownDescription: "fsType is the filesystem type to mount. It applies only when the Path is a block device. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'. The default value is to auto-select a filesystem if unspecified.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"path": schema.StringAttribute{
+											Description:         "path of the full path to the volume on the node. It can be either a directory or block device (disk, partition, ...).",
+											MarkdownDescription: "path of the full path to the volume on the node. It can be either a directory or block device (disk, partition, ...).",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"mount_options": schema.ListAttribute{
+									Description:         "mountOptions is the list of mount options, e.g. ['ro', 'soft']. Not validated - mount will simply fail if one is invalid. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes/#mount-options",
+									MarkdownDescription: "mountOptions is the list of mount options, e.g. ['ro', 'soft']. Not validated - mount will simply fail if one is invalid. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes/#mount-options",
+									ElementType:         types.StringType,
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"nfs": schema.SingleNestedAttribute{
+									Description:         "Represents an NFS mount that lasts the lifetime of a pod. NFS volumes do not support ownership management or SELinux relabeling.",
+									MarkdownDescription: "Represents an NFS mount that lasts the lifetime of a pod. NFS volumes do not support ownership management or SELinux relabeling.",
+									Attributes: map[string]schema.Attribute{
+										"path": schema.StringAttribute{
+											Description:         "path that is exported by the NFS server. More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs",
+											MarkdownDescription: "path that is exported by the NFS server. More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly here will force the NFS export to be mounted with read-only permissions. Defaults to false. More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs",
+											MarkdownDescription: "readOnly here will force the NFS export to be mounted with read-only permissions. Defaults to false. More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"server": schema.StringAttribute{
+											Description:         "server is the hostname or IP address of the NFS server. More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs",
+											MarkdownDescription: "server is the hostname or IP address of the NFS server. More info: https://kubernetes.io/docs/concepts/storage/volumes#nfs",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"node_affinity": schema.SingleNestedAttribute{
+									Description:         "VolumeNodeAffinity defines constraints that limit what nodes this volume can be accessed from.",
+									MarkdownDescription: "VolumeNodeAffinity defines constraints that limit what nodes this volume can be accessed from.",
+									Attributes: map[string]schema.Attribute{
+										"required": schema.SingleNestedAttribute{
+											Description:         "A node selector represents the union of the results of one or more label queries over a set of nodes; that is, it represents the OR of the selectors represented by the node selector terms.",
+											MarkdownDescription: "A node selector represents the union of the results of one or more label queries over a set of nodes; that is, it represents the OR of the selectors represented by the node selector terms.",
+											Attributes: map[string]schema.Attribute{
+												"node_selector_terms": schema.ListNestedAttribute{
+													Description:         "Required. A list of node selector terms. The terms are ORed.",
+													MarkdownDescription: "Required. A list of node selector terms. The terms are ORed.",
+													NestedObject: schema.NestedAttributeObject{
+														Attributes: map[string]schema.Attribute{
+															"match_expressions": schema.ListNestedAttribute{
+																Description:         "A list of node selector requirements by node's labels.",
+																MarkdownDescription: "A list of node selector requirements by node's labels.",
+																NestedObject: schema.NestedAttributeObject{
+																	Attributes: map[string]schema.Attribute{
+																		"key": schema.StringAttribute{
+																			Description:         "The label key that the selector applies to.",
+																			MarkdownDescription: "The label key that the selector applies to.",
+																			Required:            false,
+																			Optional:            false,
+																			Computed:            true,
+																		},
+
+																		"operator": schema.StringAttribute{
+																			Description:         "Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.",
+																			MarkdownDescription: "Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.",
+																			Required:            false,
+																			Optional:            false,
+																			Computed:            true,
+																		},
+
+																		"values": schema.ListAttribute{
+																			Description:         "An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.",
+																			MarkdownDescription: "An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.",
+																			ElementType:         types.StringType,
+																			Required:            false,
+																			Optional:            false,
+																			Computed:            true,
+																		},
+																	},
+																},
+																Required: false,
+																Optional: false,
+																Computed: true,
+															},
+
+															"match_fields": schema.ListNestedAttribute{
+																Description:         "A list of node selector requirements by node's fields.",
+																MarkdownDescription: "A list of node selector requirements by node's fields.",
+																NestedObject: schema.NestedAttributeObject{
+																	Attributes: map[string]schema.Attribute{
+																		"key": schema.StringAttribute{
+																			Description:         "The label key that the selector applies to.",
+																			MarkdownDescription: "The label key that the selector applies to.",
+																			Required:            false,
+																			Optional:            false,
+																			Computed:            true,
+																		},
+
+																		"operator": schema.StringAttribute{
+																			Description:         "Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.",
+																			MarkdownDescription: "Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.",
+																			Required:            false,
+																			Optional:            false,
+																			Computed:            true,
+																		},
+
+																		"values": schema.ListAttribute{
+																			Description:         "An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.",
+																			MarkdownDescription: "An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.",
+																			ElementType:         types.StringType,
+																			Required:            false,
+																			Optional:            false,
+																			Computed:            true,
+																		},
+																	},
+																},
+																Required: false,
+																Optional: false,
+																Computed: true,
+															},
+														},
+													},
+													Required: false,
+													Optional: false,
+													Computed: true,
+												},
+											},
+											Required: false,
+											Optional: false,
+											Computed: true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"persistent_volume_reclaim_policy": schema.StringAttribute{
+									Description:         "persistentVolumeReclaimPolicy defines what happens to a persistent volume when released from its claim. Valid options are Retain (default for manually created PersistentVolumes), Delete (default for dynamically provisioned PersistentVolumes), and Recycle (deprecated). Recycle must be supported by the volume plugin underlying this PersistentVolume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#reclaiming",
+									MarkdownDescription: "persistentVolumeReclaimPolicy defines what happens to a persistent volume when released from its claim. Valid options are Retain (default for manually created PersistentVolumes), Delete (default for dynamically provisioned PersistentVolumes), and Recycle (deprecated). Recycle must be supported by the volume plugin underlying this PersistentVolume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#reclaiming",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"photon_persistent_disk": schema.SingleNestedAttribute{
+									Description:         "Represents a Photon Controller persistent disk resource.",
+									MarkdownDescription: "Represents a Photon Controller persistent disk resource.",
+									Attributes: map[string]schema.Attribute{
+										"fs_type": schema.StringAttribute{
+											Description:         "fsType is the filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified.",
+											MarkdownDescription: "fsType is the filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"pd_id": schema.StringAttribute{
+											Description:         "pdID is the ID that identifies Photon Controller persistent disk",
+											MarkdownDescription: "pdID is the ID that identifies Photon Controller persistent disk",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"portworx_volume": schema.SingleNestedAttribute{
+									Description:         "PortworxVolumeSource represents a Portworx volume resource.",
+									MarkdownDescription: "PortworxVolumeSource represents a Portworx volume resource.",
+									Attributes: map[string]schema.Attribute{
+										"fs_type": schema.StringAttribute{
+											Description:         "fSType represents the filesystem type to mount Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs'. Implicitly inferred to be 'ext4' if unspecified.",
+											MarkdownDescription: "fSType represents the filesystem type to mount Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs'. Implicitly inferred to be 'ext4' if unspecified.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts.",
+											MarkdownDescription: "readOnly defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"volume_id": schema.StringAttribute{
+											Description:         "volumeID uniquely identifies a Portworx volume",
+											MarkdownDescription: "volumeID uniquely identifies a Portworx volume",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"quobyte": schema.SingleNestedAttribute{
+									Description:         "Represents a Quobyte mount that lasts the lifetime of a pod. Quobyte volumes do not support ownership management or SELinux relabeling.",
+									MarkdownDescription: "Represents a Quobyte mount that lasts the lifetime of a pod. Quobyte volumes do not support ownership management or SELinux relabeling.",
+									Attributes: map[string]schema.Attribute{
+										"group": schema.StringAttribute{
+											Description:         "group to map volume access to Default is no group",
+											MarkdownDescription: "group to map volume access to Default is no group",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly here will force the Quobyte volume to be mounted with read-only permissions. Defaults to false.",
+											MarkdownDescription: "readOnly here will force the Quobyte volume to be mounted with read-only permissions. Defaults to false.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"registry": schema.StringAttribute{
+											Description:         "registry represents a single or multiple Quobyte Registry services specified as a string as host:port pair (multiple entries are separated with commas) which acts as the central registry for volumes",
+											MarkdownDescription: "registry represents a single or multiple Quobyte Registry services specified as a string as host:port pair (multiple entries are separated with commas) which acts as the central registry for volumes",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"tenant": schema.StringAttribute{
+											Description:         "tenant owning the given Quobyte volume in the Backend Used with dynamically provisioned Quobyte volumes, value is set by the plugin",
+											MarkdownDescription: "tenant owning the given Quobyte volume in the Backend Used with dynamically provisioned Quobyte volumes, value is set by the plugin",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"user": schema.StringAttribute{
+											Description:         "user to map volume access to Defaults to serivceaccount user",
+											MarkdownDescription: "user to map volume access to Defaults to serivceaccount user",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"volume": schema.StringAttribute{
+											Description:         "volume is a string that references an already created Quobyte volume by name.",
+											MarkdownDescription: "volume is a string that references an already created Quobyte volume by name.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"rbd": schema.SingleNestedAttribute{
+									Description:         "Represents a Rados Block Device mount that lasts the lifetime of a pod. RBD volumes support ownership management and SELinux relabeling.",
+									MarkdownDescription: "Represents a Rados Block Device mount that lasts the lifetime of a pod. RBD volumes support ownership management and SELinux relabeling.",
+									Attributes: map[string]schema.Attribute{
+										"fs_type": schema.StringAttribute{
+											Description:         "fsType is the filesystem type of the volume that you want to mount. Tip: Ensure that the filesystem type is supported by the host operating system. Examples: 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified. More info: https://kubernetes.io/docs/concepts/storage/volumes#rbd",
+											MarkdownDescription: "fsType is the filesystem type of the volume that you want to mount. Tip: Ensure that the filesystem type is supported by the host operating system. Examples: 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified. More info: https://kubernetes.io/docs/concepts/storage/volumes#rbd",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"image": schema.StringAttribute{
+											Description:         "image is the rados image name. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it",
+											MarkdownDescription: "image is the rados image name. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"keyring": schema.StringAttribute{
+											Description:         "keyring is the path to key ring for RBDUser. Default is /etc/ceph/keyring. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it",
+											MarkdownDescription: "keyring is the path to key ring for RBDUser. Default is /etc/ceph/keyring. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"monitors": schema.ListAttribute{
+											Description:         "monitors is a collection of Ceph monitors. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it",
+											MarkdownDescription: "monitors is a collection of Ceph monitors. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it",
+											ElementType:         types.StringType,
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"pool": schema.StringAttribute{
+											Description:         "pool is the rados pool name. Default is rbd. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it",
+											MarkdownDescription: "pool is the rados pool name. Default is rbd. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly here will force the ReadOnly setting in VolumeMounts. Defaults to false. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it",
+											MarkdownDescription: "readOnly here will force the ReadOnly setting in VolumeMounts. Defaults to false. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"secret_ref": schema.SingleNestedAttribute{
+											Description:         "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											MarkdownDescription: "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											Attributes: map[string]schema.Attribute{
+												"name": schema.StringAttribute{
+													Description:         "name is unique within a namespace to reference a secret resource.",
+													MarkdownDescription: "name is unique within a namespace to reference a secret resource.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"namespace": schema.StringAttribute{
+													Description:         "namespace defines the space within which the secret name must be unique.",
+													MarkdownDescription: "namespace defines the space within which the secret name must be unique.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+											},
+											Required: false,
+											Optional: false,
+											Computed: true,
+										},
+
+										"user": schema.StringAttribute{
+											Description:         "user is the rados user name. Default is admin. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it",
+											MarkdownDescription: "user is the rados user name. Default is admin. More info: https://examples.k8s.io/volumes/rbd/README.md#how-to-use-it",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"scale_io": schema.SingleNestedAttribute{
+									Description:         "ScaleIOPersistentVolumeSource represents a persistent ScaleIO volume",
+									MarkdownDescription: "ScaleIOPersistentVolumeSource represents a persistent ScaleIO volume",
+									Attributes: map[string]schema.Attribute{
+										"fs_type": schema.StringAttribute{
+											Description:         "fsType is the filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'. Default is 'xfs'",
+											MarkdownDescription: "fsType is the filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'. Default is 'xfs'",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"gateway": schema.StringAttribute{
+											Description:         "gateway is the host address of the ScaleIO API Gateway.",
+											MarkdownDescription: "gateway is the host address of the ScaleIO API Gateway.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"protection_domain": schema.StringAttribute{
+											Description:         "protectionDomain is the name of the ScaleIO Protection Domain for the configured storage.",
+											MarkdownDescription: "protectionDomain is the name of the ScaleIO Protection Domain for the configured storage.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts.",
+											MarkdownDescription: "readOnly defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"secret_ref": schema.SingleNestedAttribute{
+											Description:         "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											MarkdownDescription: "SecretReference represents a Secret Reference. It has enough information to retrieve secret in any namespace",
+											Attributes: map[string]schema.Attribute{
+												"name": schema.StringAttribute{
+													Description:         "name is unique within a namespace to reference a secret resource.",
+													MarkdownDescription: "name is unique within a namespace to reference a secret resource.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"namespace": schema.StringAttribute{
+													Description:         "namespace defines the space within which the secret name must be unique.",
+													MarkdownDescription: "namespace defines the space within which the secret name must be unique.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+											},
+											Required: false,
+											Optional: false,
+											Computed: true,
+										},
+
+										"ssl_enabled": schema.BoolAttribute{
+											Description:         "sslEnabled is the flag to enable/disable SSL communication with Gateway, default false",
+											MarkdownDescription: "sslEnabled is the flag to enable/disable SSL communication with Gateway, default false",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"storage_mode": schema.StringAttribute{
+											Description:         "storageMode indicates whether the storage for a volume should be ThickProvisioned or ThinProvisioned. Default is ThinProvisioned.",
+											MarkdownDescription: "storageMode indicates whether the storage for a volume should be ThickProvisioned or ThinProvisioned. Default is ThinProvisioned.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"storage_pool": schema.StringAttribute{
+											Description:         "storagePool is the ScaleIO Storage Pool associated with the protection domain.",
+											MarkdownDescription: "storagePool is the ScaleIO Storage Pool associated with the protection domain.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"system": schema.StringAttribute{
+											Description:         "system is the name of the storage system as configured in ScaleIO.",
+											MarkdownDescription: "system is the name of the storage system as configured in ScaleIO.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"volume_name": schema.StringAttribute{
+											Description:         "volumeName is the name of a volume already created in the ScaleIO system that is associated with this volume source.",
+											MarkdownDescription: "volumeName is the name of a volume already created in the ScaleIO system that is associated with this volume source.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"storage_class_name": schema.StringAttribute{
+									Description:         "storageClassName is the name of StorageClass to which this persistent volume belongs. Empty value means that this volume does not belong to any StorageClass.",
+									MarkdownDescription: "storageClassName is the name of StorageClass to which this persistent volume belongs. Empty value means that this volume does not belong to any StorageClass.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"storageos": schema.SingleNestedAttribute{
+									Description:         "Represents a StorageOS persistent volume resource.",
+									MarkdownDescription: "Represents a StorageOS persistent volume resource.",
+									Attributes: map[string]schema.Attribute{
+										"fs_type": schema.StringAttribute{
+											Description:         "fsType is the filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified.",
+											MarkdownDescription: "fsType is the filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"read_only": schema.BoolAttribute{
+											Description:         "readOnly defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts.",
+											MarkdownDescription: "readOnly defaults to false (read/write). ReadOnly here will force the ReadOnly setting in VolumeMounts.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"secret_ref": schema.SingleNestedAttribute{
+											Description:         "ObjectReference contains enough information to let you inspect or modify the referred object.",
+											MarkdownDescription: "ObjectReference contains enough information to let you inspect or modify the referred object.",
+											Attributes: map[string]schema.Attribute{
+												"api_version": schema.StringAttribute{
+													Description:         "API version of the referent.",
+													MarkdownDescription: "API version of the referent.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"field_path": schema.StringAttribute{
+													Description:         "If referring to a piece of an object instead of an entire object, this string should contain a valid JSON/Go field access statement, such as desiredState.manifest.containers[2]. For example, if the object reference is to a container within a pod, this would take on a value like: 'spec.containers{name}' (where 'name' refers to the name of the container that triggered the event) or if no container name is specified 'spec.containers[2]' (container with index 2 in this pod). This syntax is chosen only to have some well-defined way of referencing a part of an object.",
+													MarkdownDescription: "If referring to a piece of an object instead of an entire object, this string should contain a valid JSON/Go field access statement, such as desiredState.manifest.containers[2]. For example, if the object reference is to a container within a pod, this would take on a value like: 'spec.containers{name}' (where 'name' refers to the name of the container that triggered the event) or if no container name is specified 'spec.containers[2]' (container with index 2 in this pod). This syntax is chosen only to have some well-defined way of referencing a part of an object.",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"kind": schema.StringAttribute{
+													Description:         "Kind of the referent. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+													MarkdownDescription: "Kind of the referent. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"name": schema.StringAttribute{
+													Description:         "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+													MarkdownDescription: "Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"namespace": schema.StringAttribute{
+													Description:         "Namespace of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/",
+													MarkdownDescription: "Namespace of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"resource_version": schema.StringAttribute{
+													Description:         "Specific resourceVersion to which this reference is made, if any. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency",
+													MarkdownDescription: "Specific resourceVersion to which this reference is made, if any. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+
+												"uid": schema.StringAttribute{
+													Description:         "UID of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#uids",
+													MarkdownDescription: "UID of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#uids",
+													Required:            false,
+													Optional:            false,
+													Computed:            true,
+												},
+											},
+											Required: false,
+											Optional: false,
+											Computed: true,
+										},
+
+										"volume_name": schema.StringAttribute{
+											Description:         "volumeName is the human-readable name of the StorageOS volume. Volume names are only unique within a namespace.",
+											MarkdownDescription: "volumeName is the human-readable name of the StorageOS volume. Volume names are only unique within a namespace.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"volume_namespace": schema.StringAttribute{
+											Description:         "volumeNamespace specifies the scope of the volume within StorageOS. If no namespace is specified then the Pod's namespace will be used. This allows the Kubernetes name scoping to be mirrored within StorageOS for tighter integration. Set VolumeName to any name to override the default behaviour. Set to 'default' if you are not using namespaces within StorageOS. Namespaces that do not pre-exist within StorageOS will be created.",
+											MarkdownDescription: "volumeNamespace specifies the scope of the volume within StorageOS. If no namespace is specified then the Pod's namespace will be used. This allows the Kubernetes name scoping to be mirrored within StorageOS for tighter integration. Set VolumeName to any name to override the default behaviour. Set to 'default' if you are not using namespaces within StorageOS. Namespaces that do not pre-exist within StorageOS will be created.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+
+								"volume_attributes_class_name": schema.StringAttribute{
+									Description:         "Name of VolumeAttributesClass to which this persistent volume belongs. Empty value is not allowed. When this field is not set, it indicates that this volume does not belong to any VolumeAttributesClass. This field is mutable and can be changed by the CSI driver after a volume has been updated successfully to a new class. For an unbound PersistentVolume, the volumeAttributesClassName will be matched with unbound PersistentVolumeClaims during the binding process. This is a beta field and requires enabling VolumeAttributesClass feature (off by default).",
+									MarkdownDescription: "Name of VolumeAttributesClass to which this persistent volume belongs. Empty value is not allowed. When this field is not set, it indicates that this volume does not belong to any VolumeAttributesClass. This field is mutable and can be changed by the CSI driver after a volume has been updated successfully to a new class. For an unbound PersistentVolume, the volumeAttributesClassName will be matched with unbound PersistentVolumeClaims during the binding process. This is a beta field and requires enabling VolumeAttributesClass feature (off by default).",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"volume_mode": schema.StringAttribute{
+									Description:         "volumeMode defines if a volume is intended to be used with a formatted filesystem or to remain in raw block state. Value of Filesystem is implied when not included in spec.",
+									MarkdownDescription: "volumeMode defines if a volume is intended to be used with a formatted filesystem or to remain in raw block state. Value of Filesystem is implied when not included in spec.",
+									Required:            false,
+									Optional:            false,
+									Computed:            true,
+								},
+
+								"vsphere_volume": schema.SingleNestedAttribute{
+									Description:         "Represents a vSphere volume resource.",
+									MarkdownDescription: "Represents a vSphere volume resource.",
+									Attributes: map[string]schema.Attribute{
+										"fs_type": schema.StringAttribute{
+											Description:         "fsType is filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified.",
+											MarkdownDescription: "fsType is filesystem type to mount. Must be a filesystem type supported by the host operating system. Ex. 'ext4', 'xfs', 'ntfs'. Implicitly inferred to be 'ext4' if unspecified.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"storage_policy_id": schema.StringAttribute{
+											Description:         "storagePolicyID is the storage Policy Based Management (SPBM) profile ID associated with the StoragePolicyName.",
+											MarkdownDescription: "storagePolicyID is the storage Policy Based Management (SPBM) profile ID associated with the StoragePolicyName.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"storage_policy_name": schema.StringAttribute{
+											Description:         "storagePolicyName is the storage Policy Based Management (SPBM) profile name.",
+											MarkdownDescription: "storagePolicyName is the storage Policy Based Management (SPBM) profile name.",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+
+										"volume_path": schema.StringAttribute{
+											Description:         "volumePath is the path that identifies vSphere volume vmdk",
+											MarkdownDescription: "volumePath is the path that identifies vSphere volume vmdk",
+											Required:            false,
+											Optional:            false,
+											Computed:            true,
+										},
+									},
+									Required: false,
+									Optional: false,
+									Computed: true,
+								},
+							},
+							Required: false,
+							Optional: false,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *PersistentVolumeV1ListDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	if dataSourceData, ok := request.ProviderData.(*utilities.DataSourceData); ok {
+		if dataSourceData.Offline {
+			response.Diagnostics.Append(utilities.OfflineProviderError())
+		} else {
+			r.kubernetesClient = dataSourceData.Client
+			r.kubernetesContext = dataSourceData.Context
+			r.restMapper = dataSourceData.RESTMapper
+		}
+	} else {
+		response.Diagnostics.Append(utilities.UnexpectedDataSourceDataError(request.ProviderData))
+	}
+}
+
+func (r *PersistentVolumeV1ListDataSource) ValidateConfig(_ context.Context, request datasource.ValidateConfigRequest, response *datasource.ValidateConfigResponse) {
+	// the provider is not configured while validating, and data sources with unknown values are read during apply
+	if r.restMapper == nil || !request.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	if !utilities.IsKindServed(r.restMapper, "v1", "PersistentVolume") {
+		response.Diagnostics.Append(utilities.MissingKindError(r.kubernetesContext, k8sSchema.GroupVersionResource{Group: "", Version: "v1", Resource: "persistentvolumes"}))
+	}
+}
+
+// groupVersionResource resolves the API resource of PersistentVolume through discovery and falls back to the plural "persistentvolumes".
+func (r *PersistentVolumeV1ListDataSource) groupVersionResource() k8sSchema.GroupVersionResource {
+	return utilities.GroupVersionResource(r.restMapper, "PersistentVolume", k8sSchema.GroupVersionResource{Group: "", Version: "v1", Resource: "persistentvolumes"})
+}
+
+func (r *PersistentVolumeV1ListDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Read data source k8s_persistent_volume_v1_list")
+
+	if r.kubernetesClient == nil {
+		response.Diagnostics.Append(utilities.UnknownProviderConfigurationError())
+		return
+	}
+
+	var data PersistentVolumeV1ListDataSourceData
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	groupVersionResource := r.groupVersionResource()
+	var resourceClient dynamic.ResourceInterface = r.kubernetesClient.
+		Resource(groupVersionResource)
+	if data.Namespace.ValueString() != "" {
+		resourceClient = r.kubernetesClient.
+			Resource(groupVersionResource).
+			Namespace(data.Namespace.ValueString())
+	}
+	listOptions := meta.ListOptions{
+		LabelSelector: data.LabelSelector.ValueString(),
+		FieldSelector: data.FieldSelector.ValueString(),
+		Limit:         500,
+	}
+	if !data.Limit.IsNull() {
+		listOptions.Limit = data.Limit.ValueInt64()
+	}
+
+	for {
+		listResponse, err := resourceClient.List(ctx, listOptions)
+		if err != nil {
+			response.Diagnostics.Append(utilities.ListResourcesError(err))
+			return
+		}
+		listBytes, err := listResponse.MarshalJSON()
+		if err != nil {
+			response.Diagnostics.Append(utilities.MarshalJsonError(err))
+			return
+		}
+
+		var page PersistentVolumeV1ListDataSourceData
+		err = json.Unmarshal(listBytes, &page)
+		if err != nil {
+			response.Diagnostics.Append(utilities.JsonUnmarshalError(err))
+			return
+		}
+		// the first page sets an empty list instead of null if no objects match
+		if data.Items == nil {
+			data.Items = page.Items
+		} else {
+			data.Items = append(data.Items, page.Items...)
+		}
+
+		listOptions.Continue = listResponse.GetContinue()
+		if listOptions.Continue == "" {
+			break
+		}
+		tflog.Debug(ctx, "Reading next page of k8s_persistent_volume_v1_list", map[string]interface{}{
+			"items": len(data.Items),
+		})
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,617 @@
+/*
+* SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+* SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/metio/terraform-provider-k8s/internal/utilities"
+	apiMeta "k8s.io/apimachinery/pkg/api/meta"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	k8sTypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/pointer"
+)
+
+var (
+	_ resource.Resource               = &ManifestBundleResource{}
+	_ resource.ResourceWithConfigure  = &ManifestBundleResource{}
+	_ resource.ResourceWithModifyPlan = &ManifestBundleResource{}
+)
+
+var customResourceDefinitionKind = k8sSchema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}
+
+var manifestBundleObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"api_version": types.StringType,
+		"kind":        types.StringType,
+		"namespace":   types.StringType,
+		"name":        types.StringType,
+		"uid":         types.StringType,
+	},
+}
+
+func NewManifestBundleResource() resource.Resource {
+	return &ManifestBundleResource{}
+}
+
+// ManifestBundleResource server-side applies all objects of a multi-document YAML bundle, e.g. the install manifests
+// of an operator. The identity of each applied object is tracked so that objects removed from the bundle are deleted.
+type ManifestBundleResource struct {
+	kubernetesClient dynamic.Interface
+	restMapper       apiMeta.ResettableRESTMapper
+	fieldManager     string
+	forceConflicts   bool
+}
+
+type ManifestBundleResourceData struct {
+	ForceConflicts      types.Bool   `tfsdk:"force_conflicts"`
+	FieldManager        types.String `tfsdk:"field_manager"`
+	DeletionPropagation types.String `tfsdk:"deletion_propagation"`
+	DeleteBehavior      types.String `tfsdk:"delete_behavior"`
+	WaitForReady        types.Bool   `tfsdk:"wait_for_ready"`
+	WaitForReadyTimeout types.Int64  `tfsdk:"wait_for_ready_timeout"`
+	Namespace           types.String `tfsdk:"namespace"`
+	Content             types.String `tfsdk:"content"`
+	Objects             types.List   `tfsdk:"objects"`
+}
+
+type ManifestBundleObjectData struct {
+	ApiVersion string       `tfsdk:"api_version"`
+	Kind       string       `tfsdk:"kind"`
+	Namespace  string       `tfsdk:"namespace"`
+	Name       string       `tfsdk:"name"`
+	Uid        types.String `tfsdk:"uid"`
+}
+
+func (r *ManifestBundleResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_manifest_bundle"
+}
+
+func (r *ManifestBundleResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description:         "Applies all objects of a multi-document YAML bundle, e.g. the install manifests of an operator. CustomResourceDefinitions and Namespaces are applied first, all other objects in the order of their documents. Objects removed from the bundle are deleted from the cluster.",
+		MarkdownDescription: "Applies all objects of a multi-document YAML bundle, e.g. the install manifests of an operator. CustomResourceDefinitions and Namespaces are applied first, all other objects in the order of their documents. Objects removed from the bundle are deleted from the cluster.",
+		Attributes: map[string]schema.Attribute{
+			"force_conflicts": schema.BoolAttribute{
+				Description:         "If 'true', server-side apply will force the changes against conflicts. If not specified uses the value from the provider configuration.",
+				MarkdownDescription: "If `true`, server-side apply will force the changes against conflicts. If not specified uses the value from the provider configuration.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
+			"field_manager": schema.StringAttribute{
+				Description:         "The name of the manager used to track field ownership. If not specified uses the value from the provider configuration.",
+				MarkdownDescription: "The name of the manager used to track field ownership. If not specified uses the value from the provider configuration.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"deletion_propagation": schema.StringAttribute{
+				Description:         "Decides if a deletion will propagate to the dependents of the objects, and how the garbage collector will handle the propagation.",
+				MarkdownDescription: "Decides if a deletion will propagate to the dependents of the objects, and how the garbage collector will handle the propagation.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("Orphan", "Background", "Foreground"),
+				},
+			},
+			"delete_behavior": schema.StringAttribute{
+				Description:         "Decides what happens to the objects in the cluster when the resource is destroyed. 'delete' removes the objects, 'orphan' only removes the resource from the Terraform state and leaves the objects in the cluster, and 'abandon_if_annotated' refuses to destroy the resource as long as any object carries the annotation 'terraform-provider-k8s/prevent-destroy: true'. Defaults to 'delete'.",
+				MarkdownDescription: "Decides what happens to the objects in the cluster when the resource is destroyed. `delete` removes the objects, `orphan` only removes the resource from the Terraform state and leaves the objects in the cluster, and `abandon_if_annotated` refuses to destroy the resource as long as any object carries the annotation `terraform-provider-k8s/prevent-destroy: true`. Defaults to `delete`.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Validators: []validator.String{
+					stringvalidator.OneOf("delete", "orphan", "abandon_if_annotated"),
+				},
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Description:         "If 'true', create/update of resources waits until all objects are ready. Deployments, StatefulSets and DaemonSets must be rolled out, Jobs must be completed, and all other kinds must report a 'Ready' condition with status 'True'.",
+				MarkdownDescription: "If `true`, create/update of resources waits until all objects are ready. Deployments, StatefulSets and DaemonSets must be rolled out, Jobs must be completed, and all other kinds must report a `Ready` condition with status `True`.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
+			"wait_for_ready_timeout": schema.Int64Attribute{
+				Description:         "The number of seconds to wait for each object to become ready. Defaults to '300'.",
+				MarkdownDescription: "The number of seconds to wait for each object to become ready. Defaults to `300`.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Description:         "The namespace of all namespaced objects which do not specify 'metadata.namespace' themselves.",
+				MarkdownDescription: "The namespace of all namespaced objects which do not specify `metadata.namespace` themselves.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description:         "Multi-document YAML containing the objects to apply. Documents are separated by '---' and objects of kind 'List' are expanded into their items.",
+				MarkdownDescription: "Multi-document YAML containing the objects to apply. Documents are separated by `---` and objects of kind `List` are expanded into their items.",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+			},
+			"objects": schema.ListNestedAttribute{
+				Description:         "The objects of the bundle in the order they are applied.",
+				MarkdownDescription: "The objects of the bundle in the order they are applied.",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"api_version": schema.StringAttribute{
+							Description:         "APIVersion of the object.",
+							MarkdownDescription: "APIVersion of the object.",
+							Computed:            true,
+						},
+						"kind": schema.StringAttribute{
+							Description:         "Kind of the object.",
+							MarkdownDescription: "Kind of the object.",
+							Computed:            true,
+						},
+						"namespace": schema.StringAttribute{
+							Description:         "Namespace of the object. Empty for cluster-scoped objects.",
+							MarkdownDescription: "Namespace of the object. Empty for cluster-scoped objects.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description:         "Name of the object.",
+							MarkdownDescription: "Name of the object.",
+							Computed:            true,
+						},
+						"uid": schema.StringAttribute{
+							Description:         "The unique in time and space value for the object.",
+							MarkdownDescription: "The unique in time and space value for the object.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ManifestBundleResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	if resourceData, ok := request.ProviderData.(*utilities.ResourceData); ok {
+		if resourceData.Offline {
+			response.Diagnostics.Append(utilities.OfflineProviderError())
+		} else {
+			r.kubernetesClient = resourceData.Client
+			r.restMapper = resourceData.RESTMapper
+			r.fieldManager = resourceData.FieldManager
+			r.forceConflicts = resourceData.ForceConflicts
+		}
+	} else {
+		response.Diagnostics.Append(utilities.UnexpectedResourceDataError(request.ProviderData))
+	}
+}
+
+func (r *ManifestBundleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create resource k8s_manifest_bundle")
+
+	if r.kubernetesClient == nil {
+		response.Diagnostics.Append(utilities.UnknownProviderConfigurationError())
+		return
+	}
+
+	var model ManifestBundleResourceData
+	response.Diagnostics.Append(request.Plan.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	applied, diags := r.apply(ctx, model)
+	response.Diagnostics.Append(diags...)
+
+	// objects applied before an error occurred are kept in state so that they can be deleted later on
+	model.Objects, diags = types.ListValueFrom(ctx, manifestBundleObjectType, applied)
+	response.Diagnostics.Append(diags...)
+	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+}
+
+func (r *ManifestBundleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	tflog.Debug(ctx, "Read resource k8s_manifest_bundle")
+
+	if r.kubernetesClient == nil {
+		response.Diagnostics.Append(utilities.UnknownProviderConfigurationWarning())
+		return
+	}
+
+	var data ManifestBundleResourceData
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var objects []ManifestBundleObjectData
+	response.Diagnostics.Append(data.Objects.ElementsAs(ctx, &objects, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	existing := make([]ManifestBundleObjectData, 0, len(objects))
+	for _, object := range objects {
+		resourceClient, gone, diags := r.trackedResource(object)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		if gone {
+			continue
+		}
+
+		getResponse, err := resourceClient.Get(ctx, object.Name, meta.GetOptions{})
+		if utilities.IsNotFound(err) {
+			tflog.Info(ctx, "Object of bundle no longer exists in the cluster", map[string]interface{}{
+				"api_version": object.ApiVersion,
+				"kind":        object.Kind,
+				"namespace":   object.Namespace,
+				"name":        object.Name,
+			})
+			continue
+		}
+		if err != nil {
+			response.Diagnostics.Append(utilities.GetNamespacedResourceError(err, object.Name, object.Namespace))
+			return
+		}
+
+		object.Uid = types.StringValue(string(getResponse.GetUID()))
+		existing = append(existing, object)
+	}
+
+	var diags diag.Diagnostics
+	data.Objects, diags = types.ListValueFrom(ctx, manifestBundleObjectType, existing)
+	response.Diagnostics.Append(diags...)
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ManifestBundleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Update resource k8s_manifest_bundle")
+
+	if r.kubernetesClient == nil {
+		response.Diagnostics.Append(utilities.UnknownProviderConfigurationError())
+		return
+	}
+
+	var model, state ManifestBundleResourceData
+	response.Diagnostics.Append(request.Plan.Get(ctx, &model)...)
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var previous []ManifestBundleObjectData
+	response.Diagnostics.Append(state.Objects.ElementsAs(ctx, &previous, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	applied, diags := r.apply(ctx, model)
+	response.Diagnostics.Append(diags...)
+
+	// objects are only removed from the cluster after all remaining objects of the bundle were applied successfully
+	removed := removedObjects(previous, applied)
+	if !response.Diagnostics.HasError() {
+		removed, diags = r.delete(ctx, state, removed)
+		response.Diagnostics.Append(diags...)
+	}
+
+	model.Objects, diags = types.ListValueFrom(ctx, manifestBundleObjectType, append(applied, removed...))
+	response.Diagnostics.Append(diags...)
+	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+}
+
+func (r *ManifestBundleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete resource k8s_manifest_bundle")
+
+	if r.kubernetesClient == nil {
+		response.Diagnostics.Append(utilities.UnknownProviderConfigurationError())
+		return
+	}
+
+	var data ManifestBundleResourceData
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DeleteBehavior.ValueString() == "orphan" {
+		tflog.Info(ctx, "Removing resource from state without deleting the objects from the cluster")
+		return
+	}
+
+	var objects []ManifestBundleObjectData
+	response.Diagnostics.Append(data.Objects.ElementsAs(ctx, &objects, false)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := r.delete(ctx, data, objects)
+	response.Diagnostics.Append(diags...)
+}
+
+func (r *ManifestBundleResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "ModifyPlan resource k8s_manifest_bundle")
+
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var model ManifestBundleResourceData
+	response.Diagnostics.Append(request.Plan.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() || model.Content.IsUnknown() || model.Namespace.IsUnknown() {
+		return
+	}
+
+	objects, err := utilities.DecodeManifests(model.Content.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("content"), "Invalid Manifest", "The supplied content cannot be decoded into Kubernetes objects.\n\nError: "+err.Error())
+		return
+	}
+	utilities.SortByApplyOrder(objects)
+
+	uids := map[string]types.String{}
+	if !request.State.Raw.IsNull() {
+		var state ManifestBundleResourceData
+		var previous []ManifestBundleObjectData
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		response.Diagnostics.Append(state.Objects.ElementsAs(ctx, &previous, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		for _, object := range previous {
+			uids[manifestBundleObjectKey(object)] = object.Uid
+		}
+	}
+
+	planned := make([]ManifestBundleObjectData, 0, len(objects))
+	for _, object := range objects {
+		if err := r.defaultNamespace(object, model.Namespace.ValueString()); err != nil {
+			tflog.Debug(ctx, "Unable to plan the objects of the bundle since the scope of a kind cannot be resolved yet", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return
+		}
+		trackedObject := manifestBundleObject(object, types.StringUnknown())
+		if uid, exists := uids[manifestBundleObjectKey(trackedObject)]; exists {
+			trackedObject.Uid = uid
+		}
+		planned = append(planned, trackedObject)
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("objects"), planned)...)
+}
+
+// apply server-side applies all objects of the bundle in dependency order and returns the objects applied so far.
+// CustomResourceDefinitions must be established before custom resources of the same bundle can be applied.
+func (r *ManifestBundleResource) apply(ctx context.Context, model ManifestBundleResourceData) ([]ManifestBundleObjectData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if r.restMapper == nil {
+		diags.Append(utilities.MissingRESTMapperError())
+		return nil, diags
+	}
+
+	objects, err := utilities.DecodeManifests(model.Content.ValueString())
+	if err != nil {
+		diags.Append(utilities.InvalidManifestError(err.Error()))
+		return nil, diags
+	}
+	utilities.SortByApplyOrder(objects)
+
+	forceConflicts := r.forceConflicts
+	if !model.ForceConflicts.IsNull() && !model.ForceConflicts.IsUnknown() {
+		forceConflicts = model.ForceConflicts.ValueBool()
+	}
+	fieldManager := r.fieldManager
+	if !model.FieldManager.IsNull() && !model.FieldManager.IsUnknown() {
+		fieldManager = model.FieldManager.ValueString()
+	}
+	patchOptions := meta.PatchOptions{
+		FieldManager:    fieldManager,
+		Force:           pointer.Bool(forceConflicts),
+		FieldValidation: "Strict",
+	}
+
+	applied := make([]ManifestBundleObjectData, 0, len(objects))
+	clients := make([]dynamic.ResourceInterface, 0, len(objects))
+	for _, object := range objects {
+		if err := r.defaultNamespace(object, model.Namespace.ValueString()); err != nil {
+			diags.Append(utilities.RESTMappingError(err, object.GetAPIVersion(), object.GetKind()))
+			return applied, diags
+		}
+		resourceClient, clientDiags := utilities.DynamicResource(r.kubernetesClient, r.restMapper, object)
+		diags.Append(clientDiags...)
+		if diags.HasError() {
+			return applied, diags
+		}
+
+		bytes, err := object.MarshalJSON()
+		if err != nil {
+			diags.Append(utilities.JsonMarshalError(err))
+			return applied, diags
+		}
+
+		tflog.Debug(ctx, "Applying object of bundle", map[string]interface{}{
+			"api_version": object.GetAPIVersion(),
+			"kind":        object.GetKind(),
+			"namespace":   object.GetNamespace(),
+			"name":        object.GetName(),
+		})
+		patchResponse, err := resourceClient.Patch(ctx, object.GetName(), k8sTypes.ApplyPatchType, bytes, patchOptions)
+		if err != nil {
+			diags.Append(utilities.ObjectPatchErrors(err, path.Root("content"), object)...)
+			return applied, diags
+		}
+		applied = append(applied, manifestBundleObject(object, types.StringValue(string(patchResponse.GetUID()))))
+		clients = append(clients, resourceClient)
+
+		if object.GroupVersionKind().GroupKind() == customResourceDefinitionKind {
+			diags.Append(utilities.WaitForReady(ctx, resourceClient, object.GetName(), model.WaitForReadyTimeout)...)
+			if diags.HasError() {
+				return applied, diags
+			}
+		}
+	}
+
+	if model.WaitForReady.ValueBool() {
+		for index, object := range objects {
+			if object.GroupVersionKind().GroupKind() == customResourceDefinitionKind {
+				continue
+			}
+			diags.Append(utilities.WaitForReady(ctx, clients[index], object.GetName(), model.WaitForReadyTimeout)...)
+			if diags.HasError() {
+				return applied, diags
+			}
+		}
+	}
+
+	return applied, diags
+}
+
+// delete removes the given objects from the cluster in reverse order and returns all objects which could not be
+// deleted.
+func (r *ManifestBundleResource) delete(ctx context.Context, data ManifestBundleResourceData, objects []ManifestBundleObjectData) ([]ManifestBundleObjectData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	deleteOptions := meta.DeleteOptions{}
+	if !data.DeletionPropagation.IsNull() && !data.DeletionPropagation.IsUnknown() {
+		deleteOptions.PropagationPolicy = utilities.MapDeletionPropagation(data.DeletionPropagation.ValueString())
+	}
+
+	for index := len(objects) - 1; index >= 0; index-- {
+		object := objects[index]
+		resourceClient, gone, clientDiags := r.trackedResource(object)
+		diags.Append(clientDiags...)
+		if diags.HasError() {
+			return objects[:index+1], diags
+		}
+		if gone {
+			continue
+		}
+
+		if data.DeleteBehavior.ValueString() == "abandon_if_annotated" {
+			liveObject, err := resourceClient.Get(ctx, object.Name, meta.GetOptions{})
+			if err != nil && !utilities.IsNotFound(err) {
+				diags.Append(utilities.GetNamespacedResourceError(err, object.Name, object.Namespace))
+				return objects[:index+1], diags
+			}
+			if utilities.IsDeletionProtected(liveObject) {
+				diags.Append(utilities.DeletionProtectedError())
+				return objects[:index+1], diags
+			}
+		}
+
+		tflog.Debug(ctx, "Deleting object of bundle", map[string]interface{}{
+			"api_version": object.ApiVersion,
+			"kind":        object.Kind,
+			"namespace":   object.Namespace,
+			"name":        object.Name,
+		})
+		err := resourceClient.Delete(ctx, object.Name, deleteOptions)
+		if utilities.IsDeletionError(err) {
+			diags.Append(utilities.DeleteError(err))
+			return objects[:index+1], diags
+		}
+	}
+
+	return nil, diags
+}
+
+// trackedResource returns the client for an object tracked in state. Objects whose kind is no longer served by the
+// cluster, e.g. because its CRD was deleted, are reported as gone.
+func (r *ManifestBundleResource) trackedResource(object ManifestBundleObjectData) (dynamic.ResourceInterface, bool, diag.Diagnostics) {
+	if r.restMapper == nil {
+		return nil, false, diag.Diagnostics{utilities.MissingRESTMapperError()}
+	}
+	if _, err := utilities.RESTMapping(r.restMapper, object.ApiVersion, object.Kind); apiMeta.IsNoMatchError(err) {
+		return nil, true, nil
+	}
+
+	trackedObject := &unstructured.Unstructured{}
+	trackedObject.SetAPIVersion(object.ApiVersion)
+	trackedObject.SetKind(object.Kind)
+	trackedObject.SetNamespace(object.Namespace)
+	trackedObject.SetName(object.Name)
+	resourceClient, diags := utilities.DynamicResource(r.kubernetesClient, r.restMapper, trackedObject)
+	return resourceClient, false, diags
+}
+
+// defaultNamespace sets the given namespace on namespaced objects which do not specify a namespace themselves.
+func (r *ManifestBundleResource) defaultNamespace(object *unstructured.Unstructured, namespace string) error {
+	if object.GetNamespace() != "" || namespace == "" {
+		return nil
+	}
+	if r.restMapper == nil {
+		return errors.New("discovery is not available")
+	}
+	mapping, err := utilities.RESTMapping(r.restMapper, object.GetAPIVersion(), object.GetKind())
+	if err != nil {
+		return err
+	}
+	if mapping.Scope.Name() == apiMeta.RESTScopeNameNamespace {
+		object.SetNamespace(namespace)
+	}
+	return nil
+}
+
+func manifestBundleObject(object *unstructured.Unstructured, uid types.String) ManifestBundleObjectData {
+	return ManifestBundleObjectData{
+		ApiVersion: object.GetAPIVersion(),
+		Kind:       object.GetKind(),
+		Namespace:  object.GetNamespace(),
+		Name:       object.GetName(),
+		Uid:        uid,
+	}
+}
+
+func manifestBundleObjectKey(object ManifestBundleObjectData) string {
+	return utilities.ManifestKey(object.ApiVersion, object.Kind, object.Namespace, object.Name)
+}
+
+// removedObjects returns all previously tracked objects which are not part of the applied objects anymore.
+func removedObjects(previous []ManifestBundleObjectData, applied []ManifestBundleObjectData) []ManifestBundleObjectData {
+	appliedKeys := make(map[string]bool, len(applied))
+	for _, object := range applied {
+		appliedKeys[manifestBundleObjectKey(object)] = true
+	}
+	var removed []ManifestBundleObjectData
+	for _, object := range previous {
+		if !appliedKeys[manifestBundleObjectKey(object)] {
+			removed = append(removed, object)
+		}
+	}
+	return removed
+}
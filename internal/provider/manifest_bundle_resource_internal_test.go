@@ -0,0 +1,243 @@
+/*
+* SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+* SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	apiMeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	k8sTypes "k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sTesting "k8s.io/client-go/testing"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const bundleContent = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: first
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: second
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: example
+`
+
+type resettableMapper struct {
+	*apiMeta.DefaultRESTMapper
+}
+
+func (m *resettableMapper) Reset() {}
+
+func newBundleTestResource(client *dynamicfake.FakeDynamicClient) *ManifestBundleResource {
+	mapper := apiMeta.NewDefaultRESTMapper(nil)
+	mapper.Add(k8sSchema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, apiMeta.RESTScopeNamespace)
+	mapper.Add(k8sSchema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, apiMeta.RESTScopeRoot)
+	return &ManifestBundleResource{
+		kubernetesClient: client,
+		restMapper:       &resettableMapper{DefaultRESTMapper: mapper},
+		fieldManager:     "terraform",
+	}
+}
+
+// failingPatches records the names of all patched objects and fails patches of the given object.
+func failingPatches(client *dynamicfake.FakeDynamicClient, failing string, patched *[]string) {
+	client.PrependReactor("patch", "*", func(action k8sTesting.Action) (bool, runtime.Object, error) {
+		patchAction := action.(k8sTesting.PatchAction)
+		if patchAction.GetName() == failing {
+			return true, nil, errors.New("admission webhook denied the request")
+		}
+		*patched = append(*patched, patchAction.GetName())
+		object := &unstructured.Unstructured{}
+		object.SetName(patchAction.GetName())
+		object.SetNamespace(patchAction.GetNamespace())
+		object.SetUID(k8sTypes.UID("uid-" + patchAction.GetName()))
+		return true, object, nil
+	})
+}
+
+func bundleObject(kind string, namespace string, name string) ManifestBundleObjectData {
+	return ManifestBundleObjectData{ApiVersion: "v1", Kind: kind, Namespace: namespace, Name: name, Uid: types.StringValue("uid-" + name)}
+}
+
+func TestRemovedObjects(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		previous []ManifestBundleObjectData
+		applied  []ManifestBundleObjectData
+		expected []ManifestBundleObjectData
+	}
+	tests := map[string]testCase{
+		"nothing removed": {
+			previous: []ManifestBundleObjectData{bundleObject("ConfigMap", "default", "first")},
+			applied:  []ManifestBundleObjectData{bundleObject("ConfigMap", "default", "first")},
+			expected: nil,
+		},
+		"removed object": {
+			previous: []ManifestBundleObjectData{bundleObject("ConfigMap", "default", "first"), bundleObject("ConfigMap", "default", "second")},
+			applied:  []ManifestBundleObjectData{bundleObject("ConfigMap", "default", "first")},
+			expected: []ManifestBundleObjectData{bundleObject("ConfigMap", "default", "second")},
+		},
+		"moved to other namespace": {
+			previous: []ManifestBundleObjectData{bundleObject("ConfigMap", "default", "first")},
+			applied:  []ManifestBundleObjectData{bundleObject("ConfigMap", "other", "first")},
+			expected: []ManifestBundleObjectData{bundleObject("ConfigMap", "default", "first")},
+		},
+		"changed kind": {
+			previous: []ManifestBundleObjectData{bundleObject("ConfigMap", "default", "first")},
+			applied:  []ManifestBundleObjectData{bundleObject("Secret", "default", "first")},
+			expected: []ManifestBundleObjectData{bundleObject("ConfigMap", "default", "first")},
+		},
+		"new object": {
+			previous: nil,
+			applied:  []ManifestBundleObjectData{bundleObject("ConfigMap", "default", "first")},
+			expected: nil,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := removedObjects(test.previous, test.applied); !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected %+v, got %+v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestManifestBundleResource_Delete(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		failing           string
+		expectedDeleted   []string
+		expectedRemaining []ManifestBundleObjectData
+	}
+	tests := map[string]testCase{
+		"reverse order": {
+			expectedDeleted:   []string{"second", "first", "example"},
+			expectedRemaining: nil,
+		},
+		"failing object": {
+			failing:         "first",
+			expectedDeleted: []string{"second"},
+			expectedRemaining: []ManifestBundleObjectData{
+				bundleObject("Namespace", "", "example"),
+				bundleObject("ConfigMap", "example", "first"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+			var deleted []string
+			client.PrependReactor("delete", "*", func(action k8sTesting.Action) (bool, runtime.Object, error) {
+				name := action.(k8sTesting.DeleteAction).GetName()
+				if name == test.failing {
+					return true, nil, errors.New("deletion is forbidden")
+				}
+				deleted = append(deleted, name)
+				return true, nil, nil
+			})
+			objects := []ManifestBundleObjectData{
+				bundleObject("Namespace", "", "example"),
+				bundleObject("ConfigMap", "example", "first"),
+				bundleObject("ConfigMap", "example", "second"),
+			}
+
+			remaining, diags := newBundleTestResource(client).delete(context.Background(), ManifestBundleResourceData{}, objects)
+
+			if diags.HasError() != (test.failing != "") {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(deleted, test.expectedDeleted) {
+				t.Errorf("expected deletion order %v, got %v", test.expectedDeleted, deleted)
+			}
+			if !reflect.DeepEqual(remaining, test.expectedRemaining) {
+				t.Errorf("expected remaining objects %+v, got %+v", test.expectedRemaining, remaining)
+			}
+		})
+	}
+}
+
+func TestManifestBundleResource_CreatePartialApply(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	var patched []string
+	failingPatches(client, "second", &patched)
+	bundle := newBundleTestResource(client)
+
+	schemaResponse := &resource.SchemaResponse{}
+	bundle.Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+	terraformType := schemaResponse.Schema.Type().TerraformType(ctx)
+	plan := tfsdk.Plan{Schema: schemaResponse.Schema, Raw: tftypes.NewValue(terraformType, nil)}
+	diags := plan.Set(ctx, &ManifestBundleResourceData{
+		ForceConflicts:      types.BoolNull(),
+		FieldManager:        types.StringNull(),
+		DeletionPropagation: types.StringNull(),
+		DeleteBehavior:      types.StringNull(),
+		WaitForReady:        types.BoolValue(false),
+		WaitForReadyTimeout: types.Int64Null(),
+		Namespace:           types.StringValue("example"),
+		Content:             types.StringValue(bundleContent),
+		Objects:             types.ListUnknown(manifestBundleObjectType),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	response := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResponse.Schema, Raw: tftypes.NewValue(terraformType, nil)}}
+	bundle.Create(ctx, resource.CreateRequest{Plan: plan}, response)
+
+	if !reflect.DeepEqual(patched, []string{"example", "first"}) {
+		t.Errorf("expected the namespace to be applied first, got %v", patched)
+	}
+	if len(response.Diagnostics) != 1 {
+		t.Fatalf("expected a single diagnostic, got %v", response.Diagnostics)
+	}
+	withPath, ok := response.Diagnostics[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root("content")) {
+		t.Errorf("expected diagnostic attached to content, got %v", response.Diagnostics[0])
+	}
+	if !strings.Contains(response.Diagnostics[0].Detail(), "Object: v1 ConfigMap example/second") {
+		t.Errorf("expected detail to name the failing object, got %q", response.Diagnostics[0].Detail())
+	}
+
+	var state ManifestBundleResourceData
+	response.Diagnostics.Append(response.State.Get(ctx, &state)...)
+	var objects []ManifestBundleObjectData
+	response.Diagnostics.Append(state.Objects.ElementsAs(ctx, &objects, false)...)
+	expected := []ManifestBundleObjectData{
+		bundleObject("Namespace", "", "example"),
+		bundleObject("ConfigMap", "example", "first"),
+	}
+	if !reflect.DeepEqual(objects, expected) {
+		t.Errorf("expected applied objects %+v in state, got %+v", expected, objects)
+	}
+}
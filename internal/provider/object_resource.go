@@ -0,0 +1,726 @@
+/*
+* SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+* SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/metio/terraform-provider-k8s/internal/custom_types"
+	"github.com/metio/terraform-provider-k8s/internal/utilities"
+	apiMeta "k8s.io/apimachinery/pkg/api/meta"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sTypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/pointer"
+)
+
+var (
+	_ resource.Resource                = &ObjectResource{}
+	_ resource.ResourceWithConfigure   = &ObjectResource{}
+	_ resource.ResourceWithImportState = &ObjectResource{}
+	_ resource.ResourceWithModifyPlan  = &ObjectResource{}
+	_ resource.ResourceWithIdentity    = &ObjectResource{}
+)
+
+// serverPopulatedFields are set by the API server and never part of a manifest written by users.
+var serverPopulatedFields = [][]string{
+	{"metadata", "managedFields"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "uid"},
+	{"metadata", "generation"},
+	{"metadata", "creationTimestamp"},
+	{"metadata", "selfLink"},
+	{"status"},
+}
+
+func NewObjectResource() resource.Resource {
+	return &ObjectResource{}
+}
+
+// ObjectResource manages objects of arbitrary kinds, e.g. of CRDs that are installed in the cluster but unknown to
+// this provider. The GroupVersionResource of the object is resolved at runtime through discovery.
+type ObjectResource struct {
+	kubernetesClient  dynamic.Interface
+	kubernetesContext string
+	restMapper        apiMeta.ResettableRESTMapper
+	fieldManager      string
+	forceConflicts    bool
+}
+
+type ObjectResourceData struct {
+	ForceConflicts      types.Bool              `tfsdk:"force_conflicts"`
+	FieldManager        types.String            `tfsdk:"field_manager"`
+	DeletionPropagation types.String            `tfsdk:"deletion_propagation"`
+	DeleteBehavior      types.String            `tfsdk:"delete_behavior"`
+	WaitForUpsert       types.List              `tfsdk:"wait_for_upsert"`
+	WaitForDelete       types.Object            `tfsdk:"wait_for_delete"`
+	WaitForReady        types.Bool              `tfsdk:"wait_for_ready"`
+	WaitForReadyTimeout types.Int64             `tfsdk:"wait_for_ready_timeout"`
+	ApiVersion          types.String            `tfsdk:"api_version"`
+	Kind                types.String            `tfsdk:"kind"`
+	Manifest            custom_types.Normalized `tfsdk:"manifest"`
+	Uid                 types.String            `tfsdk:"uid"`
+}
+
+func (r *ObjectResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_object"
+}
+
+func (r *ObjectResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description:         "Manages objects of any kind served by the Kubernetes API server, e.g. custom resources of CRDs unknown to this provider. The object is described by a JSON manifest and its API resource is resolved through discovery.",
+		MarkdownDescription: "Manages objects of any kind served by the Kubernetes API server, e.g. custom resources of CRDs unknown to this provider. The object is described by a JSON manifest and its API resource is resolved through discovery.",
+		Attributes: map[string]schema.Attribute{
+			"force_conflicts": schema.BoolAttribute{
+				Description:         "If 'true', server-side apply will force the changes against conflicts. If not specified uses the value from the provider configuration.",
+				MarkdownDescription: "If `true`, server-side apply will force the changes against conflicts. If not specified uses the value from the provider configuration.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
+			"field_manager": schema.StringAttribute{
+				Description:         "The name of the manager used to track field ownership. If not specified uses the value from the provider configuration.",
+				MarkdownDescription: "The name of the manager used to track field ownership. If not specified uses the value from the provider configuration.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"deletion_propagation": schema.StringAttribute{
+				Description:         "Decides if a deletion will propagate to the dependents of the object, and how the garbage collector will handle the propagation.",
+				MarkdownDescription: "Decides if a deletion will propagate to the dependents of the object, and how the garbage collector will handle the propagation.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("Orphan", "Background", "Foreground"),
+				},
+			},
+			"delete_behavior": schema.StringAttribute{
+				Description:         "Decides what happens to the object in the cluster when the resource is destroyed. 'delete' removes the object, 'orphan' only removes the resource from the Terraform state and leaves the object in the cluster, and 'abandon_if_annotated' refuses to destroy the resource as long as the object carries the annotation 'terraform-provider-k8s/prevent-destroy: true'. Defaults to 'delete'.",
+				MarkdownDescription: "Decides what happens to the object in the cluster when the resource is destroyed. `delete` removes the object, `orphan` only removes the resource from the Terraform state and leaves the object in the cluster, and `abandon_if_annotated` refuses to destroy the resource as long as the object carries the annotation `terraform-provider-k8s/prevent-destroy: true`. Defaults to `delete`.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Validators: []validator.String{
+					stringvalidator.OneOf("delete", "orphan", "abandon_if_annotated"),
+				},
+			},
+			"wait_for_upsert": schema.ListNestedAttribute{
+				Description:         "Wait for specific conditions after create/update of resources.",
+				MarkdownDescription: "Wait for specific conditions after create/update of resources.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"jsonpath": schema.StringAttribute{
+							Description:         "Relaxed JSONPath expression to use. See https://pkg.go.dev/k8s.io/kubectl/pkg/cmd/get#RelaxedJSONPathExpression for details.",
+							MarkdownDescription: "Relaxed JSONPath expression to use. See https://pkg.go.dev/k8s.io/kubectl/pkg/cmd/get#RelaxedJSONPathExpression for details.",
+							Required:            true,
+							Optional:            false,
+							Computed:            false,
+						},
+						"value": schema.StringAttribute{
+							Description:         "The value to wait for. If not specified, waiting will complete as soon as JSONPath expression exists and has any non-empty value.",
+							MarkdownDescription: "The value to wait for. If not specified, waiting will complete as soon as JSONPath expression exists and has any non-empty value.",
+							Required:            false,
+							Optional:            true,
+							Computed:            false,
+						},
+						"timeout": schema.Int64Attribute{
+							Description:         "The number of seconds to wait before giving up. Zero means check once and don't wait.",
+							MarkdownDescription: "The number of seconds to wait before giving up. Zero means check once and don't wait.",
+							Required:            false,
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(30),
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"poll_interval": schema.Int64Attribute{
+							Description:         "The number of seconds to wait before checking again.",
+							MarkdownDescription: "The number of seconds to wait before checking again.",
+							Required:            false,
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(5),
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+			"wait_for_delete": schema.SingleNestedAttribute{
+				Description:         "Wait for deletion of resources.",
+				MarkdownDescription: "Wait for deletion of resources.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Attributes: map[string]schema.Attribute{
+					"timeout": schema.Int64Attribute{
+						Description:         "The number of seconds to wait before giving up. Zero means check once and don't wait.",
+						MarkdownDescription: "The number of seconds to wait before giving up. Zero means check once and don't wait.",
+						Required:            false,
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(30),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+					"poll_interval": schema.Int64Attribute{
+						Description:         "The number of seconds to wait before checking again.",
+						MarkdownDescription: "The number of seconds to wait before checking again.",
+						Required:            false,
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(5),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+				},
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Description:         "If 'true', create/update of resources waits until the resource is ready. Deployments, StatefulSets and DaemonSets must be rolled out, Jobs must be completed, and all other kinds must report a 'Ready' condition with status 'True'.",
+				MarkdownDescription: "If `true`, create/update of resources waits until the resource is ready. Deployments, StatefulSets and DaemonSets must be rolled out, Jobs must be completed, and all other kinds must report a `Ready` condition with status `True`.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+			},
+			"wait_for_ready_timeout": schema.Int64Attribute{
+				Description:         "The number of seconds to wait for the resource to become ready. Defaults to '300'.",
+				MarkdownDescription: "The number of seconds to wait for the resource to become ready. Defaults to `300`.",
+				Required:            false,
+				Optional:            true,
+				Computed:            false,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"api_version": schema.StringAttribute{
+				Description:         "APIVersion of the object, e.g. 'v1' or 'cert-manager.io/v1'.",
+				MarkdownDescription: "APIVersion of the object, e.g. `v1` or `cert-manager.io/v1`.",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Description:         "Kind of the object, e.g. 'ConfigMap' or 'Certificate'.",
+				MarkdownDescription: "Kind of the object, e.g. `ConfigMap` or `Certificate`.",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"manifest": schema.StringAttribute{
+				Description:         "JSON encoded object, e.g. created with 'jsonencode'. The manifest must contain 'metadata.name' as well as 'metadata.namespace' for namespaced kinds. Its 'apiVersion' and 'kind' are taken from the attributes of this resource.",
+				MarkdownDescription: "JSON encoded object, e.g. created with `jsonencode`. The manifest must contain `metadata.name` as well as `metadata.namespace` for namespaced kinds. Its `apiVersion` and `kind` are taken from the attributes of this resource.",
+				Required:            true,
+				Optional:            false,
+				Computed:            false,
+				CustomType:          custom_types.NormalizedType{},
+			},
+			"uid": schema.StringAttribute{
+				Description:         "The unique in time and space value for this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#uids for more details.",
+				MarkdownDescription: "The unique in time and space value for this object. See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#uids for more details.",
+				Required:            false,
+				Optional:            false,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ObjectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"api_version": identityschema.StringAttribute{
+				Description:       "The apiVersion of the object.",
+				RequiredForImport: true,
+			},
+			"kind": identityschema.StringAttribute{
+				Description:       "The kind of the object.",
+				RequiredForImport: true,
+			},
+			"namespace": identityschema.StringAttribute{
+				Description:       "The namespace of the object. Empty for cluster-scoped kinds.",
+				OptionalForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "The name of the object.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *ObjectResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	if resourceData, ok := request.ProviderData.(*utilities.ResourceData); ok {
+		if resourceData.Offline {
+			response.Diagnostics.Append(utilities.OfflineProviderError())
+		} else {
+			r.kubernetesClient = resourceData.Client
+			r.kubernetesContext = resourceData.Context
+			r.restMapper = resourceData.RESTMapper
+			r.fieldManager = resourceData.FieldManager
+			r.forceConflicts = resourceData.ForceConflicts
+		}
+	} else {
+		response.Diagnostics.Append(utilities.UnexpectedResourceDataError(request.ProviderData))
+	}
+}
+
+func (r *ObjectResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	tflog.Debug(ctx, "Create resource k8s_object")
+
+	if r.kubernetesClient == nil {
+		response.Diagnostics.Append(utilities.UnknownProviderConfigurationError())
+		return
+	}
+
+	var model ObjectResourceData
+	response.Diagnostics.Append(request.Plan.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.apply(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+	if response.Identity != nil {
+		response.Diagnostics.Append(r.setIdentity(ctx, response.Identity, &model)...)
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.wait(ctx, &model)...)
+}
+
+func (r *ObjectResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	tflog.Debug(ctx, "Read resource k8s_object")
+
+	if r.kubernetesClient == nil {
+		response.Diagnostics.Append(utilities.UnknownProviderConfigurationWarning())
+		return
+	}
+
+	var data ObjectResourceData
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	object, diags := r.manifestObject(data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	resourceClient, diags := r.resourceClient(object)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	getResponse, err := resourceClient.Get(ctx, object.GetName(), meta.GetOptions{})
+	if utilities.IsNotFound(err) {
+		tflog.Info(ctx, "Removing resource from state since the object no longer exists")
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.Append(r.getError(err, object))
+		return
+	}
+
+	fieldManager := r.fieldManager
+	if !data.FieldManager.IsNull() && !data.FieldManager.IsUnknown() {
+		fieldManager = data.FieldManager.ValueString()
+	}
+	ownedBytes, err := utilities.OwnedFieldsJSON(getResponse, fieldManager, []byte(data.Manifest.ValueString()))
+	if err != nil {
+		response.Diagnostics.Append(utilities.ManagedFieldsError(err))
+		return
+	}
+
+	var manifest map[string]interface{}
+	err = json.Unmarshal(ownedBytes, &manifest)
+	if err != nil {
+		response.Diagnostics.Append(utilities.JsonUnmarshalError(err))
+		return
+	}
+	for _, field := range serverPopulatedFields {
+		unstructured.RemoveNestedField(manifest, field...)
+	}
+	// apiVersion and kind are configured through their own attributes and only kept if the manifest contained them
+	var previous map[string]interface{}
+	_ = json.Unmarshal([]byte(data.Manifest.ValueString()), &previous)
+	for _, field := range []string{"apiVersion", "kind"} {
+		if _, found := previous[field]; !found {
+			delete(manifest, field)
+		}
+	}
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		response.Diagnostics.Append(utilities.JsonMarshalError(err))
+		return
+	}
+	data.Manifest = custom_types.NewNormalizedValue(string(manifestBytes))
+	data.Uid = types.StringValue(string(getResponse.GetUID()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+	if response.Identity != nil {
+		response.Diagnostics.Append(r.setIdentity(ctx, response.Identity, &data)...)
+	}
+}
+
+func (r *ObjectResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Update resource k8s_object")
+
+	if r.kubernetesClient == nil {
+		response.Diagnostics.Append(utilities.UnknownProviderConfigurationError())
+		return
+	}
+
+	var model ObjectResourceData
+	response.Diagnostics.Append(request.Plan.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.apply(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+	if response.Identity != nil {
+		response.Diagnostics.Append(r.setIdentity(ctx, response.Identity, &model)...)
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.wait(ctx, &model)...)
+}
+
+func (r *ObjectResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Delete resource k8s_object")
+
+	if r.kubernetesClient == nil {
+		response.Diagnostics.Append(utilities.UnknownProviderConfigurationError())
+		return
+	}
+
+	var data ObjectResourceData
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DeleteBehavior.ValueString() == "orphan" {
+		tflog.Info(ctx, "Removing resource from state without deleting the object from the cluster")
+		return
+	}
+
+	object, diags := r.manifestObject(data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	resourceClient, diags := r.resourceClient(object)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DeleteBehavior.ValueString() == "abandon_if_annotated" {
+		liveObject, err := resourceClient.Get(ctx, object.GetName(), meta.GetOptions{})
+		if err != nil && !utilities.IsNotFound(err) {
+			response.Diagnostics.Append(r.getError(err, object))
+			return
+		}
+		if utilities.IsDeletionProtected(liveObject) {
+			response.Diagnostics.Append(utilities.DeletionProtectedError())
+			return
+		}
+	}
+
+	deleteOptions := meta.DeleteOptions{}
+	if !data.DeletionPropagation.IsNull() && !data.DeletionPropagation.IsUnknown() {
+		deleteOptions.PropagationPolicy = utilities.MapDeletionPropagation(data.DeletionPropagation.ValueString())
+	}
+
+	err := resourceClient.Delete(ctx, object.GetName(), deleteOptions)
+	if utilities.IsDeletionError(err) {
+		response.Diagnostics.Append(utilities.DeleteError(err))
+		return
+	}
+
+	if !data.WaitForDelete.IsNull() && !data.WaitForDelete.IsUnknown() {
+		response.Diagnostics.Append(utilities.WaitForDelete(ctx, resourceClient, object.GetName(), data.WaitForDelete.Attributes())...)
+	}
+}
+
+func (r *ObjectResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	tflog.Debug(ctx, "ModifyPlan resource k8s_object")
+
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var apiVersion, kind types.String
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("api_version"), &apiVersion)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("kind"), &kind)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if r.restMapper != nil && !apiVersion.IsUnknown() && !kind.IsUnknown() {
+		_, err := utilities.RESTMapping(r.restMapper, apiVersion.ValueString(), kind.ValueString())
+		if apiMeta.IsNoMatchError(err) {
+			if request.ClientCapabilities.DeferralAllowed {
+				tflog.Debug(ctx, "Deferring plan since the cluster does not serve "+kind.ValueString()+" yet")
+				response.Deferred = &resource.Deferred{Reason: resource.DeferredReasonAbsentPrereq}
+				return
+			}
+			response.Diagnostics.Append(utilities.RESTMappingError(err, apiVersion.ValueString(), kind.ValueString()))
+			return
+		}
+	}
+
+	if request.State.Raw.IsNull() {
+		return
+	}
+
+	var plannedManifest, stateManifest custom_types.Normalized
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("manifest"), &plannedManifest)...)
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("manifest"), &stateManifest)...)
+	if response.Diagnostics.HasError() || plannedManifest.IsUnknown() || plannedManifest.IsNull() || stateManifest.IsNull() {
+		return
+	}
+
+	var planned, state unstructured.Unstructured
+	if plannedManifest.Unmarshal(&planned.Object).HasError() || stateManifest.Unmarshal(&state.Object).HasError() {
+		return
+	}
+	if planned.GetName() != state.GetName() || planned.GetNamespace() != state.GetNamespace() {
+		response.RequiresReplace = append(response.RequiresReplace, path.Root("manifest"))
+	}
+}
+
+func (r *ObjectResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	var importID utilities.ImportID
+	if request.ID == "" && request.Identity != nil {
+		response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root("api_version"), &importID.APIVersion)...)
+		response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root("kind"), &importID.Kind)...)
+		response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root("namespace"), &importID.Namespace)...)
+		response.Diagnostics.Append(request.Identity.GetAttribute(ctx, path.Root("name"), &importID.Name)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		parsedID, err := utilities.ParseObjectImportID(request.ID, r.kubernetesContext)
+		if err != nil {
+			response.Diagnostics.Append(utilities.ObjectImportIDError(request.ID, err))
+			return
+		}
+		importID = parsedID
+	}
+
+	if importID.Context != "" && r.kubernetesContext != "" && importID.Context != r.kubernetesContext {
+		response.Diagnostics.AddError(
+			"Error importing resource",
+			"The import identifier targets context '"+importID.Context+"' but the provider is configured for context '"+r.kubernetesContext+"'.",
+		)
+		return
+	}
+
+	tflog.Trace(ctx, "parsed import ID", map[string]interface{}{
+		"context":     importID.Context,
+		"api_version": importID.APIVersion,
+		"kind":        importID.Kind,
+		"namespace":   importID.Namespace,
+		"name":        importID.Name,
+	})
+
+	metadata := map[string]interface{}{"name": importID.Name}
+	if importID.Namespace != "" {
+		metadata["namespace"] = importID.Namespace
+	}
+	manifest, err := json.Marshal(map[string]interface{}{"metadata": metadata})
+	if err != nil {
+		response.Diagnostics.Append(utilities.JsonMarshalError(err))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("api_version"), importID.APIVersion)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("kind"), importID.Kind)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("manifest"), custom_types.NewNormalizedValue(string(manifest)))...)
+	if response.Identity != nil {
+		response.Diagnostics.Append(response.Identity.SetAttribute(ctx, path.Root("api_version"), importID.APIVersion)...)
+		response.Diagnostics.Append(response.Identity.SetAttribute(ctx, path.Root("kind"), importID.Kind)...)
+		response.Diagnostics.Append(response.Identity.SetAttribute(ctx, path.Root("namespace"), importID.Namespace)...)
+		response.Diagnostics.Append(response.Identity.SetAttribute(ctx, path.Root("name"), importID.Name)...)
+	}
+}
+
+// apply server-side applies the manifest of the given model and records the UID of the object in the model.
+func (r *ObjectResource) apply(ctx context.Context, model *ObjectResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	object, manifestDiags := r.manifestObject(*model)
+	diags.Append(manifestDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	resourceClient, clientDiags := r.resourceClient(object)
+	diags.Append(clientDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	bytes, err := object.MarshalJSON()
+	if err != nil {
+		diags.Append(utilities.JsonMarshalError(err))
+		return diags
+	}
+
+	forceConflicts := r.forceConflicts
+	if !model.ForceConflicts.IsNull() && !model.ForceConflicts.IsUnknown() {
+		forceConflicts = model.ForceConflicts.ValueBool()
+	}
+	fieldManager := r.fieldManager
+	if !model.FieldManager.IsNull() && !model.FieldManager.IsUnknown() {
+		fieldManager = model.FieldManager.ValueString()
+	}
+	patchOptions := meta.PatchOptions{
+		FieldManager:    fieldManager,
+		Force:           pointer.Bool(forceConflicts),
+		FieldValidation: "Strict",
+	}
+
+	patchResponse, err := resourceClient.Patch(ctx, object.GetName(), k8sTypes.ApplyPatchType, bytes, patchOptions)
+	if err != nil {
+		diags.Append(utilities.ObjectPatchErrors(err, path.Root("manifest"), object)...)
+		return diags
+	}
+
+	model.Uid = types.StringValue(string(patchResponse.GetUID()))
+	return diags
+}
+
+func (r *ObjectResource) wait(ctx context.Context, model *ObjectResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	object, manifestDiags := r.manifestObject(*model)
+	diags.Append(manifestDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	resourceClient, clientDiags := r.resourceClient(object)
+	diags.Append(clientDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !model.WaitForUpsert.IsNull() && !model.WaitForUpsert.IsUnknown() {
+		diags.Append(utilities.WaitForUpsert(ctx, resourceClient, object.GetName(), model.WaitForUpsert)...)
+	}
+	if model.WaitForReady.ValueBool() && !diags.HasError() {
+		diags.Append(utilities.WaitForReady(ctx, resourceClient, object.GetName(), model.WaitForReadyTimeout)...)
+	}
+	return diags
+}
+
+// manifestObject decodes the manifest of the given model and overwrites its apiVersion and kind with the values of
+// the corresponding attributes.
+func (r *ObjectResource) manifestObject(model ObjectResourceData) (*unstructured.Unstructured, diag.Diagnostics) {
+	object := &unstructured.Unstructured{}
+	diags := model.Manifest.Unmarshal(&object.Object)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if object.Object == nil {
+		diags.Append(utilities.InvalidManifestError("the manifest must be a JSON object"))
+		return nil, diags
+	}
+	if object.GetName() == "" {
+		diags.Append(utilities.InvalidManifestError("the manifest must contain 'metadata.name'"))
+		return nil, diags
+	}
+
+	object.SetAPIVersion(model.ApiVersion.ValueString())
+	object.SetKind(model.Kind.ValueString())
+	return object, diags
+}
+
+func (r *ObjectResource) resourceClient(object *unstructured.Unstructured) (dynamic.ResourceInterface, diag.Diagnostics) {
+	return utilities.DynamicResource(r.kubernetesClient, r.restMapper, object)
+}
+
+// getError reports a failed GET request of the given object. The namespace of the object is only reported for
+// namespaced kinds.
+func (r *ObjectResource) getError(err error, object *unstructured.Unstructured) diag.Diagnostic {
+	mapping, mappingErr := utilities.RESTMapping(r.restMapper, object.GetAPIVersion(), object.GetKind())
+	if mappingErr == nil && mapping.Scope.Name() != apiMeta.RESTScopeNameNamespace {
+		return utilities.GetResourceError(err, object.GetName())
+	}
+	return utilities.GetNamespacedResourceError(err, object.GetName(), object.GetNamespace())
+}
+
+func (r *ObjectResource) setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, model *ObjectResourceData) diag.Diagnostics {
+	object, diags := r.manifestObject(*model)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(identity.SetAttribute(ctx, path.Root("api_version"), object.GetAPIVersion())...)
+	diags.Append(identity.SetAttribute(ctx, path.Root("kind"), object.GetKind())...)
+	diags.Append(identity.SetAttribute(ctx, path.Root("namespace"), object.GetNamespace())...)
+	diags.Append(identity.SetAttribute(ctx, path.Root("name"), object.GetName())...)
+	return diags
+}
@@ -0,0 +1,152 @@
+/*
+* SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+* SPDX-License-Identifier: 0BSD
+ */
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/metio/terraform-provider-k8s/internal/custom_types"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	apiMeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"strings"
+	"testing"
+)
+
+func newObjectTestResource() *ObjectResource {
+	mapper := apiMeta.NewDefaultRESTMapper(nil)
+	mapper.Add(k8sSchema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, apiMeta.RESTScopeNamespace)
+	mapper.Add(k8sSchema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, apiMeta.RESTScopeRoot)
+	return &ObjectResource{
+		kubernetesClient: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
+		restMapper:       &resettableMapper{DefaultRESTMapper: mapper},
+		fieldManager:     "terraform",
+	}
+}
+
+// objectTestValue returns a plan or state value of k8s_object for the given kind whose other attributes are null.
+func objectTestValue(t *testing.T, object *ObjectResource, apiVersion string, kind string, manifest string) tfsdk.State {
+	ctx := context.Background()
+	schemaResponse := &resource.SchemaResponse{}
+	object.Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+	state := tfsdk.State{Schema: schemaResponse.Schema, Raw: tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil)}
+	diags := state.SetAttribute(ctx, path.Root("api_version"), apiVersion)
+	diags.Append(state.SetAttribute(ctx, path.Root("kind"), kind)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("manifest"), custom_types.NewNormalizedValue(manifest))...)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return state
+}
+
+func TestObjectResource_ReadMissingObject(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	object := newObjectTestResource()
+	state := objectTestValue(t, object, "v1", "ConfigMap", `{"metadata":{"name":"example","namespace":"default"}}`)
+
+	response := &resource.ReadResponse{State: state}
+	object.Read(ctx, resource.ReadRequest{State: state}, response)
+
+	if response.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", response.Diagnostics)
+	}
+	if !response.State.Raw.IsNull() {
+		t.Errorf("expected the missing object to be removed from state, got %s", response.State.Raw)
+	}
+}
+
+func TestObjectResource_ModifyPlanMissingKind(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		deferralAllowed bool
+		expectDeferred  bool
+		expectError     bool
+	}
+	tests := map[string]testCase{
+		"deferred": {
+			deferralAllowed: true,
+			expectDeferred:  true,
+			expectError:     false,
+		},
+		"error without deferral": {
+			deferralAllowed: false,
+			expectDeferred:  false,
+			expectError:     true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			object := newObjectTestResource()
+			planned := objectTestValue(t, object, "cert-manager.io/v1", "Certificate", `{"metadata":{"name":"example","namespace":"default"}}`)
+			plan := tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}
+			nullState := tfsdk.State{Schema: planned.Schema, Raw: tftypes.NewValue(planned.Raw.Type(), nil)}
+
+			response := &resource.ModifyPlanResponse{Plan: plan}
+			object.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Plan:               plan,
+				State:              nullState,
+				ClientCapabilities: resource.ModifyPlanClientCapabilities{DeferralAllowed: test.deferralAllowed},
+			}, response)
+
+			if deferred := response.Deferred != nil; deferred != test.expectDeferred {
+				t.Errorf("expected deferred %t, got %t", test.expectDeferred, deferred)
+			}
+			if response.Diagnostics.HasError() != test.expectError {
+				t.Errorf("unexpected diagnostics: %v", response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestObjectResource_GetError(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		kind            string
+		expectNamespace bool
+	}
+	tests := map[string]testCase{
+		"namespaced kind": {
+			kind:            "ConfigMap",
+			expectNamespace: true,
+		},
+		"cluster-scoped kind": {
+			kind:            "Namespace",
+			expectNamespace: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			object := &unstructured.Unstructured{}
+			object.SetAPIVersion("v1")
+			object.SetKind(test.kind)
+			object.SetName("example")
+			object.SetNamespace("default")
+			err := k8sErrors.NewNotFound(k8sSchema.GroupResource{Resource: "example"}, "example")
+
+			diagnostic := newObjectTestResource().getError(err, object)
+			if hasNamespace := strings.Contains(diagnostic.Detail(), "Namespace: default"); hasNamespace != test.expectNamespace {
+				t.Errorf("expected namespace in detail %t, got %q", test.expectNamespace, diagnostic.Detail())
+			}
+		})
+	}
+}
@@ -8,18 +8,33 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/metio/terraform-provider-k8s/internal/utilities"
+	apiMeta "k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 )
 
 type K8sProvider struct {
@@ -27,12 +42,33 @@ type K8sProvider struct {
 }
 
 type K8sProviderModel struct {
-	Kubeconfig     types.String `tfsdk:"kubeconfig"`
-	Context        types.String `tfsdk:"context"`
-	FieldManager   types.String `tfsdk:"field_manager"`
-	ForceConflicts types.Bool   `tfsdk:"force_conflicts"`
-	Timeout        types.Int64  `tfsdk:"timeout"`
-	Offline        types.Bool   `tfsdk:"offline"`
+	Kubeconfig           types.String  `tfsdk:"kubeconfig"`
+	KubeconfigRaw        types.String  `tfsdk:"kubeconfig_raw"`
+	KubeconfigPaths      types.List    `tfsdk:"kubeconfig_paths"`
+	Context              types.String  `tfsdk:"context"`
+	Host                 types.String  `tfsdk:"host"`
+	Token                types.String  `tfsdk:"token"`
+	ClientCertificate    types.String  `tfsdk:"client_certificate"`
+	ClientKey            types.String  `tfsdk:"client_key"`
+	ClusterCACertificate types.String  `tfsdk:"cluster_ca_certificate"`
+	Insecure             types.Bool    `tfsdk:"insecure"`
+	ProxyURL             types.String  `tfsdk:"proxy_url"`
+	Exec                 types.Object  `tfsdk:"exec"`
+	FieldManager         types.String  `tfsdk:"field_manager"`
+	ForceConflicts       types.Bool    `tfsdk:"force_conflicts"`
+	Timeout              types.Int64   `tfsdk:"timeout"`
+	RetryMaxAttempts     types.Int64   `tfsdk:"retry_max_attempts"`
+	RetryBackoff         types.Int64   `tfsdk:"retry_backoff"`
+	QPS                  types.Float64 `tfsdk:"qps"`
+	Burst                types.Int64   `tfsdk:"burst"`
+	Offline              types.Bool    `tfsdk:"offline"`
+}
+
+type K8sProviderExecModel struct {
+	ApiVersion types.String `tfsdk:"api_version"`
+	Command    types.String `tfsdk:"command"`
+	Args       types.List   `tfsdk:"args"`
+	Env        types.Map    `tfsdk:"env"`
 }
 
 var _ provider.Provider = &K8sProvider{}
@@ -60,6 +96,30 @@ func (p *K8sProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 				Required:            false,
 				Optional:            true,
 				Sensitive:           false,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("kubeconfig_raw"), path.MatchRoot("kubeconfig_paths")),
+				},
+			},
+			"kubeconfig_raw": schema.StringAttribute{
+				Description:         "The content of a kubeconfig file in YAML format. Takes precedence over 'kubeconfig' and 'kubeconfig_paths'. Can be specified with the 'TF_K8S_CONFIG_RAW' environment variable.",
+				MarkdownDescription: "The content of a kubeconfig file in YAML format. Takes precedence over `kubeconfig` and `kubeconfig_paths`. Can be specified with the `TF_K8S_CONFIG_RAW` environment variable.",
+				Required:            false,
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("kubeconfig_paths")),
+				},
+			},
+			"kubeconfig_paths": schema.ListAttribute{
+				Description:         "A list of paths to kubeconfig files which are merged the same way as the 'KUBECONFIG' environment variable: the first file to set a particular value wins and missing files are ignored. Takes precedence over 'kubeconfig'. Can be specified with the 'TF_K8S_CONFIG_PATHS' environment variable using the path list separator of your OS.",
+				MarkdownDescription: "A list of paths to kubeconfig files which are merged the same way as the `KUBECONFIG` environment variable: the first file to set a particular value wins and missing files are ignored. Takes precedence over `kubeconfig`. Can be specified with the `TF_K8S_CONFIG_PATHS` environment variable using the path list separator of your OS.",
+				ElementType:         types.StringType,
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
 			},
 			"context": schema.StringAttribute{
 				Description:         "The context to use from your kubeconfig. Can be specified with the 'TF_K8S_CONTEXT' environment variable. Defaults to the current context in your config.",
@@ -68,6 +128,97 @@ func (p *K8sProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 				Optional:            true,
 				Sensitive:           false,
 			},
+			"host": schema.StringAttribute{
+				Description:         "The address of the Kubernetes API server. Overrides the server of your kubeconfig. Can be specified with the 'TF_K8S_HOST' environment variable.",
+				MarkdownDescription: "The address of the Kubernetes API server. Overrides the server of your kubeconfig. Can be specified with the `TF_K8S_HOST` environment variable.",
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+			},
+			"token": schema.StringAttribute{
+				Description:         "The bearer token used to authenticate against the Kubernetes API server. Overrides the token of your kubeconfig. Can be specified with the 'TF_K8S_TOKEN' environment variable.",
+				MarkdownDescription: "The bearer token used to authenticate against the Kubernetes API server. Overrides the token of your kubeconfig. Can be specified with the `TF_K8S_TOKEN` environment variable.",
+				Required:            false,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"client_certificate": schema.StringAttribute{
+				Description:         "PEM-encoded client certificate used for TLS authentication. Overrides the client certificate of your kubeconfig. Can be specified with the 'TF_K8S_CLIENT_CERTIFICATE' environment variable.",
+				MarkdownDescription: "PEM-encoded client certificate used for TLS authentication. Overrides the client certificate of your kubeconfig. Can be specified with the `TF_K8S_CLIENT_CERTIFICATE` environment variable.",
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+			},
+			"client_key": schema.StringAttribute{
+				Description:         "PEM-encoded private key of the client certificate used for TLS authentication. Overrides the client key of your kubeconfig. Can be specified with the 'TF_K8S_CLIENT_KEY' environment variable.",
+				MarkdownDescription: "PEM-encoded private key of the client certificate used for TLS authentication. Overrides the client key of your kubeconfig. Can be specified with the `TF_K8S_CLIENT_KEY` environment variable.",
+				Required:            false,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Description:         "PEM-encoded root certificates bundle used to verify the certificate of the Kubernetes API server. Overrides the certificate authority of your kubeconfig. Can be specified with the 'TF_K8S_CLUSTER_CA_CERTIFICATE' environment variable.",
+				MarkdownDescription: "PEM-encoded root certificates bundle used to verify the certificate of the Kubernetes API server. Overrides the certificate authority of your kubeconfig. Can be specified with the `TF_K8S_CLUSTER_CA_CERTIFICATE` environment variable.",
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+			},
+			"insecure": schema.BoolAttribute{
+				Description:         "If 'true', the certificate of the Kubernetes API server will not be checked for validity. Can be specified with the 'TF_K8S_INSECURE' environment variable. Defaults to 'false'.",
+				MarkdownDescription: "If `true`, the certificate of the Kubernetes API server will not be checked for validity. Can be specified with the `TF_K8S_INSECURE` environment variable. Defaults to `false`.",
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("cluster_ca_certificate")),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Description:         "URL of the proxy used for all requests to the Kubernetes API server. Can be specified with the 'TF_K8S_PROXY_URL' environment variable.",
+				MarkdownDescription: "URL of the proxy used for all requests to the Kubernetes API server. Can be specified with the `TF_K8S_PROXY_URL` environment variable.",
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+			},
+			"exec": schema.SingleNestedAttribute{
+				Description:         "Exec-based credential plugin used to authenticate against the Kubernetes API server. Overrides the user of your kubeconfig. See https://kubernetes.io/docs/reference/access-authn-authz/authentication/#client-go-credential-plugins for more details.",
+				MarkdownDescription: "Exec-based credential plugin used to authenticate against the Kubernetes API server. Overrides the user of your kubeconfig. See [client-go credential plugins](https://kubernetes.io/docs/reference/access-authn-authz/authentication/#client-go-credential-plugins) for more details.",
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+				Attributes: map[string]schema.Attribute{
+					"api_version": schema.StringAttribute{
+						Description:         "Preferred input version of the ExecInfo, e.g. 'client.authentication.k8s.io/v1beta1'.",
+						MarkdownDescription: "Preferred input version of the ExecInfo, e.g. `client.authentication.k8s.io/v1beta1`.",
+						Required:            true,
+						Optional:            false,
+						Sensitive:           false,
+					},
+					"command": schema.StringAttribute{
+						Description:         "Command to execute.",
+						MarkdownDescription: "Command to execute.",
+						Required:            true,
+						Optional:            false,
+						Sensitive:           false,
+					},
+					"args": schema.ListAttribute{
+						Description:         "Arguments to pass to the command when executing it.",
+						MarkdownDescription: "Arguments to pass to the command when executing it.",
+						ElementType:         types.StringType,
+						Required:            false,
+						Optional:            true,
+						Sensitive:           false,
+					},
+					"env": schema.MapAttribute{
+						Description:         "Additional environment variables to expose to the process.",
+						MarkdownDescription: "Additional environment variables to expose to the process.",
+						ElementType:         types.StringType,
+						Required:            false,
+						Optional:            true,
+						Sensitive:           false,
+					},
+				},
+			},
 			"field_manager": schema.StringAttribute{
 				Description:         "The name of the manager used to track field ownership. Can be specified with the 'TF_K8S_FIELD_MANAGER' environment variable. Defaults to 'terraform-provider-k8s'.",
 				MarkdownDescription: "The name of the manager used to track field ownership. Can be specified with the `TF_K8S_FIELD_MANAGER` environment variable. Defaults to `terraform-provider-k8s`.",
@@ -89,6 +240,46 @@ func (p *K8sProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 				Optional:            true,
 				Sensitive:           false,
 			},
+			"retry_max_attempts": schema.Int64Attribute{
+				Description:         "The maximum number of attempts for requests which fail with a transient error, e.g. HTTP status 429, 500 or 503. Set to '1' to disable retries. Can be specified with the 'TF_K8S_RETRY_MAX_ATTEMPTS' environment variable. Defaults to '5'.",
+				MarkdownDescription: "The maximum number of attempts for requests which fail with a transient error, e.g. HTTP status 429, 500 or 503. Set to `1` to disable retries. Can be specified with the `TF_K8S_RETRY_MAX_ATTEMPTS` environment variable. Defaults to `5`.",
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_backoff": schema.Int64Attribute{
+				Description:         "The time to wait before the first retry of a failed request in seconds. The backoff doubles after every attempt. Can be specified with the 'TF_K8S_RETRY_BACKOFF' environment variable. Defaults to '1'.",
+				MarkdownDescription: "The time to wait before the first retry of a failed request in seconds. The backoff doubles after every attempt. Can be specified with the `TF_K8S_RETRY_BACKOFF` environment variable. Defaults to `1`.",
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"qps": schema.Float64Attribute{
+				Description:         "The maximum number of queries per second sent to the Kubernetes API server by the client-side rate limiter. Can be specified with the 'TF_K8S_QPS' environment variable. Defaults to '50'.",
+				MarkdownDescription: "The maximum number of queries per second sent to the Kubernetes API server by the client-side rate limiter. Can be specified with the `TF_K8S_QPS` environment variable. Defaults to `50`.",
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"burst": schema.Int64Attribute{
+				Description:         "The maximum number of queries sent to the Kubernetes API server in a single burst by the client-side rate limiter. Can be specified with the 'TF_K8S_BURST' environment variable. Defaults to '100'.",
+				MarkdownDescription: "The maximum number of queries sent to the Kubernetes API server in a single burst by the client-side rate limiter. Can be specified with the `TF_K8S_BURST` environment variable. Defaults to `100`.",
+				Required:            false,
+				Optional:            true,
+				Sensitive:           false,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 			"offline": schema.BoolAttribute{
 				Description:         "Enable offline mode for this provider. In offline mode, no connection to a kubernetes cluster will be performed, therefore no resource or data source can be created except manifest data sources (those ending with _manifest). Can be specified with the 'TF_K8S_OFFLINE' environment variable. Defaults to 'true'.",
 				MarkdownDescription: "Enable offline mode for this provider. In offline mode, no connection to a kubernetes cluster will be performed, therefore no resource or data source can be created except manifest data sources (those ending with _manifest). Can be specified with the `TF_K8S_OFFLINE` environment variable. Defaults to `true`.",
@@ -109,77 +300,77 @@ func (p *K8sProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
-	if config.Kubeconfig.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("kubeconfig"),
-			"Unknown kubeconfig",
-			"The provider cannot create a Kubernetes client as there is an unknown configuration value for the kubeconfig option. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the TF_K8S_CONFIG environment variable.",
-		)
+	for _, group := range unknownResourceGroups(allResources(), os.Getenv("TF_K8S_RESOURCE_GROUPS")) {
+		resp.Diagnostics.Append(utilities.UnknownResourceGroupWarning(group))
 	}
 
-	if config.Context.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("context"),
-			"Unknown context",
-			"The provider cannot create a Kubernetes client as there is an unknown configuration value for the context option. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the TF_K8S_CONTEXT environment variable.",
-		)
+	kubeconfig := os.Getenv("TF_K8S_CONFIG")
+	kubeconfigRaw := os.Getenv("TF_K8S_CONFIG_RAW")
+	kubeconfigPaths := filepath.SplitList(os.Getenv("TF_K8S_CONFIG_PATHS"))
+	clientContext := os.Getenv("TF_K8S_CONTEXT")
+	host := os.Getenv("TF_K8S_HOST")
+	token := os.Getenv("TF_K8S_TOKEN")
+	clientCertificate := os.Getenv("TF_K8S_CLIENT_CERTIFICATE")
+	clientKey := os.Getenv("TF_K8S_CLIENT_KEY")
+	clusterCACertificate := os.Getenv("TF_K8S_CLUSTER_CA_CERTIFICATE")
+	insecure := os.Getenv("TF_K8S_INSECURE")
+	proxyURL := os.Getenv("TF_K8S_PROXY_URL")
+	fieldManager := os.Getenv("TF_K8S_FIELD_MANAGER")
+	forceConflicts := os.Getenv("TF_K8S_FORCE_CONFLICTS")
+	timeout := os.Getenv("TF_K8S_TIMEOUT")
+	retryMaxAttempts := os.Getenv("TF_K8S_RETRY_MAX_ATTEMPTS")
+	retryBackoff := os.Getenv("TF_K8S_RETRY_BACKOFF")
+	qps := os.Getenv("TF_K8S_QPS")
+	burst := os.Getenv("TF_K8S_BURST")
+	offline := os.Getenv("TF_K8S_OFFLINE")
+
+	if !config.Kubeconfig.IsNull() {
+		kubeconfig = config.Kubeconfig.ValueString()
 	}
 
-	if config.FieldManager.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("field_manager"),
-			"Unknown field_manager",
-			"The provider cannot create a Kubernetes client as there is an unknown configuration value for the field_manager option. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the TF_K8S_FIELD_MANAGER environment variable.",
-		)
+	if !config.KubeconfigRaw.IsNull() {
+		kubeconfigRaw = config.KubeconfigRaw.ValueString()
 	}
 
-	if config.ForceConflicts.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("force_conflicts"),
-			"Unknown force_conflicts",
-			"The provider cannot create a Kubernetes client as there is an unknown configuration value for the force_conflicts option. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the TF_K8S_FORCE_CONFLICTS environment variable.",
-		)
+	if !config.KubeconfigPaths.IsNull() {
+		kubeconfigPaths = make([]string, 0)
+		for _, element := range config.KubeconfigPaths.Elements() {
+			if kubeconfigPath, ok := element.(types.String); ok {
+				kubeconfigPaths = append(kubeconfigPaths, kubeconfigPath.ValueString())
+			}
+		}
 	}
 
-	if config.Timeout.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("timeout"),
-			"Unknown timeout",
-			"The provider cannot create a Kubernetes client as there is an unknown configuration value for the timeout option. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the TF_K8S_TIMEOUT environment variable.",
-		)
+	if !config.Context.IsNull() {
+		clientContext = config.Context.ValueString()
 	}
 
-	if config.Offline.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("offline"),
-			"Unknown offline",
-			"The provider cannot create a Kubernetes client as there is an unknown configuration value for the offline option. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the TF_K8S_OFFLINE environment variable.",
-		)
+	if !config.Host.IsNull() {
+		host = config.Host.ValueString()
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
+	if !config.Token.IsNull() {
+		token = config.Token.ValueString()
 	}
 
-	kubeconfig := os.Getenv("TF_K8S_CONFIG")
-	clientContext := os.Getenv("TF_K8S_CONTEXT")
-	fieldManager := os.Getenv("TF_K8S_FIELD_MANAGER")
-	forceConflicts := os.Getenv("TF_K8S_FORCE_CONFLICTS")
-	timeout := os.Getenv("TF_K8S_TIMEOUT")
-	offline := os.Getenv("TF_K8S_OFFLINE")
+	if !config.ClientCertificate.IsNull() {
+		clientCertificate = config.ClientCertificate.ValueString()
+	}
 
-	if !config.Kubeconfig.IsNull() {
-		kubeconfig = config.Kubeconfig.ValueString()
+	if !config.ClientKey.IsNull() {
+		clientKey = config.ClientKey.ValueString()
 	}
 
-	if !config.Context.IsNull() {
-		clientContext = config.Context.ValueString()
+	if !config.ClusterCACertificate.IsNull() {
+		clusterCACertificate = config.ClusterCACertificate.ValueString()
+	}
+
+	if !config.Insecure.IsNull() {
+		insecure = strconv.FormatBool(config.Insecure.ValueBool())
+	}
+
+	if !config.ProxyURL.IsNull() {
+		proxyURL = config.ProxyURL.ValueString()
 	}
 
 	if !config.FieldManager.IsNull() {
@@ -194,6 +385,22 @@ func (p *K8sProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		timeout = strconv.FormatInt(config.Timeout.ValueInt64(), 10)
 	}
 
+	if !config.RetryMaxAttempts.IsNull() && !config.RetryMaxAttempts.IsUnknown() {
+		retryMaxAttempts = strconv.FormatInt(config.RetryMaxAttempts.ValueInt64(), 10)
+	}
+
+	if !config.RetryBackoff.IsNull() && !config.RetryBackoff.IsUnknown() {
+		retryBackoff = strconv.FormatInt(config.RetryBackoff.ValueInt64(), 10)
+	}
+
+	if !config.QPS.IsNull() && !config.QPS.IsUnknown() {
+		qps = strconv.FormatFloat(config.QPS.ValueFloat64(), 'f', -1, 64)
+	}
+
+	if !config.Burst.IsNull() && !config.Burst.IsUnknown() {
+		burst = strconv.FormatInt(config.Burst.ValueInt64(), 10)
+	}
+
 	if !config.Offline.IsNull() {
 		offline = strconv.FormatBool(config.Offline.ValueBool())
 	}
@@ -210,10 +417,30 @@ func (p *K8sProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		timeout = "32"
 	}
 
+	if retryMaxAttempts == "" {
+		retryMaxAttempts = "5"
+	}
+
+	if retryBackoff == "" {
+		retryBackoff = "1"
+	}
+
+	if qps == "" {
+		qps = "50"
+	}
+
+	if burst == "" {
+		burst = "100"
+	}
+
 	if offline == "" {
 		offline = "true"
 	}
 
+	if insecure == "" {
+		insecure = "false"
+	}
+
 	conflicts, err := strconv.ParseBool(forceConflicts)
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(
@@ -232,15 +459,104 @@ func (p *K8sProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		)
 	}
 
+	insecureSkipTLSVerify, err := strconv.ParseBool(insecure)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("insecure"),
+			"Invalid insecure value",
+			"The supplied insecure value cannot be parsed into a bool: "+err.Error(),
+		)
+	}
+
+	maxAttempts, err := strconv.Atoi(retryMaxAttempts)
+	if err != nil || maxAttempts < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_attempts"),
+			"Invalid retry_max_attempts value",
+			"The supplied retry_max_attempts value must be a positive integer: "+retryMaxAttempts,
+		)
+	}
+
+	backoffSeconds, err := strconv.Atoi(retryBackoff)
+	if err != nil || backoffSeconds < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_backoff"),
+			"Invalid retry_backoff value",
+			"The supplied retry_backoff value must be a non-negative integer: "+retryBackoff,
+		)
+	}
+
+	queriesPerSecond, err := strconv.ParseFloat(qps, 32)
+	if err != nil || queriesPerSecond < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("qps"),
+			"Invalid qps value",
+			"The supplied qps value must be a non-negative number: "+qps,
+		)
+	}
+
+	burstSize, err := strconv.Atoi(burst)
+	if err != nil || burstSize < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("burst"),
+			"Invalid burst value",
+			"The supplied burst value must be a positive integer: "+burst,
+		)
+	}
+
+	if !config.ClientCertificate.IsUnknown() && !config.ClientKey.IsUnknown() && (clientCertificate == "") != (clientKey == "") {
+		missing := "client_key"
+		if clientCertificate == "" {
+			missing = "client_certificate"
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(missing),
+			"Incomplete client certificate",
+			"The client_certificate and client_key values must be specified together, either in the provider configuration or with the 'TF_K8S_CLIENT_CERTIFICATE' and 'TF_K8S_CLIENT_KEY' environment variables. Missing value: "+missing,
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if unknown := unknownAttributes(ctx, config); len(unknown) > 0 && (config.Offline.IsUnknown() || !offlineMode) {
+		if req.ClientCapabilities.DeferralAllowed {
+			tflog.Info(ctx, "Deferring resources and data sources until all provider attributes are known", map[string]interface{}{
+				"unknown_attributes": unknown,
+			})
+			resp.Deferred = &provider.Deferred{
+				Reason: provider.DeferredReasonProviderConfigUnknown,
+			}
+		} else {
+			tflog.Warn(ctx, "Configuring provider without a Kubernetes client since some provider attributes are unknown", map[string]interface{}{
+				"unknown_attributes": unknown,
+			})
+			resp.DataSourceData = &utilities.DataSourceData{}
+			resp.ResourceData = &utilities.ResourceData{}
+		}
+		return
+	}
+
+	execConfig, diags := execProviderConfig(ctx, config.Exec)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	ctx = tflog.SetField(ctx, "kubeconfig", kubeconfig)
+	ctx = tflog.SetField(ctx, "kubeconfig_paths", kubeconfigPaths)
 	ctx = tflog.SetField(ctx, "context", clientContext)
+	ctx = tflog.SetField(ctx, "host", host)
+	ctx = tflog.SetField(ctx, "insecure", insecure)
+	ctx = tflog.SetField(ctx, "proxy_url", proxyURL)
 	ctx = tflog.SetField(ctx, "field_manager", fieldManager)
 	ctx = tflog.SetField(ctx, "force_conflicts", forceConflicts)
 	ctx = tflog.SetField(ctx, "timeout", timeout)
+	ctx = tflog.SetField(ctx, "retry_max_attempts", retryMaxAttempts)
+	ctx = tflog.SetField(ctx, "retry_backoff", retryBackoff)
+	ctx = tflog.SetField(ctx, "qps", qps)
+	ctx = tflog.SetField(ctx, "burst", burst)
 	ctx = tflog.SetField(ctx, "offline", offline)
 
 	if offlineMode {
@@ -254,12 +570,9 @@ func (p *K8sProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		tflog.Debug(ctx, "Creating Kubernetes client")
 
 		var client dynamic.Interface
+		var restMapper apiMeta.ResettableRESTMapper
+		activeContext := clientContext
 		if p.client == nil {
-			loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
-			if kubeconfig != "" {
-				loadingRules = &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig}
-			}
-
 			configOverrides := &clientcmd.ConfigOverrides{
 				Timeout: timeout,
 			}
@@ -268,7 +581,59 @@ func (p *K8sProvider) Configure(ctx context.Context, req provider.ConfigureReque
 				configOverrides.CurrentContext = clientContext
 			}
 
-			kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides)
+			if host != "" {
+				configOverrides.ClusterInfo.Server = host
+			}
+
+			if clusterCACertificate != "" {
+				configOverrides.ClusterInfo.CertificateAuthorityData = []byte(clusterCACertificate)
+			}
+
+			if insecureSkipTLSVerify {
+				configOverrides.ClusterInfo.InsecureSkipTLSVerify = true
+			}
+
+			if proxyURL != "" {
+				configOverrides.ClusterInfo.ProxyURL = proxyURL
+			}
+
+			if token != "" {
+				configOverrides.AuthInfo.Token = token
+			}
+
+			if clientCertificate != "" {
+				configOverrides.AuthInfo.ClientCertificateData = []byte(clientCertificate)
+			}
+
+			if clientKey != "" {
+				configOverrides.AuthInfo.ClientKeyData = []byte(clientKey)
+			}
+
+			if execConfig != nil {
+				configOverrides.AuthInfo.Exec = execConfig
+			}
+
+			var kubeConfig clientcmd.ClientConfig
+			if kubeconfigRaw != "" {
+				rawKubeconfig, err := clientcmd.Load([]byte(kubeconfigRaw))
+				if err != nil {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("kubeconfig_raw"),
+						"Invalid kubeconfig_raw value",
+						"The supplied kubeconfig_raw value cannot be parsed into a kubeconfig: "+err.Error(),
+					)
+					return
+				}
+				kubeConfig = clientcmd.NewNonInteractiveClientConfig(*rawKubeconfig, "", configOverrides, nil)
+			} else {
+				loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+				if len(kubeconfigPaths) > 0 {
+					loadingRules = &clientcmd.ClientConfigLoadingRules{Precedence: kubeconfigPaths}
+				} else if kubeconfig != "" {
+					loadingRules = &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig}
+				}
+				kubeConfig = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, configOverrides)
+			}
 
 			rawConfig, err := kubeConfig.RawConfig()
 			if err != nil {
@@ -280,7 +645,24 @@ func (p *K8sProvider) Configure(ctx context.Context, req provider.ConfigureReque
 				)
 				return
 			}
-			err = clientcmd.ConfirmUsable(rawConfig, rawConfig.CurrentContext)
+			if activeContext == "" {
+				activeContext = rawConfig.CurrentContext
+			}
+			// without a host, a usable kubeconfig is required since the overrides alone cannot describe a cluster
+			if host == "" {
+				err = clientcmd.ConfirmUsable(rawConfig, rawConfig.CurrentContext)
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to create Kubernetes client",
+						fmt.Sprintf("An unexpected error occurred when creating the Kubernetes client. "+
+							"If the error is not clear, please contact the provider developers.\n\n"+
+							"Kubernetes client error (%T): %s", err, err.Error()),
+					)
+					return
+				}
+			}
+
+			clientConfig, err := kubeConfig.ClientConfig()
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Unable to create Kubernetes client",
@@ -291,7 +673,11 @@ func (p *K8sProvider) Configure(ctx context.Context, req provider.ConfigureReque
 				return
 			}
 
-			clientConfig, err := kubeConfig.ClientConfig()
+			clientConfig.QPS = float32(queriesPerSecond)
+			clientConfig.Burst = burstSize
+			clientConfig.Wrap(utilities.LogThrottling)
+
+			client, err = dynamic.NewForConfig(clientConfig)
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Unable to create Kubernetes client",
@@ -302,11 +688,11 @@ func (p *K8sProvider) Configure(ctx context.Context, req provider.ConfigureReque
 				return
 			}
 
-			client, err = dynamic.NewForConfig(clientConfig)
+			restMapper, err = utilities.NewRESTMapper(clientConfig)
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Unable to create Kubernetes client",
-					fmt.Sprintf("An unexpected error occurred when creating the Kubernetes client. "+
+					fmt.Sprintf("An unexpected error occurred when creating the Kubernetes discovery client. "+
 						"If the error is not clear, please contact the provider developers.\n\n"+
 						"Kubernetes client error (%T): %s", err, err.Error()),
 				)
@@ -316,26 +702,160 @@ func (p *K8sProvider) Configure(ctx context.Context, req provider.ConfigureReque
 			client = *p.client
 		}
 
+		client = utilities.NewRetryingClient(client, utilities.RetryPolicy{
+			MaxAttempts: maxAttempts,
+			Backoff:     time.Duration(backoffSeconds) * time.Second,
+		})
+
 		resp.DataSourceData = &utilities.DataSourceData{
-			Client:  client,
-			Offline: offlineMode,
+			Client:     client,
+			Context:    activeContext,
+			Offline:    offlineMode,
+			RESTMapper: restMapper,
 		}
 		resp.ResourceData = &utilities.ResourceData{
 			Client:         client,
+			Context:        activeContext,
 			FieldManager:   fieldManager,
 			ForceConflicts: conflicts,
 			Offline:        offlineMode,
+			RESTMapper:     restMapper,
 		}
 
 		tflog.Info(ctx, "Configured Kubernetes client")
 	}
 }
 
+// unknownAttributes returns the sorted names of all provider attributes which contain values that are not yet known,
+// e.g. because they depend on a cluster that is created in the same run.
+func unknownAttributes(ctx context.Context, config K8sProviderModel) []string {
+	attributes := map[string]attr.Value{
+		"kubeconfig":             config.Kubeconfig,
+		"kubeconfig_raw":         config.KubeconfigRaw,
+		"kubeconfig_paths":       config.KubeconfigPaths,
+		"context":                config.Context,
+		"host":                   config.Host,
+		"token":                  config.Token,
+		"client_certificate":     config.ClientCertificate,
+		"client_key":             config.ClientKey,
+		"cluster_ca_certificate": config.ClusterCACertificate,
+		"insecure":               config.Insecure,
+		"proxy_url":              config.ProxyURL,
+		"exec":                   config.Exec,
+		"field_manager":          config.FieldManager,
+		"force_conflicts":        config.ForceConflicts,
+		"timeout":                config.Timeout,
+		"retry_max_attempts":     config.RetryMaxAttempts,
+		"retry_backoff":          config.RetryBackoff,
+		"qps":                    config.QPS,
+		"burst":                  config.Burst,
+		"offline":                config.Offline,
+	}
+	unknown := make([]string, 0)
+	for name, value := range attributes {
+		terraformValue, err := value.ToTerraformValue(ctx)
+		if err != nil || !terraformValue.IsFullyKnown() {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// execProviderConfig converts the exec attribute of the provider configuration into the exec config of client-go.
+// Returns nil if no exec-based credential plugin was configured.
+func execProviderConfig(ctx context.Context, exec types.Object) (*clientcmdapi.ExecConfig, diag.Diagnostics) {
+	if exec.IsNull() || exec.IsUnknown() {
+		return nil, nil
+	}
+
+	var model K8sProviderExecModel
+	diags := exec.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	execConfig := &clientcmdapi.ExecConfig{
+		APIVersion:      model.ApiVersion.ValueString(),
+		Command:         model.Command.ValueString(),
+		InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+	}
+	if !model.Args.IsNull() {
+		diags.Append(model.Args.ElementsAs(ctx, &execConfig.Args, false)...)
+	}
+	if !model.Env.IsNull() {
+		env := make(map[string]string)
+		diags.Append(model.Env.ElementsAs(ctx, &env, false)...)
+		names := make([]string, 0, len(env))
+		for name := range env {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			execConfig.Env = append(execConfig.Env, clientcmdapi.ExecEnvVar{Name: name, Value: env[name]})
+		}
+	}
+	return execConfig, diags
+}
+
 func (p *K8sProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return allDataSources()
 }
 
-func (p *K8sProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{}
-	//return allResources()
+func (p *K8sProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return append(enabledResources(ctx, allResources(), os.Getenv("TF_K8S_RESOURCE_GROUPS")), NewObjectResource, NewManifestBundleResource)
+}
+
+// enabledResources returns the resources of all API groups listed in the comma separated groups string. Use 'core'
+// for the legacy core group and '*' to enable every group. Resources are opt-in since the provider schema grows
+// considerably with each enabled group, therefore no resources are returned for an empty string.
+func enabledResources(ctx context.Context, resources map[string][]func() resource.Resource, groups string) []func() resource.Resource {
+	enabled := make([]func() resource.Resource, 0)
+	for _, group := range selectedResourceGroups(resources, groups) {
+		if groupResources, exists := resources[group]; exists {
+			enabled = append(enabled, groupResources...)
+		} else {
+			tflog.Warn(ctx, "Ignoring unknown API group in TF_K8S_RESOURCE_GROUPS", map[string]interface{}{
+				"group": group,
+			})
+		}
+	}
+	return enabled
+}
+
+// unknownResourceGroups returns all selected API groups for which no resources were generated, e.g. groups of CRDs.
+func unknownResourceGroups(resources map[string][]func() resource.Resource, groups string) []string {
+	unknown := make([]string, 0)
+	for _, group := range selectedResourceGroups(resources, groups) {
+		if _, exists := resources[group]; !exists {
+			unknown = append(unknown, group)
+		}
+	}
+	return unknown
+}
+
+// selectedResourceGroups returns the sorted and de-duplicated API groups selected by the given comma separated list.
+func selectedResourceGroups(resources map[string][]func() resource.Resource, groups string) []string {
+	selected := make([]string, 0)
+	for _, group := range strings.Split(groups, ",") {
+		group = strings.TrimSpace(group)
+		if group == "*" {
+			selected = selected[:0]
+			for name := range resources {
+				selected = append(selected, name)
+			}
+			break
+		}
+		if group != "" {
+			selected = append(selected, group)
+		}
+	}
+	sort.Strings(selected)
+	unique := make([]string, 0, len(selected))
+	for index, group := range selected {
+		if index == 0 || selected[index-1] != group {
+			unique = append(unique, group)
+		}
+	}
+	return unique
 }
@@ -607,8 +607,10 @@ func allDataSources() []func() datasource.DataSource {
 		// addons_cluster_x_k8s_io_v1beta1.NewAddonsClusterXK8SIoClusterResourceSetV1Beta1DataSource,
 		addons_cluster_x_k8s_io_v1beta1.NewAddonsClusterXK8SIoClusterResourceSetV1Beta1Manifest,
 		// admissionregistration_k8s_io_v1.NewAdmissionregistrationK8SIoMutatingWebhookConfigurationV1DataSource,
+		admissionregistration_k8s_io_v1.NewAdmissionregistrationK8SIoMutatingWebhookConfigurationV1ListDataSource,
 		admissionregistration_k8s_io_v1.NewAdmissionregistrationK8SIoMutatingWebhookConfigurationV1Manifest,
 		// admissionregistration_k8s_io_v1.NewAdmissionregistrationK8SIoValidatingWebhookConfigurationV1DataSource,
+		admissionregistration_k8s_io_v1.NewAdmissionregistrationK8SIoValidatingWebhookConfigurationV1ListDataSource,
 		admissionregistration_k8s_io_v1.NewAdmissionregistrationK8SIoValidatingWebhookConfigurationV1Manifest,
 		// agent_k8s_elastic_co_v1alpha1.NewAgentK8SElasticCoAgentV1Alpha1DataSource,
 		agent_k8s_elastic_co_v1alpha1.NewAgentK8SElasticCoAgentV1Alpha1Manifest,
@@ -705,6 +707,7 @@ func allDataSources() []func() datasource.DataSource {
 		// apigatewayv2_services_k8s_aws_v1alpha1.NewApigatewayv2ServicesK8SAwsVpclinkV1Alpha1DataSource,
 		apigatewayv2_services_k8s_aws_v1alpha1.NewApigatewayv2ServicesK8SAwsVpclinkV1Alpha1Manifest,
 		// apiregistration_k8s_io_v1.NewApiregistrationK8SIoApiserviceV1DataSource,
+		apiregistration_k8s_io_v1.NewApiregistrationK8SIoApiserviceV1ListDataSource,
 		apiregistration_k8s_io_v1.NewApiregistrationK8SIoApiserviceV1Manifest,
 		// apisix_apache_org_v2.NewApisixApacheOrgApisixClusterConfigV2DataSource,
 		apisix_apache_org_v2.NewApisixApacheOrgApisixClusterConfigV2Manifest,
@@ -893,12 +896,16 @@ func allDataSources() []func() datasource.DataSource {
 		// apps_redhat_com_v1alpha1.NewAppsRedhatComClusterImpairmentV1Alpha1DataSource,
 		apps_redhat_com_v1alpha1.NewAppsRedhatComClusterImpairmentV1Alpha1Manifest,
 		// apps_v1.NewAppsDaemonSetV1DataSource,
+		apps_v1.NewAppsDaemonSetV1ListDataSource,
 		apps_v1.NewAppsDaemonSetV1Manifest,
 		// apps_v1.NewAppsDeploymentV1DataSource,
+		apps_v1.NewAppsDeploymentV1ListDataSource,
 		apps_v1.NewAppsDeploymentV1Manifest,
 		// apps_v1.NewAppsReplicaSetV1DataSource,
+		apps_v1.NewAppsReplicaSetV1ListDataSource,
 		apps_v1.NewAppsReplicaSetV1Manifest,
 		// apps_v1.NewAppsStatefulSetV1DataSource,
+		apps_v1.NewAppsStatefulSetV1ListDataSource,
 		apps_v1.NewAppsStatefulSetV1Manifest,
 		// aquasecurity_github_io_v1alpha1.NewAquasecurityGithubIoAquaStarboardV1Alpha1DataSource,
 		aquasecurity_github_io_v1alpha1.NewAquasecurityGithubIoAquaStarboardV1Alpha1Manifest,
@@ -943,8 +950,10 @@ func allDataSources() []func() datasource.DataSource {
 		// autoscaling_karmada_io_v1alpha1.NewAutoscalingKarmadaIoFederatedHpaV1Alpha1DataSource,
 		autoscaling_karmada_io_v1alpha1.NewAutoscalingKarmadaIoFederatedHpaV1Alpha1Manifest,
 		// autoscaling_v1.NewAutoscalingHorizontalPodAutoscalerV1DataSource,
+		autoscaling_v1.NewAutoscalingHorizontalPodAutoscalerV1ListDataSource,
 		autoscaling_v1.NewAutoscalingHorizontalPodAutoscalerV1Manifest,
 		// autoscaling_v2.NewAutoscalingHorizontalPodAutoscalerV2DataSource,
+		autoscaling_v2.NewAutoscalingHorizontalPodAutoscalerV2ListDataSource,
 		autoscaling_v2.NewAutoscalingHorizontalPodAutoscalerV2Manifest,
 		// awx_ansible_com_v1beta1.NewAwxAnsibleComAwxV1Beta1DataSource,
 		awx_ansible_com_v1beta1.NewAwxAnsibleComAwxV1Beta1Manifest,
@@ -1059,8 +1068,10 @@ func allDataSources() []func() datasource.DataSource {
 		// b3scale_io_v1.NewB3ScaleIoBbbfrontendV1DataSource,
 		b3scale_io_v1.NewB3ScaleIoBbbfrontendV1Manifest,
 		// batch_v1.NewBatchCronJobV1DataSource,
+		batch_v1.NewBatchCronJobV1ListDataSource,
 		batch_v1.NewBatchCronJobV1Manifest,
 		// batch_v1.NewBatchJobV1DataSource,
+		batch_v1.NewBatchJobV1ListDataSource,
 		batch_v1.NewBatchJobV1Manifest,
 		// batch_volcano_sh_v1alpha1.NewBatchVolcanoShJobV1Alpha1DataSource,
 		batch_volcano_sh_v1alpha1.NewBatchVolcanoShJobV1Alpha1Manifest,
@@ -1205,6 +1216,7 @@ func allDataSources() []func() datasource.DataSource {
 		// cert_manager_io_v1.NewCertManagerIoIssuerV1DataSource,
 		cert_manager_io_v1.NewCertManagerIoIssuerV1Manifest,
 		// certificates_k8s_io_v1.NewCertificatesK8SIoCertificateSigningRequestV1DataSource,
+		certificates_k8s_io_v1.NewCertificatesK8SIoCertificateSigningRequestV1ListDataSource,
 		certificates_k8s_io_v1.NewCertificatesK8SIoCertificateSigningRequestV1Manifest,
 		// certman_managed_openshift_io_v1alpha1.NewCertmanManagedOpenshiftIoCertificateRequestV1Alpha1DataSource,
 		certman_managed_openshift_io_v1alpha1.NewCertmanManagedOpenshiftIoCertificateRequestV1Alpha1Manifest,
@@ -1451,26 +1463,37 @@ func allDataSources() []func() datasource.DataSource {
 		// core_strimzi_io_v1beta2.NewCoreStrimziIoStrimziPodSetV1Beta2DataSource,
 		core_strimzi_io_v1beta2.NewCoreStrimziIoStrimziPodSetV1Beta2Manifest,
 		// core_v1.NewConfigMapV1DataSource,
+		core_v1.NewConfigMapV1ListDataSource,
 		core_v1.NewConfigMapV1Manifest,
 		// core_v1.NewEndpointsV1DataSource,
+		core_v1.NewEndpointsV1ListDataSource,
 		core_v1.NewEndpointsV1Manifest,
 		// core_v1.NewLimitRangeV1DataSource,
+		core_v1.NewLimitRangeV1ListDataSource,
 		core_v1.NewLimitRangeV1Manifest,
 		// core_v1.NewNamespaceV1DataSource,
+		core_v1.NewNamespaceV1ListDataSource,
 		core_v1.NewNamespaceV1Manifest,
 		// core_v1.NewPersistentVolumeClaimV1DataSource,
+		core_v1.NewPersistentVolumeClaimV1ListDataSource,
 		core_v1.NewPersistentVolumeClaimV1Manifest,
 		// core_v1.NewPersistentVolumeV1DataSource,
+		core_v1.NewPersistentVolumeV1ListDataSource,
 		core_v1.NewPersistentVolumeV1Manifest,
 		// core_v1.NewPodV1DataSource,
+		core_v1.NewPodV1ListDataSource,
 		core_v1.NewPodV1Manifest,
 		// core_v1.NewReplicationControllerV1DataSource,
+		core_v1.NewReplicationControllerV1ListDataSource,
 		core_v1.NewReplicationControllerV1Manifest,
 		// core_v1.NewSecretV1DataSource,
+		core_v1.NewSecretV1ListDataSource,
 		core_v1.NewSecretV1Manifest,
 		// core_v1.NewServiceAccountV1DataSource,
+		core_v1.NewServiceAccountV1ListDataSource,
 		core_v1.NewServiceAccountV1Manifest,
 		// core_v1.NewServiceV1DataSource,
+		core_v1.NewServiceV1ListDataSource,
 		core_v1.NewServiceV1Manifest,
 		// couchbase_com_v2.NewCouchbaseComCouchbaseAutoscalerV2DataSource,
 		couchbase_com_v2.NewCouchbaseComCouchbaseAutoscalerV2Manifest,
@@ -1615,6 +1638,7 @@ func allDataSources() []func() datasource.DataSource {
 		// digitalis_io_v1beta1.NewDigitalisIoDbSecretV1Beta1DataSource,
 		digitalis_io_v1beta1.NewDigitalisIoDbSecretV1Beta1Manifest,
 		// discovery_k8s_io_v1.NewDiscoveryK8SIoEndpointSliceV1DataSource,
+		discovery_k8s_io_v1.NewDiscoveryK8SIoEndpointSliceV1ListDataSource,
 		discovery_k8s_io_v1.NewDiscoveryK8SIoEndpointSliceV1Manifest,
 		// documentdb_services_k8s_aws_v1alpha1.NewDocumentdbServicesK8SAwsDbclusterV1Alpha1DataSource,
 		documentdb_services_k8s_aws_v1alpha1.NewDocumentdbServicesK8SAwsDbclusterV1Alpha1Manifest,
@@ -1725,6 +1749,7 @@ func allDataSources() []func() datasource.DataSource {
 		// enterprisesearch_k8s_elastic_co_v1beta1.NewEnterprisesearchK8SElasticCoEnterpriseSearchV1Beta1DataSource,
 		enterprisesearch_k8s_elastic_co_v1beta1.NewEnterprisesearchK8SElasticCoEnterpriseSearchV1Beta1Manifest,
 		// events_k8s_io_v1.NewEventsK8SIoEventV1DataSource,
+		events_k8s_io_v1.NewEventsK8SIoEventV1ListDataSource,
 		events_k8s_io_v1.NewEventsK8SIoEventV1Manifest,
 		// everest_percona_com_v1alpha1.NewEverestPerconaComBackupStorageV1Alpha1DataSource,
 		everest_percona_com_v1alpha1.NewEverestPerconaComBackupStorageV1Alpha1Manifest,
@@ -1795,8 +1820,10 @@ func allDataSources() []func() datasource.DataSource {
 		// flow_volcano_sh_v1alpha1.NewFlowVolcanoShJobTemplateV1Alpha1DataSource,
 		flow_volcano_sh_v1alpha1.NewFlowVolcanoShJobTemplateV1Alpha1Manifest,
 		// flowcontrol_apiserver_k8s_io_v1beta3.NewFlowcontrolApiserverK8SIoFlowSchemaV1Beta3DataSource,
+		flowcontrol_apiserver_k8s_io_v1beta3.NewFlowcontrolApiserverK8SIoFlowSchemaV1Beta3ListDataSource,
 		flowcontrol_apiserver_k8s_io_v1beta3.NewFlowcontrolApiserverK8SIoFlowSchemaV1Beta3Manifest,
 		// flowcontrol_apiserver_k8s_io_v1beta3.NewFlowcontrolApiserverK8SIoPriorityLevelConfigurationV1Beta3DataSource,
+		flowcontrol_apiserver_k8s_io_v1beta3.NewFlowcontrolApiserverK8SIoPriorityLevelConfigurationV1Beta3ListDataSource,
 		flowcontrol_apiserver_k8s_io_v1beta3.NewFlowcontrolApiserverK8SIoPriorityLevelConfigurationV1Beta3Manifest,
 		// flows_netobserv_io_v1alpha1.NewFlowsNetobservIoFlowCollectorV1Alpha1DataSource,
 		flows_netobserv_io_v1alpha1.NewFlowsNetobservIoFlowCollectorV1Alpha1Manifest,
@@ -3073,10 +3100,13 @@ func allDataSources() []func() datasource.DataSource {
 		// networking_k8s_aws_v1alpha1.NewNetworkingK8SAwsPolicyEndpointV1Alpha1DataSource,
 		networking_k8s_aws_v1alpha1.NewNetworkingK8SAwsPolicyEndpointV1Alpha1Manifest,
 		// networking_k8s_io_v1.NewNetworkingK8SIoIngressClassV1DataSource,
+		networking_k8s_io_v1.NewNetworkingK8SIoIngressClassV1ListDataSource,
 		networking_k8s_io_v1.NewNetworkingK8SIoIngressClassV1Manifest,
 		// networking_k8s_io_v1.NewNetworkingK8SIoIngressV1DataSource,
+		networking_k8s_io_v1.NewNetworkingK8SIoIngressV1ListDataSource,
 		networking_k8s_io_v1.NewNetworkingK8SIoIngressV1Manifest,
 		// networking_k8s_io_v1.NewNetworkingK8SIoNetworkPolicyV1DataSource,
+		networking_k8s_io_v1.NewNetworkingK8SIoNetworkPolicyV1ListDataSource,
 		networking_k8s_io_v1.NewNetworkingK8SIoNetworkPolicyV1Manifest,
 		// networking_karmada_io_v1alpha1.NewNetworkingKarmadaIoMultiClusterIngressV1Alpha1DataSource,
 		networking_karmada_io_v1alpha1.NewNetworkingKarmadaIoMultiClusterIngressV1Alpha1Manifest,
@@ -3337,6 +3367,7 @@ func allDataSources() []func() datasource.DataSource {
 		// policy_networking_k8s_io_v1alpha1.NewPolicyNetworkingK8SIoBaselineAdminNetworkPolicyV1Alpha1DataSource,
 		policy_networking_k8s_io_v1alpha1.NewPolicyNetworkingK8SIoBaselineAdminNetworkPolicyV1Alpha1Manifest,
 		// policy_v1.NewPolicyPodDisruptionBudgetV1DataSource,
+		policy_v1.NewPolicyPodDisruptionBudgetV1ListDataSource,
 		policy_v1.NewPolicyPodDisruptionBudgetV1Manifest,
 		// postgres_operator_crunchydata_com_v1beta1.NewPostgresOperatorCrunchydataComPgadminV1Beta1DataSource,
 		postgres_operator_crunchydata_com_v1beta1.NewPostgresOperatorCrunchydataComPgadminV1Beta1Manifest,
@@ -3413,12 +3444,16 @@ func allDataSources() []func() datasource.DataSource {
 		// ray_io_v1alpha1.NewRayIoRayServiceV1Alpha1DataSource,
 		ray_io_v1alpha1.NewRayIoRayServiceV1Alpha1Manifest,
 		// rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoClusterRoleBindingV1DataSource,
+		rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoClusterRoleBindingV1ListDataSource,
 		rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoClusterRoleBindingV1Manifest,
 		// rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoClusterRoleV1DataSource,
+		rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoClusterRoleV1ListDataSource,
 		rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoClusterRoleV1Manifest,
 		// rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoRoleBindingV1DataSource,
+		rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoRoleBindingV1ListDataSource,
 		rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoRoleBindingV1Manifest,
 		// rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoRoleV1DataSource,
+		rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoRoleV1ListDataSource,
 		rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoRoleV1Manifest,
 		// rbacmanager_reactiveops_io_v1beta1.NewRbacmanagerReactiveopsIoRbacdefinitionV1Beta1DataSource,
 		rbacmanager_reactiveops_io_v1beta1.NewRbacmanagerReactiveopsIoRbacdefinitionV1Beta1Manifest,
@@ -3569,6 +3604,7 @@ func allDataSources() []func() datasource.DataSource {
 		// sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsUserProfileV1Alpha1DataSource,
 		sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsUserProfileV1Alpha1Manifest,
 		// scheduling_k8s_io_v1.NewSchedulingK8SIoPriorityClassV1DataSource,
+		scheduling_k8s_io_v1.NewSchedulingK8SIoPriorityClassV1ListDataSource,
 		scheduling_k8s_io_v1.NewSchedulingK8SIoPriorityClassV1Manifest,
 		// scheduling_koordinator_sh_v1alpha1.NewSchedulingKoordinatorShDeviceV1Alpha1DataSource,
 		scheduling_koordinator_sh_v1alpha1.NewSchedulingKoordinatorShDeviceV1Alpha1Manifest,
@@ -3793,12 +3829,16 @@ func allDataSources() []func() datasource.DataSource {
 		// sriovnetwork_openshift_io_v1.NewSriovnetworkOpenshiftIoSriovOperatorConfigV1DataSource,
 		sriovnetwork_openshift_io_v1.NewSriovnetworkOpenshiftIoSriovOperatorConfigV1Manifest,
 		// storage_k8s_io_v1.NewStorageK8SIoCsidriverV1DataSource,
+		storage_k8s_io_v1.NewStorageK8SIoCsidriverV1ListDataSource,
 		storage_k8s_io_v1.NewStorageK8SIoCsidriverV1Manifest,
 		// storage_k8s_io_v1.NewStorageK8SIoCsinodeV1DataSource,
+		storage_k8s_io_v1.NewStorageK8SIoCsinodeV1ListDataSource,
 		storage_k8s_io_v1.NewStorageK8SIoCsinodeV1Manifest,
 		// storage_k8s_io_v1.NewStorageK8SIoStorageClassV1DataSource,
+		storage_k8s_io_v1.NewStorageK8SIoStorageClassV1ListDataSource,
 		storage_k8s_io_v1.NewStorageK8SIoStorageClassV1Manifest,
 		// storage_k8s_io_v1.NewStorageK8SIoVolumeAttachmentV1DataSource,
+		storage_k8s_io_v1.NewStorageK8SIoVolumeAttachmentV1ListDataSource,
 		storage_k8s_io_v1.NewStorageK8SIoVolumeAttachmentV1Manifest,
 		// storage_kubeblocks_io_v1alpha1.NewStorageKubeblocksIoStorageProviderV1Alpha1DataSource,
 		storage_kubeblocks_io_v1alpha1.NewStorageKubeblocksIoStorageProviderV1Alpha1Manifest,
@@ -7,2309 +7,95 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/about_k8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/acid_zalan_do_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/acme_cert_manager_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/acmpca_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/actions_github_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/actions_summerwind_dev_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/addons_cluster_x_k8s_io_v1alpha3"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/addons_cluster_x_k8s_io_v1alpha4"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/addons_cluster_x_k8s_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/admissionregistration_k8s_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/agent_k8s_elastic_co_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/airflow_stackable_tech_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/anywhere_eks_amazonaws_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apacheweb_arsenal_dev_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/api_clever_cloud_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/api_clever_cloud_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/api_kubemod_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apicodegen_apimatic_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apiextensions_crossplane_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apiextensions_crossplane_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apigatewayv2_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apiregistration_k8s_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apisix_apache_org_v2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apm_k8s_elastic_co_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apm_k8s_elastic_co_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/app_kiegroup_org_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/app_lightbend_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/app_redislabs_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/app_redislabs_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/app_terraform_io_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/application_networking_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/applicationautoscaling_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/appmesh_k8s_aws_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/appprotect_f5_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/appprotectdos_f5_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apps_3scale_net_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apps_clusternet_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apps_emqx_io_v1beta3"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apps_emqx_io_v1beta4"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apps_emqx_io_v2alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apps_emqx_io_v2beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apps_gitlab_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apps_gitlab_com_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apps_kubeblocks_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apps_kubeblocks_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apps_kubeblocks_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apps_kubedl_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apps_kubeedge_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apps_m88i_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apps_redhat_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/apps_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/aquasecurity_github_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/argoproj_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/argoproj_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/asdb_aerospike_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/asdb_aerospike_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/atlasmap_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/auth_ops42_org_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/authentication_stackable_tech_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/authzed_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/automation_kubensync_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/autoscaling_k8s_elastic_co_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/autoscaling_k8s_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/autoscaling_k8s_io_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/autoscaling_karmada_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/autoscaling_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/autoscaling_v2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/awx_ansible_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/azure_microsoft_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/azure_microsoft_com_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/azure_microsoft_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/b3scale_infra_run_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/b3scale_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/batch_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/batch_volcano_sh_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/beat_k8s_elastic_co_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/beegfs_csi_netapp_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/binding_operators_coreos_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/bitnami_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/bmc_tinkerbell_org_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/boskos_k8s_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/bpfman_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/bus_volcano_sh_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/cache_kubedl_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/caching_ibm_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/camel_apache_org_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/camel_apache_org_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/canaries_flanksource_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/capabilities_3scale_net_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/capabilities_3scale_net_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/capsule_clastix_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/capsule_clastix_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/capsule_clastix_io_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/cassandra_datastax_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/ceph_rook_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/cert_manager_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/certificates_k8s_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/certman_managed_openshift_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/chainsaw_kyverno_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/chainsaw_kyverno_io_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/chaos_mesh_org_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/chaosblade_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/charts_amd_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/charts_flagsmith_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/charts_helm_k8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/charts_opdev_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/charts_operatorhub_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/che_eclipse_org_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/chisel_operator_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/chisel_operator_io_v2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/cilium_io_v2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/cilium_io_v2alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/claudie_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/cloudformation_linki_space_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/cloudfront_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/cloudtrail_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/cloudwatch_aws_amazon_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/cloudwatch_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/cloudwatchlogs_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/cluster_clusterpedia_io_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/cluster_ipfs_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/cluster_x_k8s_io_v1alpha3"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/cluster_x_k8s_io_v1alpha4"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/cluster_x_k8s_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/clusters_clusternet_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/clustertemplate_openshift_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/confidentialcontainers_org_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/config_gatekeeper_sh_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/config_grafana_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/config_karmada_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/config_koordinator_sh_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/config_storageos_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/control_k8ssandra_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/core_kubeadmiral_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/core_linuxsuren_github_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/core_openfeature_dev_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/core_openfeature_dev_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/core_strimzi_io_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/core_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/couchbase_com_v2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/craftypath_github_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/crane_konveyor_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/crd_projectcalico_org_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/data_fluid_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/databases_schemahero_io_v1alpha4"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/databases_spotahome_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/datadoghq_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/datadoghq_com_v2alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/dataprotection_kubeblocks_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/designer_kaoto_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/devices_kubeedge_io_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/devices_kubeedge_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/devops_kubesphere_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/dex_gpu_ninja_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/digitalis_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/digitalis_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/discovery_k8s_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/documentdb_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/druid_apache_org_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/druid_stackable_tech_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/dynamodb_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/ec2_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/ecr_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/edc_stackable_tech_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/efs_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/egressgateway_spidernet_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/eks_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/elasticache_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/elasticsearch_k8s_elastic_co_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/elasticsearch_k8s_elastic_co_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/elbv2_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/elbv2_k8s_aws_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/emrcontainers_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/ensembleoss_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/enterprise_gloo_solo_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/enterprisesearch_k8s_elastic_co_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/enterprisesearch_k8s_elastic_co_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/events_k8s_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/everest_percona_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/execution_furiko_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/executor_testkube_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/expansion_gatekeeper_sh_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/expansion_gatekeeper_sh_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/extensions_istio_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/extensions_kubeblocks_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/external_secrets_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/external_secrets_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/externaldata_gatekeeper_sh_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/externaldata_gatekeeper_sh_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/externaldns_k8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/externaldns_nginx_org_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/fence_agents_remediation_medik8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/flagger_app_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/flink_apache_org_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/flow_volcano_sh_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/flowcontrol_apiserver_k8s_io_v1beta3"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/flows_netobserv_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/flows_netobserv_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/flows_netobserv_io_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/fluentbit_fluent_io_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/fluentd_fluent_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/flux_framework_org_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/flux_framework_org_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/forklift_konveyor_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/fossul_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/gateway_networking_k8s_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/gateway_networking_k8s_io_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/gateway_networking_k8s_io_v1alpha3"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/gateway_networking_k8s_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/gateway_nginx_org_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/gateway_solo_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/getambassador_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/getambassador_io_v2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/getambassador_io_v3alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/gitops_hybrid_cloud_patterns_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/gloo_solo_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/grafana_integreatly_org_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/graphql_gloo_solo_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/groupsnapshot_storage_k8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/hazelcast_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/hbase_stackable_tech_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/hdfs_stackable_tech_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/helm_sigstore_dev_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/helm_toolkit_fluxcd_io_v2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/helm_toolkit_fluxcd_io_v2beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/helm_toolkit_fluxcd_io_v2beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/hive_openshift_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/hive_stackable_tech_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/hiveinternal_openshift_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/hnc_x_k8s_io_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/hyperfoil_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/hyperfoil_io_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/iam_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/ibmcloud_ibm_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/image_toolkit_fluxcd_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/image_toolkit_fluxcd_io_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/imaging_ingestion_alvearie_org_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/inference_kubedl_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/infinispan_org_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/infinispan_org_v2alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/infra_contrib_fluxcd_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/infra_contrib_fluxcd_io_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/infrastructure_cluster_x_k8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/infrastructure_cluster_x_k8s_io_v1alpha3"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/infrastructure_cluster_x_k8s_io_v1alpha4"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/infrastructure_cluster_x_k8s_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/infrastructure_cluster_x_k8s_io_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/installation_mattermost_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/instana_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/integration_rock8s_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/iot_eclipse_org_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/ipam_cluster_x_k8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/ipam_cluster_x_k8s_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/isindir_github_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/isindir_github_com_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/isindir_github_com_v1alpha3"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/jaegertracing_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/jobset_x_k8s_io_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/jobsmanager_raczylo_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/k6_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/k8gb_absa_oss_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/k8s_keycloak_org_v2alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/k8s_mariadb_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/k8s_nginx_org_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/k8s_nginx_org_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/k8s_otterize_com_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/k8s_otterize_com_v1alpha3"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/k8up_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kafka_banzaicloud_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kafka_banzaicloud_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kafka_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kafka_stackable_tech_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kafka_strimzi_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kafka_strimzi_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kafka_strimzi_io_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kamaji_clastix_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/karpenter_k8s_aws_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/karpenter_k8s_aws_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/karpenter_sh_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/karpenter_sh_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/keda_sh_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/keycloak_k8s_reddec_net_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/keycloak_org_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/keyspaces_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kiali_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kibana_k8s_elastic_co_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kibana_k8s_elastic_co_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kinesis_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kmm_sigs_x_k8s_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kmm_sigs_x_k8s_io_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kms_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kuadrant_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kuadrant_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kuadrant_io_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kuadrant_io_v1beta3"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kube_green_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kubean_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kubecost_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kubevious_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kueue_x_k8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kueue_x_k8s_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kuma_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kustomize_toolkit_fluxcd_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kustomize_toolkit_fluxcd_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kustomize_toolkit_fluxcd_io_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kyverno_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kyverno_io_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kyverno_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kyverno_io_v2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kyverno_io_v2alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/kyverno_io_v2beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/lambda_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/lb_lbconfig_carlosedp_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/leaksignal_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/lerentis_uploadfilter24_eu_v1beta4"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/lerentis_uploadfilter24_eu_v1beta5"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/lerentis_uploadfilter24_eu_v1beta6"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/lerentis_uploadfilter24_eu_v1beta7"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/lerentis_uploadfilter24_eu_v1beta8"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/limitador_kuadrant_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/listeners_stackable_tech_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/litmuschaos_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/logging_banzaicloud_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/logging_banzaicloud_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/logging_extensions_banzaicloud_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/loki_grafana_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/loki_grafana_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/longhorn_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/longhorn_io_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/m4e_krestomat_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/machine_deletion_remediation_medik8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/maps_k8s_elastic_co_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/mariadb_mmontes_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/marin3r_3scale_net_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/mattermost_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/memorydb_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/metacontroller_k8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/metal3_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/minio_min_io_v2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/mirrors_kts_studio_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/mirrors_kts_studio_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/model_kubedl_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/monitoring_coreos_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/monitoring_coreos_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/monitoring_coreos_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/monocle_monocle_change_metrics_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/mq_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/multicluster_crd_antrea_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/multicluster_crd_antrea_io_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/multicluster_x_k8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/mutations_gatekeeper_sh_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/mutations_gatekeeper_sh_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/mutations_gatekeeper_sh_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/nativestor_alauda_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/netchecks_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/networkfirewall_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/networking_gke_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/networking_istio_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/networking_istio_io_v1alpha3"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/networking_istio_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/networking_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/networking_k8s_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/networking_karmada_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/nfd_k8s_sigs_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/nfd_kubernetes_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/nfd_kubernetes_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/nifi_stackable_tech_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/nodeinfo_volcano_sh_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/notebook_kubedl_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/notification_toolkit_fluxcd_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/notification_toolkit_fluxcd_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/notification_toolkit_fluxcd_io_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/notification_toolkit_fluxcd_io_v1beta3"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/objectbucket_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/ocmagent_managed_openshift_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/onepassword_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/opa_stackable_tech_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/opensearchservice_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/opentelemetry_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/opentelemetry_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/operations_kubeedge_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/operator_aquasec_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/operator_authorino_kuadrant_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/operator_cluster_x_k8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/operator_cluster_x_k8s_io_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/operator_cryostat_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/operator_cryostat_io_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/operator_knative_dev_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/operator_marin3r_3scale_net_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/operator_open_cluster_management_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/operator_shipwright_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/operator_tigera_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/operator_tigera_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/operator_victoriametrics_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/oracle_db_anthosapis_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/org_eclipse_che_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/org_eclipse_che_v2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/organizations_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/pgv2_percona_com_v2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/pipes_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/pkg_crossplane_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/pkg_crossplane_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/pkg_crossplane_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/policy_clusterpedia_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/policy_karmada_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/policy_kubeedge_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/policy_networking_k8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/policy_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/postgres_operator_crunchydata_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/postgresql_cnpg_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/projectcontour_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/projectcontour_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/prometheusservice_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/ps_percona_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/psmdb_percona_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/ptp_openshift_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/pubsubplus_solace_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/pxc_percona_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/quay_redhat_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/quota_codeflare_dev_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/ray_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/ray_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/rbac_authorization_k8s_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/rbacmanager_reactiveops_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/rc_app_stacks_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/rc_app_stacks_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/rds_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/redhatcop_redhat_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/registry_apicur_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/registry_devfile_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/reliablesyncs_kubeedge_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/remediation_medik8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/repo_manager_pulpproject_org_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/reports_kyverno_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/resources_teleport_dev_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/resources_teleport_dev_v2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/resources_teleport_dev_v3"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/resources_teleport_dev_v5"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/resources_teleport_dev_v6"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/ripsaw_cloudbulldozer_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/rocketmq_apache_org_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/route53_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/route53resolver_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/rules_kubeedge_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/runtime_cluster_x_k8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/s3_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/s3_snappcloud_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/sagemaker_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/scheduling_k8s_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/scheduling_koordinator_sh_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/scheduling_sigs_k8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/scheduling_volcano_sh_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/schemas_schemahero_io_v1alpha4"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/scylla_scylladb_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/scylla_scylladb_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/secretgenerator_mittwald_de_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/secrets_crossplane_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/secrets_doppler_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/secrets_hashicorp_com_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/secrets_stackable_tech_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/secrets_store_csi_x_k8s_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/secrets_store_csi_x_k8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/secretsmanager_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/secscan_quay_redhat_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/security_istio_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/security_istio_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/security_profiles_operator_x_k8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/security_profiles_operator_x_k8s_io_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/security_profiles_operator_x_k8s_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/self_node_remediation_medik8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/sematext_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/servicebinding_io_v1alpha3"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/servicebinding_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/servicemesh_cisco_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/serving_kubedl_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/sfn_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/site_superedge_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/slo_koordinator_sh_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/sloth_slok_dev_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/snapscheduler_backube_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/snapshot_storage_k8s_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/snapshot_storage_k8s_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/sns_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/sonataflow_org_v1alpha08"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/source_toolkit_fluxcd_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/source_toolkit_fluxcd_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/source_toolkit_fluxcd_io_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/spark_stackable_tech_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/sparkoperator_k8s_io_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/spv_no_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/spv_no_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/spv_no_v2alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/spv_no_v2beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/sqs_services_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/sriovnetwork_openshift_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/storage_k8s_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/storage_kubeblocks_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/storageos_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/sts_min_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/sts_min_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/stunner_l7mp_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/stunner_l7mp_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/submariner_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/superset_stackable_tech_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/telemetry_istio_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/telemetry_istio_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/templates_gatekeeper_sh_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/templates_gatekeeper_sh_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/templates_gatekeeper_sh_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/tempo_grafana_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/temporal_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/tests_testkube_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/tests_testkube_io_v2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/tests_testkube_io_v3"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/tf_tungsten_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/theketch_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/tinkerbell_org_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/tinkerbell_org_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/topology_node_k8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/topolvm_cybozu_com_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/topolvm_cybozu_com_v2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/traefik_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/training_kubedl_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/trino_stackable_tech_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/trust_cert_manager_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/upgrade_cattle_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/upgrade_managed_openshift_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/velero_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/velero_io_v2alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/virt_virtink_smartx_com_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/volsync_backube_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/vpcresources_k8s_aws_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/vpcresources_k8s_aws_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/wgpolicyk8s_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/wgpolicyk8s_io_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/wgpolicyk8s_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/wildfly_org_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/work_karmada_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/work_karmada_io_v1alpha2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/workload_codeflare_dev_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/workload_codeflare_dev_v1beta2"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/workloads_kubeblocks_io_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/workloads_kubeblocks_io_v1alpha1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/zonecontrol_k8s_aws_v1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/zookeeper_pravega_io_v1beta1"
-	//"github.com/metio/terraform-provider-k8s/internal/provider/zookeeper_stackable_tech_v1alpha1"
+	"github.com/metio/terraform-provider-k8s/internal/provider/admissionregistration_k8s_io_v1"
+	"github.com/metio/terraform-provider-k8s/internal/provider/apiregistration_k8s_io_v1"
+	"github.com/metio/terraform-provider-k8s/internal/provider/apps_v1"
+	"github.com/metio/terraform-provider-k8s/internal/provider/autoscaling_v1"
+	"github.com/metio/terraform-provider-k8s/internal/provider/autoscaling_v2"
+	"github.com/metio/terraform-provider-k8s/internal/provider/batch_v1"
+	"github.com/metio/terraform-provider-k8s/internal/provider/certificates_k8s_io_v1"
+	"github.com/metio/terraform-provider-k8s/internal/provider/core_v1"
+	"github.com/metio/terraform-provider-k8s/internal/provider/discovery_k8s_io_v1"
+	"github.com/metio/terraform-provider-k8s/internal/provider/events_k8s_io_v1"
+	"github.com/metio/terraform-provider-k8s/internal/provider/flowcontrol_apiserver_k8s_io_v1beta3"
+	"github.com/metio/terraform-provider-k8s/internal/provider/networking_k8s_io_v1"
+	"github.com/metio/terraform-provider-k8s/internal/provider/policy_v1"
+	"github.com/metio/terraform-provider-k8s/internal/provider/rbac_authorization_k8s_io_v1"
+	"github.com/metio/terraform-provider-k8s/internal/provider/scheduling_k8s_io_v1"
+	"github.com/metio/terraform-provider-k8s/internal/provider/storage_k8s_io_v1"
 )
 
-func allResources() []func() resource.Resource {
-	return []func() resource.Resource{
-		//about_k8s_io_v1alpha1.NewAboutK8SIoClusterPropertyV1Alpha1Resource,
-		//acid_zalan_do_v1.NewAcidZalanDoOperatorConfigurationV1Resource,
-		//acid_zalan_do_v1.NewAcidZalanDoPostgresTeamV1Resource,
-		//acid_zalan_do_v1.NewAcidZalanDoPostgresqlV1Resource,
-		//acme_cert_manager_io_v1.NewAcmeCertManagerIoChallengeV1Resource,
-		//acme_cert_manager_io_v1.NewAcmeCertManagerIoOrderV1Resource,
-		//acmpca_services_k8s_aws_v1alpha1.NewAcmpcaServicesK8SAwsCertificateAuthorityActivationV1Alpha1Resource,
-		//acmpca_services_k8s_aws_v1alpha1.NewAcmpcaServicesK8SAwsCertificateAuthorityV1Alpha1Resource,
-		//acmpca_services_k8s_aws_v1alpha1.NewAcmpcaServicesK8SAwsCertificateV1Alpha1Resource,
-		//actions_github_com_v1alpha1.NewActionsGithubComAutoscalingListenerV1Alpha1Resource,
-		//actions_github_com_v1alpha1.NewActionsGithubComAutoscalingRunnerSetV1Alpha1Resource,
-		//actions_github_com_v1alpha1.NewActionsGithubComEphemeralRunnerSetV1Alpha1Resource,
-		//actions_github_com_v1alpha1.NewActionsGithubComEphemeralRunnerV1Alpha1Resource,
-		//actions_summerwind_dev_v1alpha1.NewActionsSummerwindDevHorizontalRunnerAutoscalerV1Alpha1Resource,
-		//actions_summerwind_dev_v1alpha1.NewActionsSummerwindDevRunnerDeploymentV1Alpha1Resource,
-		//actions_summerwind_dev_v1alpha1.NewActionsSummerwindDevRunnerReplicaSetV1Alpha1Resource,
-		//actions_summerwind_dev_v1alpha1.NewActionsSummerwindDevRunnerSetV1Alpha1Resource,
-		//actions_summerwind_dev_v1alpha1.NewActionsSummerwindDevRunnerV1Alpha1Resource,
-		//addons_cluster_x_k8s_io_v1alpha3.NewAddonsClusterXK8SIoClusterResourceSetBindingV1Alpha3Resource,
-		//addons_cluster_x_k8s_io_v1alpha3.NewAddonsClusterXK8SIoClusterResourceSetV1Alpha3Resource,
-		//addons_cluster_x_k8s_io_v1alpha4.NewAddonsClusterXK8SIoClusterResourceSetBindingV1Alpha4Resource,
-		//addons_cluster_x_k8s_io_v1alpha4.NewAddonsClusterXK8SIoClusterResourceSetV1Alpha4Resource,
-		//addons_cluster_x_k8s_io_v1beta1.NewAddonsClusterXK8SIoClusterResourceSetBindingV1Beta1Resource,
-		//addons_cluster_x_k8s_io_v1beta1.NewAddonsClusterXK8SIoClusterResourceSetV1Beta1Resource,
-		//admissionregistration_k8s_io_v1.NewAdmissionregistrationK8SIoMutatingWebhookConfigurationV1Resource,
-		//admissionregistration_k8s_io_v1.NewAdmissionregistrationK8SIoValidatingWebhookConfigurationV1Resource,
-		//agent_k8s_elastic_co_v1alpha1.NewAgentK8SElasticCoAgentV1Alpha1Resource,
-		//airflow_stackable_tech_v1alpha1.NewAirflowStackableTechAirflowClusterV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComAwsdatacenterConfigV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComAwsiamConfigV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComBundlesV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComCloudStackDatacenterConfigV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComCloudStackMachineConfigV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComClusterV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComControlPlaneUpgradeV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComDockerDatacenterConfigV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComEksareleaseV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComFluxConfigV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComGitOpsConfigV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComMachineDeploymentUpgradeV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComNodeUpgradeV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComNutanixDatacenterConfigV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComNutanixMachineConfigV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComOidcconfigV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComSnowDatacenterConfigV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComSnowIppoolV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComSnowMachineConfigV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComTinkerbellDatacenterConfigV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComTinkerbellMachineConfigV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComTinkerbellTemplateConfigV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComVsphereDatacenterConfigV1Alpha1Resource,
-		//anywhere_eks_amazonaws_com_v1alpha1.NewAnywhereEksAmazonawsComVsphereMachineConfigV1Alpha1Resource,
-		//apacheweb_arsenal_dev_v1alpha1.NewApachewebArsenalDevApachewebV1Alpha1Resource,
-		//api_clever_cloud_com_v1.NewApiCleverCloudComConfigProviderV1Resource,
-		//api_clever_cloud_com_v1.NewApiCleverCloudComElasticSearchV1Resource,
-		//api_clever_cloud_com_v1.NewApiCleverCloudComMongoDbV1Resource,
-		//api_clever_cloud_com_v1.NewApiCleverCloudComMySqlV1Resource,
-		//api_clever_cloud_com_v1.NewApiCleverCloudComPostgreSqlV1Resource,
-		//api_clever_cloud_com_v1.NewApiCleverCloudComRedisV1Resource,
-		//api_clever_cloud_com_v1beta1.NewApiCleverCloudComPulsarV1Beta1Resource,
-		//api_kubemod_io_v1beta1.NewApiKubemodIoModRuleV1Beta1Resource,
-		//apicodegen_apimatic_io_v1beta1.NewApicodegenApimaticIoApimaticV1Beta1Resource,
-		//apiextensions_crossplane_io_v1.NewApiextensionsCrossplaneIoCompositeResourceDefinitionV1Resource,
-		//apiextensions_crossplane_io_v1.NewApiextensionsCrossplaneIoCompositionRevisionV1Resource,
-		//apiextensions_crossplane_io_v1.NewApiextensionsCrossplaneIoCompositionV1Resource,
-		//apiextensions_crossplane_io_v1beta1.NewApiextensionsCrossplaneIoCompositionRevisionV1Beta1Resource,
-		//apigatewayv2_services_k8s_aws_v1alpha1.NewApigatewayv2ServicesK8SAwsApiV1Alpha1Resource,
-		//apigatewayv2_services_k8s_aws_v1alpha1.NewApigatewayv2ServicesK8SAwsAuthorizerV1Alpha1Resource,
-		//apigatewayv2_services_k8s_aws_v1alpha1.NewApigatewayv2ServicesK8SAwsDeploymentV1Alpha1Resource,
-		//apigatewayv2_services_k8s_aws_v1alpha1.NewApigatewayv2ServicesK8SAwsIntegrationV1Alpha1Resource,
-		//apigatewayv2_services_k8s_aws_v1alpha1.NewApigatewayv2ServicesK8SAwsRouteV1Alpha1Resource,
-		//apigatewayv2_services_k8s_aws_v1alpha1.NewApigatewayv2ServicesK8SAwsStageV1Alpha1Resource,
-		//apigatewayv2_services_k8s_aws_v1alpha1.NewApigatewayv2ServicesK8SAwsVpclinkV1Alpha1Resource,
-		//apiregistration_k8s_io_v1.NewApiregistrationK8SIoApiserviceV1Resource,
-		//apisix_apache_org_v2.NewApisixApacheOrgApisixClusterConfigV2Resource,
-		//apisix_apache_org_v2.NewApisixApacheOrgApisixConsumerV2Resource,
-		//apisix_apache_org_v2.NewApisixApacheOrgApisixGlobalRuleV2Resource,
-		//apisix_apache_org_v2.NewApisixApacheOrgApisixPluginConfigV2Resource,
-		//apisix_apache_org_v2.NewApisixApacheOrgApisixRouteV2Resource,
-		//apisix_apache_org_v2.NewApisixApacheOrgApisixTlsV2Resource,
-		//apisix_apache_org_v2.NewApisixApacheOrgApisixUpstreamV2Resource,
-		//apm_k8s_elastic_co_v1.NewApmK8SElasticCoApmServerV1Resource,
-		//apm_k8s_elastic_co_v1beta1.NewApmK8SElasticCoApmServerV1Beta1Resource,
-		//app_kiegroup_org_v1beta1.NewAppKiegroupOrgKogitoBuildV1Beta1Resource,
-		//app_kiegroup_org_v1beta1.NewAppKiegroupOrgKogitoInfraV1Beta1Resource,
-		//app_kiegroup_org_v1beta1.NewAppKiegroupOrgKogitoRuntimeV1Beta1Resource,
-		//app_kiegroup_org_v1beta1.NewAppKiegroupOrgKogitoSupportingServiceV1Beta1Resource,
-		//app_lightbend_com_v1alpha1.NewAppLightbendComAkkaClusterV1Alpha1Resource,
-		//app_redislabs_com_v1.NewAppRedislabsComRedisEnterpriseClusterV1Resource,
-		//app_redislabs_com_v1alpha1.NewAppRedislabsComRedisEnterpriseActiveActiveDatabaseV1Alpha1Resource,
-		//app_redislabs_com_v1alpha1.NewAppRedislabsComRedisEnterpriseClusterV1Alpha1Resource,
-		//app_redislabs_com_v1alpha1.NewAppRedislabsComRedisEnterpriseDatabaseV1Alpha1Resource,
-		//app_redislabs_com_v1alpha1.NewAppRedislabsComRedisEnterpriseRemoteClusterV1Alpha1Resource,
-		//app_terraform_io_v1alpha2.NewAppTerraformIoAgentPoolV1Alpha2Resource,
-		//app_terraform_io_v1alpha2.NewAppTerraformIoModuleV1Alpha2Resource,
-		//app_terraform_io_v1alpha2.NewAppTerraformIoWorkspaceV1Alpha2Resource,
-		//application_networking_k8s_aws_v1alpha1.NewApplicationNetworkingK8SAwsAccessLogPolicyV1Alpha1Resource,
-		//application_networking_k8s_aws_v1alpha1.NewApplicationNetworkingK8SAwsIamauthPolicyV1Alpha1Resource,
-		//application_networking_k8s_aws_v1alpha1.NewApplicationNetworkingK8SAwsServiceImportV1Alpha1Resource,
-		//application_networking_k8s_aws_v1alpha1.NewApplicationNetworkingK8SAwsTargetGroupPolicyV1Alpha1Resource,
-		//application_networking_k8s_aws_v1alpha1.NewApplicationNetworkingK8SAwsVpcAssociationPolicyV1Alpha1Resource,
-		//applicationautoscaling_services_k8s_aws_v1alpha1.NewApplicationautoscalingServicesK8SAwsScalableTargetV1Alpha1Resource,
-		//applicationautoscaling_services_k8s_aws_v1alpha1.NewApplicationautoscalingServicesK8SAwsScalingPolicyV1Alpha1Resource,
-		//appmesh_k8s_aws_v1beta2.NewAppmeshK8SAwsBackendGroupV1Beta2Resource,
-		//appmesh_k8s_aws_v1beta2.NewAppmeshK8SAwsGatewayRouteV1Beta2Resource,
-		//appmesh_k8s_aws_v1beta2.NewAppmeshK8SAwsMeshV1Beta2Resource,
-		//appmesh_k8s_aws_v1beta2.NewAppmeshK8SAwsVirtualGatewayV1Beta2Resource,
-		//appmesh_k8s_aws_v1beta2.NewAppmeshK8SAwsVirtualNodeV1Beta2Resource,
-		//appmesh_k8s_aws_v1beta2.NewAppmeshK8SAwsVirtualRouterV1Beta2Resource,
-		//appmesh_k8s_aws_v1beta2.NewAppmeshK8SAwsVirtualServiceV1Beta2Resource,
-		//appprotect_f5_com_v1beta1.NewAppprotectF5ComAplogConfV1Beta1Resource,
-		//appprotect_f5_com_v1beta1.NewAppprotectF5ComAppolicyV1Beta1Resource,
-		//appprotect_f5_com_v1beta1.NewAppprotectF5ComApuserSigV1Beta1Resource,
-		//appprotectdos_f5_com_v1beta1.NewAppprotectdosF5ComApdosLogConfV1Beta1Resource,
-		//appprotectdos_f5_com_v1beta1.NewAppprotectdosF5ComApdosPolicyV1Beta1Resource,
-		//appprotectdos_f5_com_v1beta1.NewAppprotectdosF5ComDosProtectedResourceV1Beta1Resource,
-		//apps_3scale_net_v1alpha1.NewApps3ScaleNetApicastV1Alpha1Resource,
-		//apps_3scale_net_v1alpha1.NewApps3ScaleNetApimanagerBackupV1Alpha1Resource,
-		//apps_3scale_net_v1alpha1.NewApps3ScaleNetApimanagerRestoreV1Alpha1Resource,
-		//apps_3scale_net_v1alpha1.NewApps3ScaleNetApimanagerV1Alpha1Resource,
-		//apps_clusternet_io_v1alpha1.NewAppsClusternetIoBaseV1Alpha1Resource,
-		//apps_clusternet_io_v1alpha1.NewAppsClusternetIoDescriptionV1Alpha1Resource,
-		//apps_clusternet_io_v1alpha1.NewAppsClusternetIoFeedInventoryV1Alpha1Resource,
-		//apps_clusternet_io_v1alpha1.NewAppsClusternetIoGlobalizationV1Alpha1Resource,
-		//apps_clusternet_io_v1alpha1.NewAppsClusternetIoHelmChartV1Alpha1Resource,
-		//apps_clusternet_io_v1alpha1.NewAppsClusternetIoHelmReleaseV1Alpha1Resource,
-		//apps_clusternet_io_v1alpha1.NewAppsClusternetIoLocalizationV1Alpha1Resource,
-		//apps_clusternet_io_v1alpha1.NewAppsClusternetIoManifestV1Alpha1Resource,
-		//apps_clusternet_io_v1alpha1.NewAppsClusternetIoSubscriptionV1Alpha1Resource,
-		//apps_emqx_io_v1beta3.NewAppsEmqxIoEmqxBrokerV1Beta3Resource,
-		//apps_emqx_io_v1beta3.NewAppsEmqxIoEmqxEnterpriseV1Beta3Resource,
-		//apps_emqx_io_v1beta3.NewAppsEmqxIoEmqxPluginV1Beta3Resource,
-		//apps_emqx_io_v1beta4.NewAppsEmqxIoEmqxBrokerV1Beta4Resource,
-		//apps_emqx_io_v1beta4.NewAppsEmqxIoEmqxEnterpriseV1Beta4Resource,
-		//apps_emqx_io_v1beta4.NewAppsEmqxIoEmqxPluginV1Beta4Resource,
-		//apps_emqx_io_v1beta4.NewAppsEmqxIoRebalanceV1Beta4Resource,
-		//apps_emqx_io_v2alpha1.NewAppsEmqxIoEmqxV2Alpha1Resource,
-		//apps_emqx_io_v2beta1.NewAppsEmqxIoEmqxV2Beta1Resource,
-		//apps_emqx_io_v2beta1.NewAppsEmqxIoRebalanceV2Beta1Resource,
-		//apps_gitlab_com_v1beta1.NewAppsGitlabComGitLabV1Beta1Resource,
-		//apps_gitlab_com_v1beta2.NewAppsGitlabComRunnerV1Beta2Resource,
-		//apps_kubeblocks_io_v1.NewAppsKubeblocksIoClusterDefinitionV1Resource,
-		//apps_kubeblocks_io_v1.NewAppsKubeblocksIoClusterV1Resource,
-		//apps_kubeblocks_io_v1.NewAppsKubeblocksIoComponentDefinitionV1Resource,
-		//apps_kubeblocks_io_v1.NewAppsKubeblocksIoComponentV1Resource,
-		//apps_kubeblocks_io_v1.NewAppsKubeblocksIoComponentVersionV1Resource,
-		//apps_kubeblocks_io_v1.NewAppsKubeblocksIoServiceDescriptorV1Resource,
-		//apps_kubeblocks_io_v1alpha1.NewAppsKubeblocksIoBackupPolicyTemplateV1Alpha1Resource,
-		//apps_kubeblocks_io_v1alpha1.NewAppsKubeblocksIoClusterDefinitionV1Alpha1Resource,
-		//apps_kubeblocks_io_v1alpha1.NewAppsKubeblocksIoClusterV1Alpha1Resource,
-		//apps_kubeblocks_io_v1alpha1.NewAppsKubeblocksIoClusterVersionV1Alpha1Resource,
-		//apps_kubeblocks_io_v1alpha1.NewAppsKubeblocksIoComponentClassDefinitionV1Alpha1Resource,
-		//apps_kubeblocks_io_v1alpha1.NewAppsKubeblocksIoComponentDefinitionV1Alpha1Resource,
-		//apps_kubeblocks_io_v1alpha1.NewAppsKubeblocksIoComponentResourceConstraintV1Alpha1Resource,
-		//apps_kubeblocks_io_v1alpha1.NewAppsKubeblocksIoComponentV1Alpha1Resource,
-		//apps_kubeblocks_io_v1alpha1.NewAppsKubeblocksIoComponentVersionV1Alpha1Resource,
-		//apps_kubeblocks_io_v1alpha1.NewAppsKubeblocksIoConfigConstraintV1Alpha1Resource,
-		//apps_kubeblocks_io_v1alpha1.NewAppsKubeblocksIoConfigurationV1Alpha1Resource,
-		//apps_kubeblocks_io_v1alpha1.NewAppsKubeblocksIoOpsDefinitionV1Alpha1Resource,
-		//apps_kubeblocks_io_v1alpha1.NewAppsKubeblocksIoOpsRequestV1Alpha1Resource,
-		//apps_kubeblocks_io_v1alpha1.NewAppsKubeblocksIoServiceDescriptorV1Alpha1Resource,
-		//apps_kubeblocks_io_v1beta1.NewAppsKubeblocksIoConfigConstraintV1Beta1Resource,
-		//apps_kubedl_io_v1alpha1.NewAppsKubedlIoCronV1Alpha1Resource,
-		//apps_kubeedge_io_v1alpha1.NewAppsKubeedgeIoEdgeApplicationV1Alpha1Resource,
-		//apps_kubeedge_io_v1alpha1.NewAppsKubeedgeIoNodeGroupV1Alpha1Resource,
-		//apps_m88i_io_v1alpha1.NewAppsM88IIoNexusV1Alpha1Resource,
-		//apps_redhat_com_v1alpha1.NewAppsRedhatComClusterImpairmentV1Alpha1Resource,
-		//apps_v1.NewAppsDaemonSetV1Resource,
-		//apps_v1.NewAppsDeploymentV1Resource,
-		//apps_v1.NewAppsReplicaSetV1Resource,
-		//apps_v1.NewAppsStatefulSetV1Resource,
-		//aquasecurity_github_io_v1alpha1.NewAquasecurityGithubIoAquaStarboardV1Alpha1Resource,
-		//argoproj_io_v1alpha1.NewArgoprojIoAppProjectV1Alpha1Resource,
-		//argoproj_io_v1alpha1.NewArgoprojIoApplicationSetV1Alpha1Resource,
-		//argoproj_io_v1alpha1.NewArgoprojIoApplicationV1Alpha1Resource,
-		//argoproj_io_v1alpha1.NewArgoprojIoArgoCdV1Alpha1Resource,
-		//argoproj_io_v1alpha1.NewArgoprojIoArgoCdexportV1Alpha1Resource,
-		//argoproj_io_v1beta1.NewArgoprojIoArgoCdV1Beta1Resource,
-		//asdb_aerospike_com_v1.NewAsdbAerospikeComAerospikeClusterV1Resource,
-		//asdb_aerospike_com_v1beta1.NewAsdbAerospikeComAerospikeClusterV1Beta1Resource,
-		//atlasmap_io_v1alpha1.NewAtlasmapIoAtlasMapV1Alpha1Resource,
-		//auth_ops42_org_v1alpha1.NewAuthOps42OrgAwsAuthSyncConfigV1Alpha1Resource,
-		//authentication_stackable_tech_v1alpha1.NewAuthenticationStackableTechAuthenticationClassV1Alpha1Resource,
-		//authzed_com_v1alpha1.NewAuthzedComSpiceDbclusterV1Alpha1Resource,
-		//automation_kubensync_com_v1alpha1.NewAutomationKubensyncComManagedResourceV1Alpha1Resource,
-		//autoscaling_k8s_elastic_co_v1alpha1.NewAutoscalingK8SElasticCoElasticsearchAutoscalerV1Alpha1Resource,
-		//autoscaling_k8s_io_v1.NewAutoscalingK8SIoVerticalPodAutoscalerCheckpointV1Resource,
-		//autoscaling_k8s_io_v1.NewAutoscalingK8SIoVerticalPodAutoscalerV1Resource,
-		//autoscaling_k8s_io_v1beta2.NewAutoscalingK8SIoVerticalPodAutoscalerCheckpointV1Beta2Resource,
-		//autoscaling_k8s_io_v1beta2.NewAutoscalingK8SIoVerticalPodAutoscalerV1Beta2Resource,
-		//autoscaling_karmada_io_v1alpha1.NewAutoscalingKarmadaIoCronFederatedHpaV1Alpha1Resource,
-		//autoscaling_karmada_io_v1alpha1.NewAutoscalingKarmadaIoFederatedHpaV1Alpha1Resource,
-		//autoscaling_v1.NewAutoscalingHorizontalPodAutoscalerV1Resource,
-		//autoscaling_v2.NewAutoscalingHorizontalPodAutoscalerV2Resource,
-		//awx_ansible_com_v1beta1.NewAwxAnsibleComAwxV1Beta1Resource,
-		//awx_ansible_com_v1beta1.NewAwxAnsibleComAwxbackupV1Beta1Resource,
-		//awx_ansible_com_v1beta1.NewAwxAnsibleComAwxrestoreV1Beta1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComApimServiceV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComApimgmtApiV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComAppInsightsApiKeyV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComAppInsightsV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComAzureLoadBalancerV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComAzureNetworkInterfaceV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComAzurePublicIpaddressV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComAzureSqlActionV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComAzureSqlDatabaseV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComAzureSqlFailoverGroupV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComAzureSqlFirewallRuleV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComAzureSqlServerV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComAzureSqlmanagedUserV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComAzureSqluserV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComAzureSqlvnetRuleV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComAzureVirtualMachineExtensionV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComAzureVirtualMachineV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComAzureVmscaleSetV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComBlobContainerV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComConsumerGroupV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComCosmosDbV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComEventhubNamespaceV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComEventhubV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComKeyVaultKeyV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComKeyVaultV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComMySqlaaduserV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComMySqldatabaseV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComMySqlfirewallRuleV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComMySqlserverAdministratorV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComMySqlserverV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComMySqluserV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComMySqlvnetRuleV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComPostgreSqldatabaseV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComPostgreSqlfirewallRuleV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComPostgreSqlserverV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComPostgreSqluserV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComPostgreSqlvnetRuleV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComRedisCacheActionV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComRedisCacheFirewallRuleV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComResourceGroupV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComStorageAccountV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha1.NewAzureMicrosoftComVirtualNetworkV1Alpha1Resource,
-		//azure_microsoft_com_v1alpha2.NewAzureMicrosoftComBlobContainerV1Alpha2Resource,
-		//azure_microsoft_com_v1alpha2.NewAzureMicrosoftComMySqlaaduserV1Alpha2Resource,
-		//azure_microsoft_com_v1alpha2.NewAzureMicrosoftComMySqlserverV1Alpha2Resource,
-		//azure_microsoft_com_v1alpha2.NewAzureMicrosoftComMySqluserV1Alpha2Resource,
-		//azure_microsoft_com_v1alpha2.NewAzureMicrosoftComPostgreSqlserverV1Alpha2Resource,
-		//azure_microsoft_com_v1beta1.NewAzureMicrosoftComAzureSqlDatabaseV1Beta1Resource,
-		//azure_microsoft_com_v1beta1.NewAzureMicrosoftComAzureSqlFailoverGroupV1Beta1Resource,
-		//azure_microsoft_com_v1beta1.NewAzureMicrosoftComAzureSqlFirewallRuleV1Beta1Resource,
-		//azure_microsoft_com_v1beta1.NewAzureMicrosoftComAzureSqlServerV1Beta1Resource,
-		//b3scale_infra_run_v1.NewB3ScaleInfraRunBbbfrontendV1Resource,
-		//b3scale_io_v1.NewB3ScaleIoBbbfrontendV1Resource,
-		//batch_v1.NewBatchCronJobV1Resource,
-		//batch_v1.NewBatchJobV1Resource,
-		//batch_volcano_sh_v1alpha1.NewBatchVolcanoShJobV1Alpha1Resource,
-		//beat_k8s_elastic_co_v1beta1.NewBeatK8SElasticCoBeatV1Beta1Resource,
-		//beegfs_csi_netapp_com_v1.NewBeegfsCsiNetappComBeegfsDriverV1Resource,
-		//binding_operators_coreos_com_v1alpha1.NewBindingOperatorsCoreosComServiceBindingV1Alpha1Resource,
-		//bitnami_com_v1alpha1.NewBitnamiComSealedSecretV1Alpha1Resource,
-		//bmc_tinkerbell_org_v1alpha1.NewBmcTinkerbellOrgJobV1Alpha1Resource,
-		//bmc_tinkerbell_org_v1alpha1.NewBmcTinkerbellOrgMachineV1Alpha1Resource,
-		//bmc_tinkerbell_org_v1alpha1.NewBmcTinkerbellOrgTaskV1Alpha1Resource,
-		//boskos_k8s_io_v1.NewBoskosK8SIoDrlcobjectV1Resource,
-		//boskos_k8s_io_v1.NewBoskosK8SIoResourceObjectV1Resource,
-		//bpfman_io_v1alpha1.NewBpfmanIoBpfProgramV1Alpha1Resource,
-		//bpfman_io_v1alpha1.NewBpfmanIoFentryProgramV1Alpha1Resource,
-		//bpfman_io_v1alpha1.NewBpfmanIoFexitProgramV1Alpha1Resource,
-		//bpfman_io_v1alpha1.NewBpfmanIoKprobeProgramV1Alpha1Resource,
-		//bpfman_io_v1alpha1.NewBpfmanIoTcProgramV1Alpha1Resource,
-		//bpfman_io_v1alpha1.NewBpfmanIoTracepointProgramV1Alpha1Resource,
-		//bpfman_io_v1alpha1.NewBpfmanIoUprobeProgramV1Alpha1Resource,
-		//bpfman_io_v1alpha1.NewBpfmanIoXdpProgramV1Alpha1Resource,
-		//bus_volcano_sh_v1alpha1.NewBusVolcanoShCommandV1Alpha1Resource,
-		//cache_kubedl_io_v1alpha1.NewCacheKubedlIoCacheBackendV1Alpha1Resource,
-		//caching_ibm_com_v1alpha1.NewCachingIbmComVarnishClusterV1Alpha1Resource,
-		//camel_apache_org_v1.NewCamelApacheOrgBuildV1Resource,
-		//camel_apache_org_v1.NewCamelApacheOrgCamelCatalogV1Resource,
-		//camel_apache_org_v1.NewCamelApacheOrgIntegrationKitV1Resource,
-		//camel_apache_org_v1.NewCamelApacheOrgIntegrationPlatformV1Resource,
-		//camel_apache_org_v1.NewCamelApacheOrgIntegrationProfileV1Resource,
-		//camel_apache_org_v1.NewCamelApacheOrgIntegrationV1Resource,
-		//camel_apache_org_v1.NewCamelApacheOrgKameletV1Resource,
-		//camel_apache_org_v1.NewCamelApacheOrgPipeV1Resource,
-		//camel_apache_org_v1alpha1.NewCamelApacheOrgKameletBindingV1Alpha1Resource,
-		//camel_apache_org_v1alpha1.NewCamelApacheOrgKameletV1Alpha1Resource,
-		//canaries_flanksource_com_v1.NewCanariesFlanksourceComCanaryV1Resource,
-		//canaries_flanksource_com_v1.NewCanariesFlanksourceComComponentV1Resource,
-		//canaries_flanksource_com_v1.NewCanariesFlanksourceComTopologyV1Resource,
-		//capabilities_3scale_net_v1alpha1.NewCapabilities3ScaleNetTenantV1Alpha1Resource,
-		//capabilities_3scale_net_v1beta1.NewCapabilities3ScaleNetActiveDocV1Beta1Resource,
-		//capabilities_3scale_net_v1beta1.NewCapabilities3ScaleNetApplicationV1Beta1Resource,
-		//capabilities_3scale_net_v1beta1.NewCapabilities3ScaleNetBackendV1Beta1Resource,
-		//capabilities_3scale_net_v1beta1.NewCapabilities3ScaleNetCustomPolicyDefinitionV1Beta1Resource,
-		//capabilities_3scale_net_v1beta1.NewCapabilities3ScaleNetDeveloperAccountV1Beta1Resource,
-		//capabilities_3scale_net_v1beta1.NewCapabilities3ScaleNetDeveloperUserV1Beta1Resource,
-		//capabilities_3scale_net_v1beta1.NewCapabilities3ScaleNetOpenApiV1Beta1Resource,
-		//capabilities_3scale_net_v1beta1.NewCapabilities3ScaleNetProductV1Beta1Resource,
-		//capabilities_3scale_net_v1beta1.NewCapabilities3ScaleNetProxyConfigPromoteV1Beta1Resource,
-		//capsule_clastix_io_v1alpha1.NewCapsuleClastixIoCapsuleConfigurationV1Alpha1Resource,
-		//capsule_clastix_io_v1alpha1.NewCapsuleClastixIoTenantV1Alpha1Resource,
-		//capsule_clastix_io_v1beta1.NewCapsuleClastixIoTenantV1Beta1Resource,
-		//capsule_clastix_io_v1beta2.NewCapsuleClastixIoCapsuleConfigurationV1Beta2Resource,
-		//capsule_clastix_io_v1beta2.NewCapsuleClastixIoTenantV1Beta2Resource,
-		//cassandra_datastax_com_v1beta1.NewCassandraDatastaxComCassandraDatacenterV1Beta1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephBlockPoolRadosNamespaceV1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephBlockPoolV1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephBucketNotificationV1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephBucketTopicV1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephClientV1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephClusterV1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephCosidriverV1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephFilesystemMirrorV1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephFilesystemSubVolumeGroupV1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephFilesystemV1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephNfsV1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephObjectRealmV1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephObjectStoreUserV1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephObjectStoreV1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephObjectZoneGroupV1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephObjectZoneV1Resource,
-		//ceph_rook_io_v1.NewCephRookIoCephRbdmirrorV1Resource,
-		//cert_manager_io_v1.NewCertManagerIoCertificateRequestV1Resource,
-		//cert_manager_io_v1.NewCertManagerIoCertificateV1Resource,
-		//cert_manager_io_v1.NewCertManagerIoClusterIssuerV1Resource,
-		//cert_manager_io_v1.NewCertManagerIoIssuerV1Resource,
-		//certificates_k8s_io_v1.NewCertificatesK8SIoCertificateSigningRequestV1Resource,
-		//certman_managed_openshift_io_v1alpha1.NewCertmanManagedOpenshiftIoCertificateRequestV1Alpha1Resource,
-		//chainsaw_kyverno_io_v1alpha1.NewChainsawKyvernoIoConfigurationV1Alpha1Resource,
-		//chainsaw_kyverno_io_v1alpha1.NewChainsawKyvernoIoTestV1Alpha1Resource,
-		//chainsaw_kyverno_io_v1alpha2.NewChainsawKyvernoIoConfigurationV1Alpha2Resource,
-		//chainsaw_kyverno_io_v1alpha2.NewChainsawKyvernoIoTestV1Alpha2Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgAwschaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgAzureChaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgBlockChaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgDnschaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgGcpchaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgHttpchaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgIochaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgJvmchaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgKernelChaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgNetworkChaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgPhysicalMachineChaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgPhysicalMachineV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgPodChaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgPodHttpChaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgPodIochaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgPodNetworkChaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgRemoteClusterV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgScheduleV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgStatusCheckV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgStressChaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgTimeChaosV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgWorkflowNodeV1Alpha1Resource,
-		//chaos_mesh_org_v1alpha1.NewChaosMeshOrgWorkflowV1Alpha1Resource,
-		//chaosblade_io_v1alpha1.NewChaosbladeIoChaosBladeV1Alpha1Resource,
-		//charts_amd_com_v1alpha1.NewChartsAmdComAmdgpuV1Alpha1Resource,
-		//charts_flagsmith_com_v1alpha1.NewChartsFlagsmithComFlagsmithV1Alpha1Resource,
-		//charts_helm_k8s_io_v1alpha1.NewChartsHelmK8SIoSnykMonitorV1Alpha1Resource,
-		//charts_opdev_io_v1alpha1.NewChartsOpdevIoSynapseV1Alpha1Resource,
-		//charts_operatorhub_io_v1alpha1.NewChartsOperatorhubIoCockroachdbV1Alpha1Resource,
-		//che_eclipse_org_v1alpha1.NewCheEclipseOrgKubernetesImagePullerV1Alpha1Resource,
-		//chisel_operator_io_v1.NewChiselOperatorIoExitNodeProvisionerV1Resource,
-		//chisel_operator_io_v1.NewChiselOperatorIoExitNodeV1Resource,
-		//chisel_operator_io_v2.NewChiselOperatorIoExitNodeV2Resource,
-		//cilium_io_v2.NewCiliumIoCiliumClusterwideEnvoyConfigV2Resource,
-		//cilium_io_v2.NewCiliumIoCiliumClusterwideNetworkPolicyV2Resource,
-		//cilium_io_v2.NewCiliumIoCiliumEgressGatewayPolicyV2Resource,
-		//cilium_io_v2.NewCiliumIoCiliumEnvoyConfigV2Resource,
-		//cilium_io_v2.NewCiliumIoCiliumExternalWorkloadV2Resource,
-		//cilium_io_v2.NewCiliumIoCiliumIdentityV2Resource,
-		//cilium_io_v2.NewCiliumIoCiliumLocalRedirectPolicyV2Resource,
-		//cilium_io_v2.NewCiliumIoCiliumNetworkPolicyV2Resource,
-		//cilium_io_v2.NewCiliumIoCiliumNodeV2Resource,
-		//cilium_io_v2alpha1.NewCiliumIoCiliumBgppeeringPolicyV2Alpha1Resource,
-		//cilium_io_v2alpha1.NewCiliumIoCiliumCidrgroupV2Alpha1Resource,
-		//cilium_io_v2alpha1.NewCiliumIoCiliumEndpointSliceV2Alpha1Resource,
-		//cilium_io_v2alpha1.NewCiliumIoCiliumL2AnnouncementPolicyV2Alpha1Resource,
-		//cilium_io_v2alpha1.NewCiliumIoCiliumLoadBalancerIppoolV2Alpha1Resource,
-		//cilium_io_v2alpha1.NewCiliumIoCiliumNodeConfigV2Alpha1Resource,
-		//cilium_io_v2alpha1.NewCiliumIoCiliumPodIppoolV2Alpha1Resource,
-		//claudie_io_v1beta1.NewClaudieIoInputManifestV1Beta1Resource,
-		//cloudformation_linki_space_v1alpha1.NewCloudformationLinkiSpaceStackV1Alpha1Resource,
-		//cloudfront_services_k8s_aws_v1alpha1.NewCloudfrontServicesK8SAwsCachePolicyV1Alpha1Resource,
-		//cloudfront_services_k8s_aws_v1alpha1.NewCloudfrontServicesK8SAwsDistributionV1Alpha1Resource,
-		//cloudfront_services_k8s_aws_v1alpha1.NewCloudfrontServicesK8SAwsFunctionV1Alpha1Resource,
-		//cloudfront_services_k8s_aws_v1alpha1.NewCloudfrontServicesK8SAwsOriginRequestPolicyV1Alpha1Resource,
-		//cloudfront_services_k8s_aws_v1alpha1.NewCloudfrontServicesK8SAwsResponseHeadersPolicyV1Alpha1Resource,
-		//cloudtrail_services_k8s_aws_v1alpha1.NewCloudtrailServicesK8SAwsEventDataStoreV1Alpha1Resource,
-		//cloudtrail_services_k8s_aws_v1alpha1.NewCloudtrailServicesK8SAwsTrailV1Alpha1Resource,
-		//cloudwatch_aws_amazon_com_v1alpha1.NewCloudwatchAwsAmazonComAmazonCloudWatchAgentV1Alpha1Resource,
-		//cloudwatch_aws_amazon_com_v1alpha1.NewCloudwatchAwsAmazonComInstrumentationV1Alpha1Resource,
-		//cloudwatch_services_k8s_aws_v1alpha1.NewCloudwatchServicesK8SAwsMetricAlarmV1Alpha1Resource,
-		//cloudwatchlogs_services_k8s_aws_v1alpha1.NewCloudwatchlogsServicesK8SAwsLogGroupV1Alpha1Resource,
-		//cluster_clusterpedia_io_v1alpha2.NewClusterClusterpediaIoClusterSyncResourcesV1Alpha2Resource,
-		//cluster_clusterpedia_io_v1alpha2.NewClusterClusterpediaIoPediaClusterV1Alpha2Resource,
-		//cluster_ipfs_io_v1alpha1.NewClusterIpfsIoCircuitRelayV1Alpha1Resource,
-		//cluster_ipfs_io_v1alpha1.NewClusterIpfsIoIpfsClusterV1Alpha1Resource,
-		//cluster_x_k8s_io_v1alpha3.NewClusterXK8SIoClusterV1Alpha3Resource,
-		//cluster_x_k8s_io_v1alpha3.NewClusterXK8SIoMachineDeploymentV1Alpha3Resource,
-		//cluster_x_k8s_io_v1alpha3.NewClusterXK8SIoMachineHealthCheckV1Alpha3Resource,
-		//cluster_x_k8s_io_v1alpha3.NewClusterXK8SIoMachinePoolV1Alpha3Resource,
-		//cluster_x_k8s_io_v1alpha3.NewClusterXK8SIoMachineSetV1Alpha3Resource,
-		//cluster_x_k8s_io_v1alpha3.NewClusterXK8SIoMachineV1Alpha3Resource,
-		//cluster_x_k8s_io_v1alpha4.NewClusterXK8SIoClusterClassV1Alpha4Resource,
-		//cluster_x_k8s_io_v1alpha4.NewClusterXK8SIoClusterV1Alpha4Resource,
-		//cluster_x_k8s_io_v1alpha4.NewClusterXK8SIoMachineDeploymentV1Alpha4Resource,
-		//cluster_x_k8s_io_v1alpha4.NewClusterXK8SIoMachineHealthCheckV1Alpha4Resource,
-		//cluster_x_k8s_io_v1alpha4.NewClusterXK8SIoMachinePoolV1Alpha4Resource,
-		//cluster_x_k8s_io_v1alpha4.NewClusterXK8SIoMachineSetV1Alpha4Resource,
-		//cluster_x_k8s_io_v1alpha4.NewClusterXK8SIoMachineV1Alpha4Resource,
-		//cluster_x_k8s_io_v1beta1.NewClusterXK8SIoClusterClassV1Beta1Resource,
-		//cluster_x_k8s_io_v1beta1.NewClusterXK8SIoClusterV1Beta1Resource,
-		//cluster_x_k8s_io_v1beta1.NewClusterXK8SIoMachineDeploymentV1Beta1Resource,
-		//cluster_x_k8s_io_v1beta1.NewClusterXK8SIoMachineHealthCheckV1Beta1Resource,
-		//cluster_x_k8s_io_v1beta1.NewClusterXK8SIoMachinePoolV1Beta1Resource,
-		//cluster_x_k8s_io_v1beta1.NewClusterXK8SIoMachineSetV1Beta1Resource,
-		//cluster_x_k8s_io_v1beta1.NewClusterXK8SIoMachineV1Beta1Resource,
-		//clusters_clusternet_io_v1beta1.NewClustersClusternetIoClusterRegistrationRequestV1Beta1Resource,
-		//clusters_clusternet_io_v1beta1.NewClustersClusternetIoManagedClusterV1Beta1Resource,
-		//clustertemplate_openshift_io_v1alpha1.NewClustertemplateOpenshiftIoClusterTemplateInstanceV1Alpha1Resource,
-		//clustertemplate_openshift_io_v1alpha1.NewClustertemplateOpenshiftIoClusterTemplateQuotaV1Alpha1Resource,
-		//clustertemplate_openshift_io_v1alpha1.NewClustertemplateOpenshiftIoClusterTemplateSetupV1Alpha1Resource,
-		//clustertemplate_openshift_io_v1alpha1.NewClustertemplateOpenshiftIoClusterTemplateV1Alpha1Resource,
-		//clustertemplate_openshift_io_v1alpha1.NewClustertemplateOpenshiftIoConfigV1Alpha1Resource,
-		//confidentialcontainers_org_v1beta1.NewConfidentialcontainersOrgCcRuntimeV1Beta1Resource,
-		//config_gatekeeper_sh_v1alpha1.NewConfigGatekeeperShConfigV1Alpha1Resource,
-		//config_grafana_com_v1.NewConfigGrafanaComProjectConfigV1Resource,
-		//config_karmada_io_v1alpha1.NewConfigKarmadaIoResourceInterpreterCustomizationV1Alpha1Resource,
-		//config_karmada_io_v1alpha1.NewConfigKarmadaIoResourceInterpreterWebhookConfigurationV1Alpha1Resource,
-		//config_koordinator_sh_v1alpha1.NewConfigKoordinatorShClusterColocationProfileV1Alpha1Resource,
-		//config_storageos_com_v1.NewConfigStorageosComOperatorConfigV1Resource,
-		//control_k8ssandra_io_v1alpha1.NewControlK8SsandraIoCassandraTaskV1Alpha1Resource,
-		//core_kubeadmiral_io_v1alpha1.NewCoreKubeadmiralIoClusterCollectedStatusV1Alpha1Resource,
-		//core_kubeadmiral_io_v1alpha1.NewCoreKubeadmiralIoClusterFederatedObjectV1Alpha1Resource,
-		//core_kubeadmiral_io_v1alpha1.NewCoreKubeadmiralIoClusterOverridePolicyV1Alpha1Resource,
-		//core_kubeadmiral_io_v1alpha1.NewCoreKubeadmiralIoClusterPropagationPolicyV1Alpha1Resource,
-		//core_kubeadmiral_io_v1alpha1.NewCoreKubeadmiralIoCollectedStatusV1Alpha1Resource,
-		//core_kubeadmiral_io_v1alpha1.NewCoreKubeadmiralIoFederatedClusterV1Alpha1Resource,
-		//core_kubeadmiral_io_v1alpha1.NewCoreKubeadmiralIoFederatedObjectV1Alpha1Resource,
-		//core_kubeadmiral_io_v1alpha1.NewCoreKubeadmiralIoFederatedTypeConfigV1Alpha1Resource,
-		//core_kubeadmiral_io_v1alpha1.NewCoreKubeadmiralIoOverridePolicyV1Alpha1Resource,
-		//core_kubeadmiral_io_v1alpha1.NewCoreKubeadmiralIoPropagationPolicyV1Alpha1Resource,
-		//core_kubeadmiral_io_v1alpha1.NewCoreKubeadmiralIoSchedulerPluginWebhookConfigurationV1Alpha1Resource,
-		//core_kubeadmiral_io_v1alpha1.NewCoreKubeadmiralIoSchedulingProfileV1Alpha1Resource,
-		//core_linuxsuren_github_com_v1alpha1.NewCoreLinuxsurenGithubComAtestV1Alpha1Resource,
-		//core_openfeature_dev_v1alpha1.NewCoreOpenfeatureDevFeatureFlagConfigurationV1Alpha1Resource,
-		//core_openfeature_dev_v1alpha2.NewCoreOpenfeatureDevFeatureFlagConfigurationV1Alpha2Resource,
-		//core_strimzi_io_v1beta2.NewCoreStrimziIoStrimziPodSetV1Beta2Resource,
-		//core_v1.NewConfigMapV1Resource,
-		//core_v1.NewEndpointsV1Resource,
-		//core_v1.NewLimitRangeV1Resource,
-		//core_v1.NewNamespaceV1Resource,
-		//core_v1.NewPersistentVolumeClaimV1Resource,
-		//core_v1.NewPersistentVolumeV1Resource,
-		//core_v1.NewPodV1Resource,
-		//core_v1.NewReplicationControllerV1Resource,
-		//core_v1.NewSecretV1Resource,
-		//core_v1.NewServiceAccountV1Resource,
-		//core_v1.NewServiceV1Resource,
-		//couchbase_com_v2.NewCouchbaseComCouchbaseAutoscalerV2Resource,
-		//couchbase_com_v2.NewCouchbaseComCouchbaseBackupRestoreV2Resource,
-		//couchbase_com_v2.NewCouchbaseComCouchbaseBackupV2Resource,
-		//couchbase_com_v2.NewCouchbaseComCouchbaseBucketV2Resource,
-		//couchbase_com_v2.NewCouchbaseComCouchbaseClusterV2Resource,
-		//couchbase_com_v2.NewCouchbaseComCouchbaseCollectionGroupV2Resource,
-		//couchbase_com_v2.NewCouchbaseComCouchbaseCollectionV2Resource,
-		//couchbase_com_v2.NewCouchbaseComCouchbaseEphemeralBucketV2Resource,
-		//couchbase_com_v2.NewCouchbaseComCouchbaseGroupV2Resource,
-		//couchbase_com_v2.NewCouchbaseComCouchbaseMemcachedBucketV2Resource,
-		//couchbase_com_v2.NewCouchbaseComCouchbaseMigrationReplicationV2Resource,
-		//couchbase_com_v2.NewCouchbaseComCouchbaseReplicationV2Resource,
-		//couchbase_com_v2.NewCouchbaseComCouchbaseRoleBindingV2Resource,
-		//couchbase_com_v2.NewCouchbaseComCouchbaseScopeGroupV2Resource,
-		//couchbase_com_v2.NewCouchbaseComCouchbaseScopeV2Resource,
-		//couchbase_com_v2.NewCouchbaseComCouchbaseUserV2Resource,
-		//craftypath_github_io_v1alpha1.NewCraftypathGithubIoSopsSecretV1Alpha1Resource,
-		//crane_konveyor_io_v1alpha1.NewCraneKonveyorIoOperatorConfigV1Alpha1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgBgpconfigurationV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgBgpfilterV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgBgppeerV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgBlockAffinityV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgCalicoNodeStatusV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgClusterInformationV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgFelixConfigurationV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgGlobalNetworkPolicyV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgGlobalNetworkSetV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgHostEndpointV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgIpamblockV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgIpamconfigV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgIpamhandleV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgIppoolV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgIpreservationV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgKubeControllersConfigurationV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgNetworkPolicyV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgNetworkSetV1Resource,
-		//crd_projectcalico_org_v1.NewCrdProjectcalicoOrgTierV1Resource,
-		//data_fluid_io_v1alpha1.NewDataFluidIoAlluxioRuntimeV1Alpha1Resource,
-		//data_fluid_io_v1alpha1.NewDataFluidIoDataBackupV1Alpha1Resource,
-		//data_fluid_io_v1alpha1.NewDataFluidIoDataLoadV1Alpha1Resource,
-		//data_fluid_io_v1alpha1.NewDataFluidIoDatasetV1Alpha1Resource,
-		//data_fluid_io_v1alpha1.NewDataFluidIoGooseFsruntimeV1Alpha1Resource,
-		//data_fluid_io_v1alpha1.NewDataFluidIoJindoRuntimeV1Alpha1Resource,
-		//data_fluid_io_v1alpha1.NewDataFluidIoJuiceFsruntimeV1Alpha1Resource,
-		//data_fluid_io_v1alpha1.NewDataFluidIoThinRuntimeProfileV1Alpha1Resource,
-		//data_fluid_io_v1alpha1.NewDataFluidIoThinRuntimeV1Alpha1Resource,
-		//databases_schemahero_io_v1alpha4.NewDatabasesSchemaheroIoDatabaseV1Alpha4Resource,
-		//databases_spotahome_com_v1.NewDatabasesSpotahomeComRedisFailoverV1Resource,
-		//datadoghq_com_v1alpha1.NewDatadoghqComDatadogAgentV1Alpha1Resource,
-		//datadoghq_com_v1alpha1.NewDatadoghqComDatadogMetricV1Alpha1Resource,
-		//datadoghq_com_v1alpha1.NewDatadoghqComDatadogMonitorV1Alpha1Resource,
-		//datadoghq_com_v1alpha1.NewDatadoghqComDatadogSloV1Alpha1Resource,
-		//datadoghq_com_v2alpha1.NewDatadoghqComDatadogAgentV2Alpha1Resource,
-		//dataprotection_kubeblocks_io_v1alpha1.NewDataprotectionKubeblocksIoActionSetV1Alpha1Resource,
-		//dataprotection_kubeblocks_io_v1alpha1.NewDataprotectionKubeblocksIoBackupPolicyV1Alpha1Resource,
-		//dataprotection_kubeblocks_io_v1alpha1.NewDataprotectionKubeblocksIoBackupRepoV1Alpha1Resource,
-		//dataprotection_kubeblocks_io_v1alpha1.NewDataprotectionKubeblocksIoBackupScheduleV1Alpha1Resource,
-		//dataprotection_kubeblocks_io_v1alpha1.NewDataprotectionKubeblocksIoBackupV1Alpha1Resource,
-		//dataprotection_kubeblocks_io_v1alpha1.NewDataprotectionKubeblocksIoRestoreV1Alpha1Resource,
-		//designer_kaoto_io_v1alpha1.NewDesignerKaotoIoKaotoV1Alpha1Resource,
-		//devices_kubeedge_io_v1alpha2.NewDevicesKubeedgeIoDeviceModelV1Alpha2Resource,
-		//devices_kubeedge_io_v1alpha2.NewDevicesKubeedgeIoDeviceV1Alpha2Resource,
-		//devices_kubeedge_io_v1beta1.NewDevicesKubeedgeIoDeviceModelV1Beta1Resource,
-		//devices_kubeedge_io_v1beta1.NewDevicesKubeedgeIoDeviceV1Beta1Resource,
-		//devops_kubesphere_io_v1alpha1.NewDevopsKubesphereIoReleaserControllerV1Alpha1Resource,
-		//devops_kubesphere_io_v1alpha1.NewDevopsKubesphereIoReleaserV1Alpha1Resource,
-		//dex_gpu_ninja_com_v1alpha1.NewDexGpuNinjaComDexIdentityProviderV1Alpha1Resource,
-		//dex_gpu_ninja_com_v1alpha1.NewDexGpuNinjaComDexOauth2ClientV1Alpha1Resource,
-		//dex_gpu_ninja_com_v1alpha1.NewDexGpuNinjaComDexUserV1Alpha1Resource,
-		//digitalis_io_v1.NewDigitalisIoValsSecretV1Resource,
-		//digitalis_io_v1beta1.NewDigitalisIoDbSecretV1Beta1Resource,
-		//discovery_k8s_io_v1.NewDiscoveryK8SIoEndpointSliceV1Resource,
-		//documentdb_services_k8s_aws_v1alpha1.NewDocumentdbServicesK8SAwsDbclusterV1Alpha1Resource,
-		//documentdb_services_k8s_aws_v1alpha1.NewDocumentdbServicesK8SAwsDbinstanceV1Alpha1Resource,
-		//documentdb_services_k8s_aws_v1alpha1.NewDocumentdbServicesK8SAwsDbsubnetGroupV1Alpha1Resource,
-		//druid_apache_org_v1alpha1.NewDruidApacheOrgDruidV1Alpha1Resource,
-		//druid_stackable_tech_v1alpha1.NewDruidStackableTechDruidClusterV1Alpha1Resource,
-		//dynamodb_services_k8s_aws_v1alpha1.NewDynamodbServicesK8SAwsBackupV1Alpha1Resource,
-		//dynamodb_services_k8s_aws_v1alpha1.NewDynamodbServicesK8SAwsGlobalTableV1Alpha1Resource,
-		//dynamodb_services_k8s_aws_v1alpha1.NewDynamodbServicesK8SAwsTableV1Alpha1Resource,
-		//ec2_services_k8s_aws_v1alpha1.NewEc2ServicesK8SAwsDhcpoptionsV1Alpha1Resource,
-		//ec2_services_k8s_aws_v1alpha1.NewEc2ServicesK8SAwsElasticIpaddressV1Alpha1Resource,
-		//ec2_services_k8s_aws_v1alpha1.NewEc2ServicesK8SAwsInstanceV1Alpha1Resource,
-		//ec2_services_k8s_aws_v1alpha1.NewEc2ServicesK8SAwsInternetGatewayV1Alpha1Resource,
-		//ec2_services_k8s_aws_v1alpha1.NewEc2ServicesK8SAwsNatgatewayV1Alpha1Resource,
-		//ec2_services_k8s_aws_v1alpha1.NewEc2ServicesK8SAwsRouteTableV1Alpha1Resource,
-		//ec2_services_k8s_aws_v1alpha1.NewEc2ServicesK8SAwsSecurityGroupV1Alpha1Resource,
-		//ec2_services_k8s_aws_v1alpha1.NewEc2ServicesK8SAwsSubnetV1Alpha1Resource,
-		//ec2_services_k8s_aws_v1alpha1.NewEc2ServicesK8SAwsTransitGatewayV1Alpha1Resource,
-		//ec2_services_k8s_aws_v1alpha1.NewEc2ServicesK8SAwsVpcV1Alpha1Resource,
-		//ec2_services_k8s_aws_v1alpha1.NewEc2ServicesK8SAwsVpcendpointV1Alpha1Resource,
-		//ecr_services_k8s_aws_v1alpha1.NewEcrServicesK8SAwsPullThroughCacheRuleV1Alpha1Resource,
-		//ecr_services_k8s_aws_v1alpha1.NewEcrServicesK8SAwsRepositoryV1Alpha1Resource,
-		//edc_stackable_tech_v1alpha1.NewEdcStackableTechEdcclusterV1Alpha1Resource,
-		//efs_services_k8s_aws_v1alpha1.NewEfsServicesK8SAwsAccessPointV1Alpha1Resource,
-		//efs_services_k8s_aws_v1alpha1.NewEfsServicesK8SAwsFileSystemV1Alpha1Resource,
-		//efs_services_k8s_aws_v1alpha1.NewEfsServicesK8SAwsMountTargetV1Alpha1Resource,
-		//egressgateway_spidernet_io_v1beta1.NewEgressgatewaySpidernetIoEgressClusterEndpointSliceV1Beta1Resource,
-		//egressgateway_spidernet_io_v1beta1.NewEgressgatewaySpidernetIoEgressClusterInfoV1Beta1Resource,
-		//egressgateway_spidernet_io_v1beta1.NewEgressgatewaySpidernetIoEgressClusterPolicyV1Beta1Resource,
-		//egressgateway_spidernet_io_v1beta1.NewEgressgatewaySpidernetIoEgressEndpointSliceV1Beta1Resource,
-		//egressgateway_spidernet_io_v1beta1.NewEgressgatewaySpidernetIoEgressGatewayV1Beta1Resource,
-		//egressgateway_spidernet_io_v1beta1.NewEgressgatewaySpidernetIoEgressPolicyV1Beta1Resource,
-		//egressgateway_spidernet_io_v1beta1.NewEgressgatewaySpidernetIoEgressTunnelV1Beta1Resource,
-		//eks_services_k8s_aws_v1alpha1.NewEksServicesK8SAwsAddonV1Alpha1Resource,
-		//eks_services_k8s_aws_v1alpha1.NewEksServicesK8SAwsClusterV1Alpha1Resource,
-		//eks_services_k8s_aws_v1alpha1.NewEksServicesK8SAwsFargateProfileV1Alpha1Resource,
-		//eks_services_k8s_aws_v1alpha1.NewEksServicesK8SAwsNodegroupV1Alpha1Resource,
-		//elasticache_services_k8s_aws_v1alpha1.NewElasticacheServicesK8SAwsCacheParameterGroupV1Alpha1Resource,
-		//elasticache_services_k8s_aws_v1alpha1.NewElasticacheServicesK8SAwsCacheSubnetGroupV1Alpha1Resource,
-		//elasticache_services_k8s_aws_v1alpha1.NewElasticacheServicesK8SAwsReplicationGroupV1Alpha1Resource,
-		//elasticache_services_k8s_aws_v1alpha1.NewElasticacheServicesK8SAwsSnapshotV1Alpha1Resource,
-		//elasticache_services_k8s_aws_v1alpha1.NewElasticacheServicesK8SAwsUserGroupV1Alpha1Resource,
-		//elasticache_services_k8s_aws_v1alpha1.NewElasticacheServicesK8SAwsUserV1Alpha1Resource,
-		//elasticsearch_k8s_elastic_co_v1.NewElasticsearchK8SElasticCoElasticsearchV1Resource,
-		//elasticsearch_k8s_elastic_co_v1beta1.NewElasticsearchK8SElasticCoElasticsearchV1Beta1Resource,
-		//elbv2_k8s_aws_v1alpha1.NewElbv2K8SAwsTargetGroupBindingV1Alpha1Resource,
-		//elbv2_k8s_aws_v1beta1.NewElbv2K8SAwsIngressClassParamsV1Beta1Resource,
-		//elbv2_k8s_aws_v1beta1.NewElbv2K8SAwsTargetGroupBindingV1Beta1Resource,
-		//emrcontainers_services_k8s_aws_v1alpha1.NewEmrcontainersServicesK8SAwsJobRunV1Alpha1Resource,
-		//emrcontainers_services_k8s_aws_v1alpha1.NewEmrcontainersServicesK8SAwsVirtualClusterV1Alpha1Resource,
-		//ensembleoss_io_v1.NewEnsembleossIoClusterV1Resource,
-		//ensembleoss_io_v1.NewEnsembleossIoResourceV1Resource,
-		//enterprise_gloo_solo_io_v1.NewEnterpriseGlooSoloIoAuthConfigV1Resource,
-		//enterprisesearch_k8s_elastic_co_v1.NewEnterprisesearchK8SElasticCoEnterpriseSearchV1Resource,
-		//enterprisesearch_k8s_elastic_co_v1beta1.NewEnterprisesearchK8SElasticCoEnterpriseSearchV1Beta1Resource,
-		//events_k8s_io_v1.NewEventsK8SIoEventV1Resource,
-		//everest_percona_com_v1alpha1.NewEverestPerconaComBackupStorageV1Alpha1Resource,
-		//everest_percona_com_v1alpha1.NewEverestPerconaComDatabaseClusterBackupV1Alpha1Resource,
-		//everest_percona_com_v1alpha1.NewEverestPerconaComDatabaseClusterRestoreV1Alpha1Resource,
-		//everest_percona_com_v1alpha1.NewEverestPerconaComDatabaseClusterV1Alpha1Resource,
-		//everest_percona_com_v1alpha1.NewEverestPerconaComDatabaseEngineV1Alpha1Resource,
-		//everest_percona_com_v1alpha1.NewEverestPerconaComMonitoringConfigV1Alpha1Resource,
-		//execution_furiko_io_v1alpha1.NewExecutionFurikoIoJobConfigV1Alpha1Resource,
-		//execution_furiko_io_v1alpha1.NewExecutionFurikoIoJobV1Alpha1Resource,
-		//executor_testkube_io_v1.NewExecutorTestkubeIoExecutorV1Resource,
-		//executor_testkube_io_v1.NewExecutorTestkubeIoWebhookV1Resource,
-		//expansion_gatekeeper_sh_v1alpha1.NewExpansionGatekeeperShExpansionTemplateV1Alpha1Resource,
-		//expansion_gatekeeper_sh_v1beta1.NewExpansionGatekeeperShExpansionTemplateV1Beta1Resource,
-		//extensions_istio_io_v1alpha1.NewExtensionsIstioIoWasmPluginV1Alpha1Resource,
-		//extensions_kubeblocks_io_v1alpha1.NewExtensionsKubeblocksIoAddonV1Alpha1Resource,
-		//external_secrets_io_v1alpha1.NewExternalSecretsIoClusterSecretStoreV1Alpha1Resource,
-		//external_secrets_io_v1alpha1.NewExternalSecretsIoExternalSecretV1Alpha1Resource,
-		//external_secrets_io_v1alpha1.NewExternalSecretsIoSecretStoreV1Alpha1Resource,
-		//external_secrets_io_v1beta1.NewExternalSecretsIoClusterExternalSecretV1Beta1Resource,
-		//external_secrets_io_v1beta1.NewExternalSecretsIoClusterSecretStoreV1Beta1Resource,
-		//external_secrets_io_v1beta1.NewExternalSecretsIoExternalSecretV1Beta1Resource,
-		//external_secrets_io_v1beta1.NewExternalSecretsIoSecretStoreV1Beta1Resource,
-		//externaldata_gatekeeper_sh_v1alpha1.NewExternaldataGatekeeperShProviderV1Alpha1Resource,
-		//externaldata_gatekeeper_sh_v1beta1.NewExternaldataGatekeeperShProviderV1Beta1Resource,
-		//externaldns_k8s_io_v1alpha1.NewExternaldnsK8SIoDnsendpointV1Alpha1Resource,
-		//externaldns_nginx_org_v1.NewExternaldnsNginxOrgDnsendpointV1Resource,
-		//fence_agents_remediation_medik8s_io_v1alpha1.NewFenceAgentsRemediationMedik8SIoFenceAgentsRemediationTemplateV1Alpha1Resource,
-		//fence_agents_remediation_medik8s_io_v1alpha1.NewFenceAgentsRemediationMedik8SIoFenceAgentsRemediationV1Alpha1Resource,
-		//flagger_app_v1beta1.NewFlaggerAppAlertProviderV1Beta1Resource,
-		//flagger_app_v1beta1.NewFlaggerAppCanaryV1Beta1Resource,
-		//flagger_app_v1beta1.NewFlaggerAppMetricTemplateV1Beta1Resource,
-		//flink_apache_org_v1beta1.NewFlinkApacheOrgFlinkDeploymentV1Beta1Resource,
-		//flink_apache_org_v1beta1.NewFlinkApacheOrgFlinkSessionJobV1Beta1Resource,
-		//flow_volcano_sh_v1alpha1.NewFlowVolcanoShJobFlowV1Alpha1Resource,
-		//flow_volcano_sh_v1alpha1.NewFlowVolcanoShJobTemplateV1Alpha1Resource,
-		//flowcontrol_apiserver_k8s_io_v1beta3.NewFlowcontrolApiserverK8SIoFlowSchemaV1Beta3Resource,
-		//flowcontrol_apiserver_k8s_io_v1beta3.NewFlowcontrolApiserverK8SIoPriorityLevelConfigurationV1Beta3Resource,
-		//flows_netobserv_io_v1alpha1.NewFlowsNetobservIoFlowCollectorV1Alpha1Resource,
-		//flows_netobserv_io_v1beta1.NewFlowsNetobservIoFlowCollectorV1Beta1Resource,
-		//flows_netobserv_io_v1beta2.NewFlowsNetobservIoFlowCollectorV1Beta2Resource,
-		//fluentbit_fluent_io_v1alpha2.NewFluentbitFluentIoClusterFilterV1Alpha2Resource,
-		//fluentbit_fluent_io_v1alpha2.NewFluentbitFluentIoClusterFluentBitConfigV1Alpha2Resource,
-		//fluentbit_fluent_io_v1alpha2.NewFluentbitFluentIoClusterInputV1Alpha2Resource,
-		//fluentbit_fluent_io_v1alpha2.NewFluentbitFluentIoClusterOutputV1Alpha2Resource,
-		//fluentbit_fluent_io_v1alpha2.NewFluentbitFluentIoClusterParserV1Alpha2Resource,
-		//fluentbit_fluent_io_v1alpha2.NewFluentbitFluentIoCollectorV1Alpha2Resource,
-		//fluentbit_fluent_io_v1alpha2.NewFluentbitFluentIoFilterV1Alpha2Resource,
-		//fluentbit_fluent_io_v1alpha2.NewFluentbitFluentIoFluentBitConfigV1Alpha2Resource,
-		//fluentbit_fluent_io_v1alpha2.NewFluentbitFluentIoFluentBitV1Alpha2Resource,
-		//fluentbit_fluent_io_v1alpha2.NewFluentbitFluentIoOutputV1Alpha2Resource,
-		//fluentbit_fluent_io_v1alpha2.NewFluentbitFluentIoParserV1Alpha2Resource,
-		//fluentd_fluent_io_v1alpha1.NewFluentdFluentIoClusterFilterV1Alpha1Resource,
-		//fluentd_fluent_io_v1alpha1.NewFluentdFluentIoClusterFluentdConfigV1Alpha1Resource,
-		//fluentd_fluent_io_v1alpha1.NewFluentdFluentIoClusterInputV1Alpha1Resource,
-		//fluentd_fluent_io_v1alpha1.NewFluentdFluentIoClusterOutputV1Alpha1Resource,
-		//fluentd_fluent_io_v1alpha1.NewFluentdFluentIoFilterV1Alpha1Resource,
-		//fluentd_fluent_io_v1alpha1.NewFluentdFluentIoFluentdConfigV1Alpha1Resource,
-		//fluentd_fluent_io_v1alpha1.NewFluentdFluentIoFluentdV1Alpha1Resource,
-		//fluentd_fluent_io_v1alpha1.NewFluentdFluentIoInputV1Alpha1Resource,
-		//fluentd_fluent_io_v1alpha1.NewFluentdFluentIoOutputV1Alpha1Resource,
-		//flux_framework_org_v1alpha1.NewFluxFrameworkOrgMiniClusterV1Alpha1Resource,
-		//flux_framework_org_v1alpha2.NewFluxFrameworkOrgMiniClusterV1Alpha2Resource,
-		//forklift_konveyor_io_v1beta1.NewForkliftKonveyorIoForkliftControllerV1Beta1Resource,
-		//forklift_konveyor_io_v1beta1.NewForkliftKonveyorIoHookV1Beta1Resource,
-		//forklift_konveyor_io_v1beta1.NewForkliftKonveyorIoHostV1Beta1Resource,
-		//forklift_konveyor_io_v1beta1.NewForkliftKonveyorIoMigrationV1Beta1Resource,
-		//forklift_konveyor_io_v1beta1.NewForkliftKonveyorIoNetworkMapV1Beta1Resource,
-		//forklift_konveyor_io_v1beta1.NewForkliftKonveyorIoOpenstackVolumePopulatorV1Beta1Resource,
-		//forklift_konveyor_io_v1beta1.NewForkliftKonveyorIoOvirtVolumePopulatorV1Beta1Resource,
-		//forklift_konveyor_io_v1beta1.NewForkliftKonveyorIoPlanV1Beta1Resource,
-		//forklift_konveyor_io_v1beta1.NewForkliftKonveyorIoProviderV1Beta1Resource,
-		//forklift_konveyor_io_v1beta1.NewForkliftKonveyorIoStorageMapV1Beta1Resource,
-		//fossul_io_v1.NewFossulIoBackupConfigV1Resource,
-		//fossul_io_v1.NewFossulIoBackupScheduleV1Resource,
-		//fossul_io_v1.NewFossulIoBackupV1Resource,
-		//fossul_io_v1.NewFossulIoFossulV1Resource,
-		//fossul_io_v1.NewFossulIoRestoreV1Resource,
-		//gateway_networking_k8s_io_v1.NewGatewayNetworkingK8SIoGatewayClassV1Resource,
-		//gateway_networking_k8s_io_v1.NewGatewayNetworkingK8SIoGatewayV1Resource,
-		//gateway_networking_k8s_io_v1.NewGatewayNetworkingK8SIoGrpcrouteV1Resource,
-		//gateway_networking_k8s_io_v1.NewGatewayNetworkingK8SIoHttprouteV1Resource,
-		//gateway_networking_k8s_io_v1alpha2.NewGatewayNetworkingK8SIoBackendLbpolicyV1Alpha2Resource,
-		//gateway_networking_k8s_io_v1alpha2.NewGatewayNetworkingK8SIoGrpcrouteV1Alpha2Resource,
-		//gateway_networking_k8s_io_v1alpha2.NewGatewayNetworkingK8SIoReferenceGrantV1Alpha2Resource,
-		//gateway_networking_k8s_io_v1alpha2.NewGatewayNetworkingK8SIoTcprouteV1Alpha2Resource,
-		//gateway_networking_k8s_io_v1alpha2.NewGatewayNetworkingK8SIoTlsrouteV1Alpha2Resource,
-		//gateway_networking_k8s_io_v1alpha2.NewGatewayNetworkingK8SIoUdprouteV1Alpha2Resource,
-		//gateway_networking_k8s_io_v1alpha3.NewGatewayNetworkingK8SIoBackendTlspolicyV1Alpha3Resource,
-		//gateway_networking_k8s_io_v1beta1.NewGatewayNetworkingK8SIoGatewayClassV1Beta1Resource,
-		//gateway_networking_k8s_io_v1beta1.NewGatewayNetworkingK8SIoGatewayV1Beta1Resource,
-		//gateway_networking_k8s_io_v1beta1.NewGatewayNetworkingK8SIoHttprouteV1Beta1Resource,
-		//gateway_networking_k8s_io_v1beta1.NewGatewayNetworkingK8SIoReferenceGrantV1Beta1Resource,
-		//gateway_nginx_org_v1alpha1.NewGatewayNginxOrgClientSettingsPolicyV1Alpha1Resource,
-		//gateway_nginx_org_v1alpha1.NewGatewayNginxOrgNginxGatewayV1Alpha1Resource,
-		//gateway_nginx_org_v1alpha1.NewGatewayNginxOrgNginxProxyV1Alpha1Resource,
-		//gateway_nginx_org_v1alpha1.NewGatewayNginxOrgObservabilityPolicyV1Alpha1Resource,
-		//gateway_solo_io_v1.NewGatewaySoloIoGatewayV1Resource,
-		//gateway_solo_io_v1.NewGatewaySoloIoMatchableHttpGatewayV1Resource,
-		//gateway_solo_io_v1.NewGatewaySoloIoRouteOptionV1Resource,
-		//gateway_solo_io_v1.NewGatewaySoloIoRouteTableV1Resource,
-		//gateway_solo_io_v1.NewGatewaySoloIoVirtualHostOptionV1Resource,
-		//gateway_solo_io_v1.NewGatewaySoloIoVirtualServiceV1Resource,
-		//getambassador_io_v1.NewGetambassadorIoAuthServiceV1Resource,
-		//getambassador_io_v1.NewGetambassadorIoConsulResolverV1Resource,
-		//getambassador_io_v1.NewGetambassadorIoDevPortalV1Resource,
-		//getambassador_io_v1.NewGetambassadorIoKubernetesEndpointResolverV1Resource,
-		//getambassador_io_v1.NewGetambassadorIoKubernetesServiceResolverV1Resource,
-		//getambassador_io_v1.NewGetambassadorIoLogServiceV1Resource,
-		//getambassador_io_v1.NewGetambassadorIoMappingV1Resource,
-		//getambassador_io_v1.NewGetambassadorIoModuleV1Resource,
-		//getambassador_io_v1.NewGetambassadorIoRateLimitServiceV1Resource,
-		//getambassador_io_v1.NewGetambassadorIoTcpmappingV1Resource,
-		//getambassador_io_v1.NewGetambassadorIoTlscontextV1Resource,
-		//getambassador_io_v1.NewGetambassadorIoTracingServiceV1Resource,
-		//getambassador_io_v2.NewGetambassadorIoAuthServiceV2Resource,
-		//getambassador_io_v2.NewGetambassadorIoConsulResolverV2Resource,
-		//getambassador_io_v2.NewGetambassadorIoDevPortalV2Resource,
-		//getambassador_io_v2.NewGetambassadorIoHostV2Resource,
-		//getambassador_io_v2.NewGetambassadorIoKubernetesEndpointResolverV2Resource,
-		//getambassador_io_v2.NewGetambassadorIoKubernetesServiceResolverV2Resource,
-		//getambassador_io_v2.NewGetambassadorIoLogServiceV2Resource,
-		//getambassador_io_v2.NewGetambassadorIoMappingV2Resource,
-		//getambassador_io_v2.NewGetambassadorIoModuleV2Resource,
-		//getambassador_io_v2.NewGetambassadorIoRateLimitServiceV2Resource,
-		//getambassador_io_v2.NewGetambassadorIoTcpmappingV2Resource,
-		//getambassador_io_v2.NewGetambassadorIoTlscontextV2Resource,
-		//getambassador_io_v2.NewGetambassadorIoTracingServiceV2Resource,
-		//getambassador_io_v3alpha1.NewGetambassadorIoAuthServiceV3Alpha1Resource,
-		//getambassador_io_v3alpha1.NewGetambassadorIoConsulResolverV3Alpha1Resource,
-		//getambassador_io_v3alpha1.NewGetambassadorIoDevPortalV3Alpha1Resource,
-		//getambassador_io_v3alpha1.NewGetambassadorIoHostV3Alpha1Resource,
-		//getambassador_io_v3alpha1.NewGetambassadorIoKubernetesEndpointResolverV3Alpha1Resource,
-		//getambassador_io_v3alpha1.NewGetambassadorIoKubernetesServiceResolverV3Alpha1Resource,
-		//getambassador_io_v3alpha1.NewGetambassadorIoListenerV3Alpha1Resource,
-		//getambassador_io_v3alpha1.NewGetambassadorIoLogServiceV3Alpha1Resource,
-		//getambassador_io_v3alpha1.NewGetambassadorIoMappingV3Alpha1Resource,
-		//getambassador_io_v3alpha1.NewGetambassadorIoModuleV3Alpha1Resource,
-		//getambassador_io_v3alpha1.NewGetambassadorIoRateLimitServiceV3Alpha1Resource,
-		//getambassador_io_v3alpha1.NewGetambassadorIoTcpmappingV3Alpha1Resource,
-		//getambassador_io_v3alpha1.NewGetambassadorIoTlscontextV3Alpha1Resource,
-		//getambassador_io_v3alpha1.NewGetambassadorIoTracingServiceV3Alpha1Resource,
-		//gitops_hybrid_cloud_patterns_io_v1alpha1.NewGitopsHybridCloudPatternsIoPatternV1Alpha1Resource,
-		//gloo_solo_io_v1.NewGlooSoloIoProxyV1Resource,
-		//gloo_solo_io_v1.NewGlooSoloIoSettingsV1Resource,
-		//gloo_solo_io_v1.NewGlooSoloIoUpstreamGroupV1Resource,
-		//gloo_solo_io_v1.NewGlooSoloIoUpstreamV1Resource,
-		//grafana_integreatly_org_v1beta1.NewGrafanaIntegreatlyOrgGrafanaDashboardV1Beta1Resource,
-		//grafana_integreatly_org_v1beta1.NewGrafanaIntegreatlyOrgGrafanaDatasourceV1Beta1Resource,
-		//grafana_integreatly_org_v1beta1.NewGrafanaIntegreatlyOrgGrafanaFolderV1Beta1Resource,
-		//grafana_integreatly_org_v1beta1.NewGrafanaIntegreatlyOrgGrafanaV1Beta1Resource,
-		//graphql_gloo_solo_io_v1beta1.NewGraphqlGlooSoloIoGraphQlapiV1Beta1Resource,
-		//groupsnapshot_storage_k8s_io_v1alpha1.NewGroupsnapshotStorageK8SIoVolumeGroupSnapshotClassV1Alpha1Resource,
-		//groupsnapshot_storage_k8s_io_v1alpha1.NewGroupsnapshotStorageK8SIoVolumeGroupSnapshotContentV1Alpha1Resource,
-		//groupsnapshot_storage_k8s_io_v1alpha1.NewGroupsnapshotStorageK8SIoVolumeGroupSnapshotV1Alpha1Resource,
-		//hazelcast_com_v1alpha1.NewHazelcastComCronHotBackupV1Alpha1Resource,
-		//hazelcast_com_v1alpha1.NewHazelcastComHazelcastV1Alpha1Resource,
-		//hazelcast_com_v1alpha1.NewHazelcastComHotBackupV1Alpha1Resource,
-		//hazelcast_com_v1alpha1.NewHazelcastComManagementCenterV1Alpha1Resource,
-		//hazelcast_com_v1alpha1.NewHazelcastComMapV1Alpha1Resource,
-		//hazelcast_com_v1alpha1.NewHazelcastComWanReplicationV1Alpha1Resource,
-		//hbase_stackable_tech_v1alpha1.NewHbaseStackableTechHbaseClusterV1Alpha1Resource,
-		//hdfs_stackable_tech_v1alpha1.NewHdfsStackableTechHdfsClusterV1Alpha1Resource,
-		//helm_sigstore_dev_v1alpha1.NewHelmSigstoreDevRekorV1Alpha1Resource,
-		//helm_toolkit_fluxcd_io_v2.NewHelmToolkitFluxcdIoHelmReleaseV2Resource,
-		//helm_toolkit_fluxcd_io_v2beta1.NewHelmToolkitFluxcdIoHelmReleaseV2Beta1Resource,
-		//helm_toolkit_fluxcd_io_v2beta2.NewHelmToolkitFluxcdIoHelmReleaseV2Beta2Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoCheckpointV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoClusterClaimV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoClusterDeploymentCustomizationV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoClusterDeploymentV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoClusterDeprovisionV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoClusterImageSetV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoClusterPoolV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoClusterProvisionV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoClusterRelocateV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoClusterStateV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoDnszoneV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoHiveConfigV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoMachinePoolNameLeaseV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoMachinePoolV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoSelectorSyncIdentityProviderV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoSelectorSyncSetV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoSyncIdentityProviderV1Resource,
-		//hive_openshift_io_v1.NewHiveOpenshiftIoSyncSetV1Resource,
-		//hive_stackable_tech_v1alpha1.NewHiveStackableTechHiveClusterV1Alpha1Resource,
-		//hiveinternal_openshift_io_v1alpha1.NewHiveinternalOpenshiftIoClusterSyncLeaseV1Alpha1Resource,
-		//hiveinternal_openshift_io_v1alpha1.NewHiveinternalOpenshiftIoClusterSyncV1Alpha1Resource,
-		//hiveinternal_openshift_io_v1alpha1.NewHiveinternalOpenshiftIoFakeClusterInstallV1Alpha1Resource,
-		//hnc_x_k8s_io_v1alpha2.NewHncXK8SIoHierarchicalResourceQuotaV1Alpha2Resource,
-		//hnc_x_k8s_io_v1alpha2.NewHncXK8SIoHierarchyConfigurationV1Alpha2Resource,
-		//hnc_x_k8s_io_v1alpha2.NewHncXK8SIoHncconfigurationV1Alpha2Resource,
-		//hnc_x_k8s_io_v1alpha2.NewHncXK8SIoSubnamespaceAnchorV1Alpha2Resource,
-		//hyperfoil_io_v1alpha1.NewHyperfoilIoHorreumV1Alpha1Resource,
-		//hyperfoil_io_v1alpha2.NewHyperfoilIoHyperfoilV1Alpha2Resource,
-		//iam_services_k8s_aws_v1alpha1.NewIamServicesK8SAwsGroupV1Alpha1Resource,
-		//iam_services_k8s_aws_v1alpha1.NewIamServicesK8SAwsInstanceProfileV1Alpha1Resource,
-		//iam_services_k8s_aws_v1alpha1.NewIamServicesK8SAwsOpenIdconnectProviderV1Alpha1Resource,
-		//iam_services_k8s_aws_v1alpha1.NewIamServicesK8SAwsPolicyV1Alpha1Resource,
-		//iam_services_k8s_aws_v1alpha1.NewIamServicesK8SAwsRoleV1Alpha1Resource,
-		//iam_services_k8s_aws_v1alpha1.NewIamServicesK8SAwsUserV1Alpha1Resource,
-		//ibmcloud_ibm_com_v1alpha1.NewIbmcloudIbmComComposableV1Alpha1Resource,
-		//image_toolkit_fluxcd_io_v1beta1.NewImageToolkitFluxcdIoImagePolicyV1Beta1Resource,
-		//image_toolkit_fluxcd_io_v1beta1.NewImageToolkitFluxcdIoImageRepositoryV1Beta1Resource,
-		//image_toolkit_fluxcd_io_v1beta1.NewImageToolkitFluxcdIoImageUpdateAutomationV1Beta1Resource,
-		//image_toolkit_fluxcd_io_v1beta2.NewImageToolkitFluxcdIoImagePolicyV1Beta2Resource,
-		//image_toolkit_fluxcd_io_v1beta2.NewImageToolkitFluxcdIoImageRepositoryV1Beta2Resource,
-		//image_toolkit_fluxcd_io_v1beta2.NewImageToolkitFluxcdIoImageUpdateAutomationV1Beta2Resource,
-		//imaging_ingestion_alvearie_org_v1alpha1.NewImagingIngestionAlvearieOrgDicomEventBridgeV1Alpha1Resource,
-		//imaging_ingestion_alvearie_org_v1alpha1.NewImagingIngestionAlvearieOrgDicomEventDrivenIngestionV1Alpha1Resource,
-		//imaging_ingestion_alvearie_org_v1alpha1.NewImagingIngestionAlvearieOrgDicomInstanceBindingV1Alpha1Resource,
-		//imaging_ingestion_alvearie_org_v1alpha1.NewImagingIngestionAlvearieOrgDicomStudyBindingV1Alpha1Resource,
-		//imaging_ingestion_alvearie_org_v1alpha1.NewImagingIngestionAlvearieOrgDicomwebIngestionServiceV1Alpha1Resource,
-		//imaging_ingestion_alvearie_org_v1alpha1.NewImagingIngestionAlvearieOrgDimseIngestionServiceV1Alpha1Resource,
-		//imaging_ingestion_alvearie_org_v1alpha1.NewImagingIngestionAlvearieOrgDimseProxyV1Alpha1Resource,
-		//inference_kubedl_io_v1alpha1.NewInferenceKubedlIoElasticBatchJobV1Alpha1Resource,
-		//infinispan_org_v1.NewInfinispanOrgInfinispanV1Resource,
-		//infinispan_org_v2alpha1.NewInfinispanOrgBackupV2Alpha1Resource,
-		//infinispan_org_v2alpha1.NewInfinispanOrgBatchV2Alpha1Resource,
-		//infinispan_org_v2alpha1.NewInfinispanOrgCacheV2Alpha1Resource,
-		//infinispan_org_v2alpha1.NewInfinispanOrgRestoreV2Alpha1Resource,
-		//infra_contrib_fluxcd_io_v1alpha1.NewInfraContribFluxcdIoTerraformV1Alpha1Resource,
-		//infra_contrib_fluxcd_io_v1alpha2.NewInfraContribFluxcdIoTerraformV1Alpha2Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha1.NewInfrastructureClusterXK8SIoKubevirtClusterTemplateV1Alpha1Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha1.NewInfrastructureClusterXK8SIoKubevirtClusterV1Alpha1Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha1.NewInfrastructureClusterXK8SIoKubevirtMachineTemplateV1Alpha1Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha1.NewInfrastructureClusterXK8SIoKubevirtMachineV1Alpha1Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha3.NewInfrastructureClusterXK8SIoVsphereClusterIdentityV1Alpha3Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha3.NewInfrastructureClusterXK8SIoVsphereClusterV1Alpha3Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha3.NewInfrastructureClusterXK8SIoVsphereDeploymentZoneV1Alpha3Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha3.NewInfrastructureClusterXK8SIoVsphereFailureDomainV1Alpha3Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha3.NewInfrastructureClusterXK8SIoVsphereMachineTemplateV1Alpha3Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha3.NewInfrastructureClusterXK8SIoVsphereMachineV1Alpha3Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha3.NewInfrastructureClusterXK8SIoVsphereVmV1Alpha3Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha4.NewInfrastructureClusterXK8SIoVsphereClusterIdentityV1Alpha4Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha4.NewInfrastructureClusterXK8SIoVsphereClusterTemplateV1Alpha4Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha4.NewInfrastructureClusterXK8SIoVsphereClusterV1Alpha4Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha4.NewInfrastructureClusterXK8SIoVsphereDeploymentZoneV1Alpha4Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha4.NewInfrastructureClusterXK8SIoVsphereFailureDomainV1Alpha4Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha4.NewInfrastructureClusterXK8SIoVsphereMachineTemplateV1Alpha4Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha4.NewInfrastructureClusterXK8SIoVsphereMachineV1Alpha4Resource,
-		//infrastructure_cluster_x_k8s_io_v1alpha4.NewInfrastructureClusterXK8SIoVsphereVmV1Alpha4Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoIbmpowerVsclusterTemplateV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoIbmpowerVsclusterV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoIbmpowerVsimageV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoIbmpowerVsmachineTemplateV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoIbmpowerVsmachineV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoIbmvpcclusterV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoIbmvpcmachineTemplateV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoIbmvpcmachineV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoTinkerbellClusterV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoTinkerbellMachineTemplateV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoTinkerbellMachineV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoVsphereClusterIdentityV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoVsphereClusterTemplateV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoVsphereClusterV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoVsphereDeploymentZoneV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoVsphereFailureDomainV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoVsphereMachineTemplateV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoVsphereMachineV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta1.NewInfrastructureClusterXK8SIoVsphereVmV1Beta1Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta2.NewInfrastructureClusterXK8SIoIbmpowerVsclusterTemplateV1Beta2Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta2.NewInfrastructureClusterXK8SIoIbmpowerVsclusterV1Beta2Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta2.NewInfrastructureClusterXK8SIoIbmpowerVsimageV1Beta2Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta2.NewInfrastructureClusterXK8SIoIbmpowerVsmachineTemplateV1Beta2Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta2.NewInfrastructureClusterXK8SIoIbmpowerVsmachineV1Beta2Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta2.NewInfrastructureClusterXK8SIoIbmvpcclusterV1Beta2Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta2.NewInfrastructureClusterXK8SIoIbmvpcmachineTemplateV1Beta2Resource,
-		//infrastructure_cluster_x_k8s_io_v1beta2.NewInfrastructureClusterXK8SIoIbmvpcmachineV1Beta2Resource,
-		//installation_mattermost_com_v1beta1.NewInstallationMattermostComMattermostV1Beta1Resource,
-		//instana_io_v1.NewInstanaIoInstanaAgentV1Resource,
-		//integration_rock8s_com_v1beta1.NewIntegrationRock8SComDeferredResourceV1Beta1Resource,
-		//integration_rock8s_com_v1beta1.NewIntegrationRock8SComPlugV1Beta1Resource,
-		//integration_rock8s_com_v1beta1.NewIntegrationRock8SComSocketV1Beta1Resource,
-		//iot_eclipse_org_v1alpha1.NewIotEclipseOrgDittoV1Alpha1Resource,
-		//iot_eclipse_org_v1alpha1.NewIotEclipseOrgHawkbitV1Alpha1Resource,
-		//ipam_cluster_x_k8s_io_v1alpha1.NewIpamClusterXK8SIoIpaddressClaimV1Alpha1Resource,
-		//ipam_cluster_x_k8s_io_v1alpha1.NewIpamClusterXK8SIoIpaddressV1Alpha1Resource,
-		//ipam_cluster_x_k8s_io_v1beta1.NewIpamClusterXK8SIoIpaddressClaimV1Beta1Resource,
-		//ipam_cluster_x_k8s_io_v1beta1.NewIpamClusterXK8SIoIpaddressV1Beta1Resource,
-		//isindir_github_com_v1alpha1.NewIsindirGithubComSopsSecretV1Alpha1Resource,
-		//isindir_github_com_v1alpha2.NewIsindirGithubComSopsSecretV1Alpha2Resource,
-		//isindir_github_com_v1alpha3.NewIsindirGithubComSopsSecretV1Alpha3Resource,
-		//jaegertracing_io_v1.NewJaegertracingIoJaegerV1Resource,
-		//jobset_x_k8s_io_v1alpha2.NewJobsetXK8SIoJobSetV1Alpha2Resource,
-		//jobsmanager_raczylo_com_v1beta1.NewJobsmanagerRaczyloComManagedJobV1Beta1Resource,
-		//k6_io_v1alpha1.NewK6IoK6V1Alpha1Resource,
-		//k6_io_v1alpha1.NewK6IoPrivateLoadZoneV1Alpha1Resource,
-		//k6_io_v1alpha1.NewK6IoTestRunV1Alpha1Resource,
-		//k8gb_absa_oss_v1beta1.NewK8GbAbsaOssGslbV1Beta1Resource,
-		//k8s_keycloak_org_v2alpha1.NewK8SKeycloakOrgKeycloakRealmImportV2Alpha1Resource,
-		//k8s_keycloak_org_v2alpha1.NewK8SKeycloakOrgKeycloakV2Alpha1Resource,
-		//k8s_mariadb_com_v1alpha1.NewK8SMariadbComBackupV1Alpha1Resource,
-		//k8s_mariadb_com_v1alpha1.NewK8SMariadbComConnectionV1Alpha1Resource,
-		//k8s_mariadb_com_v1alpha1.NewK8SMariadbComDatabaseV1Alpha1Resource,
-		//k8s_mariadb_com_v1alpha1.NewK8SMariadbComGrantV1Alpha1Resource,
-		//k8s_mariadb_com_v1alpha1.NewK8SMariadbComMariaDbV1Alpha1Resource,
-		//k8s_mariadb_com_v1alpha1.NewK8SMariadbComMaxScaleV1Alpha1Resource,
-		//k8s_mariadb_com_v1alpha1.NewK8SMariadbComRestoreV1Alpha1Resource,
-		//k8s_mariadb_com_v1alpha1.NewK8SMariadbComSqlJobV1Alpha1Resource,
-		//k8s_mariadb_com_v1alpha1.NewK8SMariadbComUserV1Alpha1Resource,
-		//k8s_nginx_org_v1.NewK8SNginxOrgGlobalConfigurationV1Resource,
-		//k8s_nginx_org_v1.NewK8SNginxOrgPolicyV1Resource,
-		//k8s_nginx_org_v1.NewK8SNginxOrgTransportServerV1Resource,
-		//k8s_nginx_org_v1.NewK8SNginxOrgVirtualServerRouteV1Resource,
-		//k8s_nginx_org_v1.NewK8SNginxOrgVirtualServerV1Resource,
-		//k8s_nginx_org_v1alpha1.NewK8SNginxOrgGlobalConfigurationV1Alpha1Resource,
-		//k8s_nginx_org_v1alpha1.NewK8SNginxOrgPolicyV1Alpha1Resource,
-		//k8s_nginx_org_v1alpha1.NewK8SNginxOrgTransportServerV1Alpha1Resource,
-		//k8s_otterize_com_v1alpha2.NewK8SOtterizeComClientIntentsV1Alpha2Resource,
-		//k8s_otterize_com_v1alpha2.NewK8SOtterizeComKafkaServerConfigV1Alpha2Resource,
-		//k8s_otterize_com_v1alpha2.NewK8SOtterizeComProtectedServiceV1Alpha2Resource,
-		//k8s_otterize_com_v1alpha3.NewK8SOtterizeComClientIntentsV1Alpha3Resource,
-		//k8s_otterize_com_v1alpha3.NewK8SOtterizeComKafkaServerConfigV1Alpha3Resource,
-		//k8s_otterize_com_v1alpha3.NewK8SOtterizeComProtectedServiceV1Alpha3Resource,
-		//k8up_io_v1.NewK8UpIoArchiveV1Resource,
-		//k8up_io_v1.NewK8UpIoBackupV1Resource,
-		//k8up_io_v1.NewK8UpIoCheckV1Resource,
-		//k8up_io_v1.NewK8UpIoPreBackupPodV1Resource,
-		//k8up_io_v1.NewK8UpIoPruneV1Resource,
-		//k8up_io_v1.NewK8UpIoRestoreV1Resource,
-		//k8up_io_v1.NewK8UpIoScheduleV1Resource,
-		//k8up_io_v1.NewK8UpIoSnapshotV1Resource,
-		//kafka_banzaicloud_io_v1alpha1.NewKafkaBanzaicloudIoCruiseControlOperationV1Alpha1Resource,
-		//kafka_banzaicloud_io_v1alpha1.NewKafkaBanzaicloudIoKafkaTopicV1Alpha1Resource,
-		//kafka_banzaicloud_io_v1alpha1.NewKafkaBanzaicloudIoKafkaUserV1Alpha1Resource,
-		//kafka_banzaicloud_io_v1beta1.NewKafkaBanzaicloudIoKafkaClusterV1Beta1Resource,
-		//kafka_services_k8s_aws_v1alpha1.NewKafkaServicesK8SAwsClusterV1Alpha1Resource,
-		//kafka_stackable_tech_v1alpha1.NewKafkaStackableTechKafkaClusterV1Alpha1Resource,
-		//kafka_strimzi_io_v1alpha1.NewKafkaStrimziIoKafkaTopicV1Alpha1Resource,
-		//kafka_strimzi_io_v1alpha1.NewKafkaStrimziIoKafkaUserV1Alpha1Resource,
-		//kafka_strimzi_io_v1beta1.NewKafkaStrimziIoKafkaTopicV1Beta1Resource,
-		//kafka_strimzi_io_v1beta1.NewKafkaStrimziIoKafkaUserV1Beta1Resource,
-		//kafka_strimzi_io_v1beta2.NewKafkaStrimziIoKafkaBridgeV1Beta2Resource,
-		//kafka_strimzi_io_v1beta2.NewKafkaStrimziIoKafkaConnectV1Beta2Resource,
-		//kafka_strimzi_io_v1beta2.NewKafkaStrimziIoKafkaConnectorV1Beta2Resource,
-		//kafka_strimzi_io_v1beta2.NewKafkaStrimziIoKafkaMirrorMaker2V1Beta2Resource,
-		//kafka_strimzi_io_v1beta2.NewKafkaStrimziIoKafkaMirrorMakerV1Beta2Resource,
-		//kafka_strimzi_io_v1beta2.NewKafkaStrimziIoKafkaNodePoolV1Beta2Resource,
-		//kafka_strimzi_io_v1beta2.NewKafkaStrimziIoKafkaRebalanceV1Beta2Resource,
-		//kafka_strimzi_io_v1beta2.NewKafkaStrimziIoKafkaTopicV1Beta2Resource,
-		//kafka_strimzi_io_v1beta2.NewKafkaStrimziIoKafkaUserV1Beta2Resource,
-		//kafka_strimzi_io_v1beta2.NewKafkaStrimziIoKafkaV1Beta2Resource,
-		//kamaji_clastix_io_v1alpha1.NewKamajiClastixIoDataStoreV1Alpha1Resource,
-		//kamaji_clastix_io_v1alpha1.NewKamajiClastixIoTenantControlPlaneV1Alpha1Resource,
-		//karpenter_k8s_aws_v1.NewKarpenterK8SAwsEc2NodeClassV1Resource,
-		//karpenter_k8s_aws_v1beta1.NewKarpenterK8SAwsEc2NodeClassV1Beta1Resource,
-		//karpenter_sh_v1.NewKarpenterShNodeClaimV1Resource,
-		//karpenter_sh_v1.NewKarpenterShNodePoolV1Resource,
-		//karpenter_sh_v1beta1.NewKarpenterShNodeClaimV1Beta1Resource,
-		//karpenter_sh_v1beta1.NewKarpenterShNodePoolV1Beta1Resource,
-		//keda_sh_v1alpha1.NewKedaShClusterTriggerAuthenticationV1Alpha1Resource,
-		//keda_sh_v1alpha1.NewKedaShScaledJobV1Alpha1Resource,
-		//keda_sh_v1alpha1.NewKedaShScaledObjectV1Alpha1Resource,
-		//keda_sh_v1alpha1.NewKedaShTriggerAuthenticationV1Alpha1Resource,
-		//keycloak_k8s_reddec_net_v1alpha1.NewKeycloakK8SReddecNetKeycloakClientV1Alpha1Resource,
-		//keycloak_org_v1alpha1.NewKeycloakOrgKeycloakBackupV1Alpha1Resource,
-		//keycloak_org_v1alpha1.NewKeycloakOrgKeycloakClientV1Alpha1Resource,
-		//keycloak_org_v1alpha1.NewKeycloakOrgKeycloakRealmV1Alpha1Resource,
-		//keycloak_org_v1alpha1.NewKeycloakOrgKeycloakUserV1Alpha1Resource,
-		//keycloak_org_v1alpha1.NewKeycloakOrgKeycloakV1Alpha1Resource,
-		//keyspaces_services_k8s_aws_v1alpha1.NewKeyspacesServicesK8SAwsKeyspaceV1Alpha1Resource,
-		//keyspaces_services_k8s_aws_v1alpha1.NewKeyspacesServicesK8SAwsTableV1Alpha1Resource,
-		//kiali_io_v1alpha1.NewKialiIoKialiV1Alpha1Resource,
-		//kibana_k8s_elastic_co_v1.NewKibanaK8SElasticCoKibanaV1Resource,
-		//kibana_k8s_elastic_co_v1beta1.NewKibanaK8SElasticCoKibanaV1Beta1Resource,
-		//kinesis_services_k8s_aws_v1alpha1.NewKinesisServicesK8SAwsStreamV1Alpha1Resource,
-		//kmm_sigs_x_k8s_io_v1beta1.NewKmmSigsXK8SIoModuleV1Beta1Resource,
-		//kmm_sigs_x_k8s_io_v1beta1.NewKmmSigsXK8SIoNodeModulesConfigV1Beta1Resource,
-		//kmm_sigs_x_k8s_io_v1beta1.NewKmmSigsXK8SIoPreflightValidationV1Beta1Resource,
-		//kmm_sigs_x_k8s_io_v1beta2.NewKmmSigsXK8SIoPreflightValidationV1Beta2Resource,
-		//kms_services_k8s_aws_v1alpha1.NewKmsServicesK8SAwsAliasV1Alpha1Resource,
-		//kms_services_k8s_aws_v1alpha1.NewKmsServicesK8SAwsGrantV1Alpha1Resource,
-		//kms_services_k8s_aws_v1alpha1.NewKmsServicesK8SAwsKeyV1Alpha1Resource,
-		//kuadrant_io_v1alpha1.NewKuadrantIoDnsrecordV1Alpha1Resource,
-		//kuadrant_io_v1alpha1.NewKuadrantIoManagedZoneV1Alpha1Resource,
-		//kuadrant_io_v1beta1.NewKuadrantIoKuadrantV1Beta1Resource,
-		//kuadrant_io_v1beta2.NewKuadrantIoAuthPolicyV1Beta2Resource,
-		//kuadrant_io_v1beta2.NewKuadrantIoRateLimitPolicyV1Beta2Resource,
-		//kuadrant_io_v1beta3.NewKuadrantIoRateLimitPolicyV1Beta3Resource,
-		//kube_green_com_v1alpha1.NewKubeGreenComSleepInfoV1Alpha1Resource,
-		//kubean_io_v1alpha1.NewKubeanIoClusterOperationV1Alpha1Resource,
-		//kubean_io_v1alpha1.NewKubeanIoClusterV1Alpha1Resource,
-		//kubean_io_v1alpha1.NewKubeanIoLocalArtifactSetV1Alpha1Resource,
-		//kubean_io_v1alpha1.NewKubeanIoManifestV1Alpha1Resource,
-		//kubecost_com_v1alpha1.NewKubecostComTurndownScheduleV1Alpha1Resource,
-		//kubevious_io_v1alpha1.NewKubeviousIoWorkloadProfileV1Alpha1Resource,
-		//kubevious_io_v1alpha1.NewKubeviousIoWorkloadV1Alpha1Resource,
-		//kueue_x_k8s_io_v1alpha1.NewKueueXK8SIoCohortV1Alpha1Resource,
-		//kueue_x_k8s_io_v1alpha1.NewKueueXK8SIoMultiKueueClusterV1Alpha1Resource,
-		//kueue_x_k8s_io_v1alpha1.NewKueueXK8SIoMultiKueueConfigV1Alpha1Resource,
-		//kueue_x_k8s_io_v1beta1.NewKueueXK8SIoAdmissionCheckV1Beta1Resource,
-		//kueue_x_k8s_io_v1beta1.NewKueueXK8SIoClusterQueueV1Beta1Resource,
-		//kueue_x_k8s_io_v1beta1.NewKueueXK8SIoLocalQueueV1Beta1Resource,
-		//kueue_x_k8s_io_v1beta1.NewKueueXK8SIoMultiKueueClusterV1Beta1Resource,
-		//kueue_x_k8s_io_v1beta1.NewKueueXK8SIoMultiKueueConfigV1Beta1Resource,
-		//kueue_x_k8s_io_v1beta1.NewKueueXK8SIoProvisioningRequestConfigV1Beta1Resource,
-		//kueue_x_k8s_io_v1beta1.NewKueueXK8SIoResourceFlavorV1Beta1Resource,
-		//kueue_x_k8s_io_v1beta1.NewKueueXK8SIoWorkloadPriorityClassV1Beta1Resource,
-		//kueue_x_k8s_io_v1beta1.NewKueueXK8SIoWorkloadV1Beta1Resource,
-		//kuma_io_v1alpha1.NewKumaIoCircuitBreakerV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoContainerPatchV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoDataplaneInsightV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoDataplaneV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoExternalServiceV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoFaultInjectionV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoHealthCheckV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshAccessLogV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshCircuitBreakerV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshFaultInjectionV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshGatewayConfigV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshGatewayInstanceV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshGatewayRouteV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshGatewayV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshHealthCheckV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshHttprouteV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshInsightV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshLoadBalancingStrategyV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshProxyPatchV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshRateLimitV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshRetryV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshTcprouteV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshTimeoutV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshTraceV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshTrafficPermissionV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoMeshV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoProxyTemplateV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoRateLimitV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoRetryV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoServiceInsightV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoTimeoutV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoTrafficLogV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoTrafficPermissionV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoTrafficRouteV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoTrafficTraceV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoVirtualOutboundV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoZoneEgressInsightV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoZoneEgressV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoZoneIngressInsightV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoZoneIngressV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoZoneInsightV1Alpha1Resource,
-		//kuma_io_v1alpha1.NewKumaIoZoneV1Alpha1Resource,
-		//kustomize_toolkit_fluxcd_io_v1.NewKustomizeToolkitFluxcdIoKustomizationV1Resource,
-		//kustomize_toolkit_fluxcd_io_v1beta1.NewKustomizeToolkitFluxcdIoKustomizationV1Beta1Resource,
-		//kustomize_toolkit_fluxcd_io_v1beta2.NewKustomizeToolkitFluxcdIoKustomizationV1Beta2Resource,
-		//kyverno_io_v1.NewKyvernoIoClusterPolicyV1Resource,
-		//kyverno_io_v1.NewKyvernoIoPolicyV1Resource,
-		//kyverno_io_v1alpha2.NewKyvernoIoAdmissionReportV1Alpha2Resource,
-		//kyverno_io_v1alpha2.NewKyvernoIoBackgroundScanReportV1Alpha2Resource,
-		//kyverno_io_v1alpha2.NewKyvernoIoClusterAdmissionReportV1Alpha2Resource,
-		//kyverno_io_v1alpha2.NewKyvernoIoClusterBackgroundScanReportV1Alpha2Resource,
-		//kyverno_io_v1beta1.NewKyvernoIoUpdateRequestV1Beta1Resource,
-		//kyverno_io_v2.NewKyvernoIoAdmissionReportV2Resource,
-		//kyverno_io_v2.NewKyvernoIoBackgroundScanReportV2Resource,
-		//kyverno_io_v2.NewKyvernoIoCleanupPolicyV2Resource,
-		//kyverno_io_v2.NewKyvernoIoClusterAdmissionReportV2Resource,
-		//kyverno_io_v2.NewKyvernoIoClusterBackgroundScanReportV2Resource,
-		//kyverno_io_v2.NewKyvernoIoClusterCleanupPolicyV2Resource,
-		//kyverno_io_v2.NewKyvernoIoPolicyExceptionV2Resource,
-		//kyverno_io_v2.NewKyvernoIoUpdateRequestV2Resource,
-		//kyverno_io_v2alpha1.NewKyvernoIoCleanupPolicyV2Alpha1Resource,
-		//kyverno_io_v2alpha1.NewKyvernoIoClusterCleanupPolicyV2Alpha1Resource,
-		//kyverno_io_v2alpha1.NewKyvernoIoGlobalContextEntryV2Alpha1Resource,
-		//kyverno_io_v2alpha1.NewKyvernoIoPolicyExceptionV2Alpha1Resource,
-		//kyverno_io_v2beta1.NewKyvernoIoCleanupPolicyV2Beta1Resource,
-		//kyverno_io_v2beta1.NewKyvernoIoClusterCleanupPolicyV2Beta1Resource,
-		//kyverno_io_v2beta1.NewKyvernoIoClusterPolicyV2Beta1Resource,
-		//kyverno_io_v2beta1.NewKyvernoIoPolicyExceptionV2Beta1Resource,
-		//kyverno_io_v2beta1.NewKyvernoIoPolicyV2Beta1Resource,
-		//lambda_services_k8s_aws_v1alpha1.NewLambdaServicesK8SAwsAliasV1Alpha1Resource,
-		//lambda_services_k8s_aws_v1alpha1.NewLambdaServicesK8SAwsCodeSigningConfigV1Alpha1Resource,
-		//lambda_services_k8s_aws_v1alpha1.NewLambdaServicesK8SAwsEventSourceMappingV1Alpha1Resource,
-		//lambda_services_k8s_aws_v1alpha1.NewLambdaServicesK8SAwsFunctionUrlconfigV1Alpha1Resource,
-		//lambda_services_k8s_aws_v1alpha1.NewLambdaServicesK8SAwsFunctionV1Alpha1Resource,
-		//lambda_services_k8s_aws_v1alpha1.NewLambdaServicesK8SAwsLayerVersionV1Alpha1Resource,
-		//lambda_services_k8s_aws_v1alpha1.NewLambdaServicesK8SAwsVersionV1Alpha1Resource,
-		//lb_lbconfig_carlosedp_com_v1.NewLbLbconfigCarlosedpComExternalLoadBalancerV1Resource,
-		//leaksignal_com_v1.NewLeaksignalComClusterLeaksignalIstioV1Resource,
-		//leaksignal_com_v1.NewLeaksignalComLeaksignalIstioV1Resource,
-		//lerentis_uploadfilter24_eu_v1beta4.NewLerentisUploadfilter24EuBitwardenSecretV1Beta4Resource,
-		//lerentis_uploadfilter24_eu_v1beta4.NewLerentisUploadfilter24EuBitwardenTemplateV1Beta4Resource,
-		//lerentis_uploadfilter24_eu_v1beta4.NewLerentisUploadfilter24EuRegistryCredentialV1Beta4Resource,
-		//lerentis_uploadfilter24_eu_v1beta5.NewLerentisUploadfilter24EuBitwardenSecretV1Beta5Resource,
-		//lerentis_uploadfilter24_eu_v1beta5.NewLerentisUploadfilter24EuBitwardenTemplateV1Beta5Resource,
-		//lerentis_uploadfilter24_eu_v1beta5.NewLerentisUploadfilter24EuRegistryCredentialV1Beta5Resource,
-		//lerentis_uploadfilter24_eu_v1beta6.NewLerentisUploadfilter24EuBitwardenSecretV1Beta6Resource,
-		//lerentis_uploadfilter24_eu_v1beta6.NewLerentisUploadfilter24EuBitwardenTemplateV1Beta6Resource,
-		//lerentis_uploadfilter24_eu_v1beta6.NewLerentisUploadfilter24EuRegistryCredentialV1Beta6Resource,
-		//lerentis_uploadfilter24_eu_v1beta7.NewLerentisUploadfilter24EuBitwardenSecretV1Beta7Resource,
-		//lerentis_uploadfilter24_eu_v1beta7.NewLerentisUploadfilter24EuBitwardenTemplateV1Beta7Resource,
-		//lerentis_uploadfilter24_eu_v1beta7.NewLerentisUploadfilter24EuRegistryCredentialV1Beta7Resource,
-		//lerentis_uploadfilter24_eu_v1beta8.NewLerentisUploadfilter24EuBitwardenSecretV1Beta8Resource,
-		//lerentis_uploadfilter24_eu_v1beta8.NewLerentisUploadfilter24EuBitwardenTemplateV1Beta8Resource,
-		//lerentis_uploadfilter24_eu_v1beta8.NewLerentisUploadfilter24EuRegistryCredentialV1Beta8Resource,
-		//limitador_kuadrant_io_v1alpha1.NewLimitadorKuadrantIoLimitadorV1Alpha1Resource,
-		//listeners_stackable_tech_v1alpha1.NewListenersStackableTechListenerClassV1Alpha1Resource,
-		//listeners_stackable_tech_v1alpha1.NewListenersStackableTechListenerV1Alpha1Resource,
-		//listeners_stackable_tech_v1alpha1.NewListenersStackableTechPodListenersV1Alpha1Resource,
-		//litmuschaos_io_v1alpha1.NewLitmuschaosIoChaosEngineV1Alpha1Resource,
-		//litmuschaos_io_v1alpha1.NewLitmuschaosIoChaosExperimentV1Alpha1Resource,
-		//litmuschaos_io_v1alpha1.NewLitmuschaosIoChaosResultV1Alpha1Resource,
-		//logging_banzaicloud_io_v1alpha1.NewLoggingBanzaicloudIoClusterFlowV1Alpha1Resource,
-		//logging_banzaicloud_io_v1alpha1.NewLoggingBanzaicloudIoClusterOutputV1Alpha1Resource,
-		//logging_banzaicloud_io_v1alpha1.NewLoggingBanzaicloudIoFlowV1Alpha1Resource,
-		//logging_banzaicloud_io_v1alpha1.NewLoggingBanzaicloudIoLoggingV1Alpha1Resource,
-		//logging_banzaicloud_io_v1alpha1.NewLoggingBanzaicloudIoOutputV1Alpha1Resource,
-		//logging_banzaicloud_io_v1beta1.NewLoggingBanzaicloudIoClusterFlowV1Beta1Resource,
-		//logging_banzaicloud_io_v1beta1.NewLoggingBanzaicloudIoClusterOutputV1Beta1Resource,
-		//logging_banzaicloud_io_v1beta1.NewLoggingBanzaicloudIoFlowV1Beta1Resource,
-		//logging_banzaicloud_io_v1beta1.NewLoggingBanzaicloudIoFluentbitAgentV1Beta1Resource,
-		//logging_banzaicloud_io_v1beta1.NewLoggingBanzaicloudIoLoggingV1Beta1Resource,
-		//logging_banzaicloud_io_v1beta1.NewLoggingBanzaicloudIoNodeAgentV1Beta1Resource,
-		//logging_banzaicloud_io_v1beta1.NewLoggingBanzaicloudIoOutputV1Beta1Resource,
-		//logging_banzaicloud_io_v1beta1.NewLoggingBanzaicloudIoSyslogNgclusterFlowV1Beta1Resource,
-		//logging_banzaicloud_io_v1beta1.NewLoggingBanzaicloudIoSyslogNgclusterOutputV1Beta1Resource,
-		//logging_banzaicloud_io_v1beta1.NewLoggingBanzaicloudIoSyslogNgflowV1Beta1Resource,
-		//logging_banzaicloud_io_v1beta1.NewLoggingBanzaicloudIoSyslogNgoutputV1Beta1Resource,
-		//logging_extensions_banzaicloud_io_v1alpha1.NewLoggingExtensionsBanzaicloudIoEventTailerV1Alpha1Resource,
-		//logging_extensions_banzaicloud_io_v1alpha1.NewLoggingExtensionsBanzaicloudIoHostTailerV1Alpha1Resource,
-		//loki_grafana_com_v1.NewLokiGrafanaComAlertingRuleV1Resource,
-		//loki_grafana_com_v1.NewLokiGrafanaComLokiStackV1Resource,
-		//loki_grafana_com_v1.NewLokiGrafanaComRecordingRuleV1Resource,
-		//loki_grafana_com_v1.NewLokiGrafanaComRulerConfigV1Resource,
-		//loki_grafana_com_v1beta1.NewLokiGrafanaComAlertingRuleV1Beta1Resource,
-		//loki_grafana_com_v1beta1.NewLokiGrafanaComLokiStackV1Beta1Resource,
-		//loki_grafana_com_v1beta1.NewLokiGrafanaComRecordingRuleV1Beta1Resource,
-		//loki_grafana_com_v1beta1.NewLokiGrafanaComRulerConfigV1Beta1Resource,
-		//longhorn_io_v1beta1.NewLonghornIoBackingImageDataSourceV1Beta1Resource,
-		//longhorn_io_v1beta1.NewLonghornIoBackingImageManagerV1Beta1Resource,
-		//longhorn_io_v1beta1.NewLonghornIoBackingImageV1Beta1Resource,
-		//longhorn_io_v1beta1.NewLonghornIoBackupTargetV1Beta1Resource,
-		//longhorn_io_v1beta1.NewLonghornIoBackupV1Beta1Resource,
-		//longhorn_io_v1beta1.NewLonghornIoBackupVolumeV1Beta1Resource,
-		//longhorn_io_v1beta1.NewLonghornIoEngineImageV1Beta1Resource,
-		//longhorn_io_v1beta1.NewLonghornIoEngineV1Beta1Resource,
-		//longhorn_io_v1beta1.NewLonghornIoInstanceManagerV1Beta1Resource,
-		//longhorn_io_v1beta1.NewLonghornIoNodeV1Beta1Resource,
-		//longhorn_io_v1beta1.NewLonghornIoRecurringJobV1Beta1Resource,
-		//longhorn_io_v1beta1.NewLonghornIoReplicaV1Beta1Resource,
-		//longhorn_io_v1beta1.NewLonghornIoSettingV1Beta1Resource,
-		//longhorn_io_v1beta1.NewLonghornIoShareManagerV1Beta1Resource,
-		//longhorn_io_v1beta1.NewLonghornIoVolumeV1Beta1Resource,
-		//longhorn_io_v1beta2.NewLonghornIoBackingImageDataSourceV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoBackingImageManagerV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoBackingImageV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoBackupBackingImageV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoBackupTargetV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoBackupV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoBackupVolumeV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoEngineImageV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoEngineV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoInstanceManagerV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoNodeV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoOrphanV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoRecurringJobV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoReplicaV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoSettingV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoShareManagerV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoSnapshotV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoSupportBundleV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoSystemBackupV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoSystemRestoreV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoVolumeAttachmentV1Beta2Resource,
-		//longhorn_io_v1beta2.NewLonghornIoVolumeV1Beta2Resource,
-		//m4e_krestomat_io_v1alpha1.NewM4EKrestomatIoMoodleV1Alpha1Resource,
-		//m4e_krestomat_io_v1alpha1.NewM4EKrestomatIoNginxV1Alpha1Resource,
-		//m4e_krestomat_io_v1alpha1.NewM4EKrestomatIoPhpfpmV1Alpha1Resource,
-		//m4e_krestomat_io_v1alpha1.NewM4EKrestomatIoRoutineV1Alpha1Resource,
-		//machine_deletion_remediation_medik8s_io_v1alpha1.NewMachineDeletionRemediationMedik8SIoMachineDeletionRemediationTemplateV1Alpha1Resource,
-		//machine_deletion_remediation_medik8s_io_v1alpha1.NewMachineDeletionRemediationMedik8SIoMachineDeletionRemediationV1Alpha1Resource,
-		//maps_k8s_elastic_co_v1alpha1.NewMapsK8SElasticCoElasticMapsServerV1Alpha1Resource,
-		//mariadb_mmontes_io_v1alpha1.NewMariadbMmontesIoBackupV1Alpha1Resource,
-		//mariadb_mmontes_io_v1alpha1.NewMariadbMmontesIoConnectionV1Alpha1Resource,
-		//mariadb_mmontes_io_v1alpha1.NewMariadbMmontesIoDatabaseV1Alpha1Resource,
-		//mariadb_mmontes_io_v1alpha1.NewMariadbMmontesIoGrantV1Alpha1Resource,
-		//mariadb_mmontes_io_v1alpha1.NewMariadbMmontesIoMariaDbV1Alpha1Resource,
-		//mariadb_mmontes_io_v1alpha1.NewMariadbMmontesIoRestoreV1Alpha1Resource,
-		//mariadb_mmontes_io_v1alpha1.NewMariadbMmontesIoSqlJobV1Alpha1Resource,
-		//mariadb_mmontes_io_v1alpha1.NewMariadbMmontesIoUserV1Alpha1Resource,
-		//marin3r_3scale_net_v1alpha1.NewMarin3R3ScaleNetEnvoyConfigRevisionV1Alpha1Resource,
-		//marin3r_3scale_net_v1alpha1.NewMarin3R3ScaleNetEnvoyConfigV1Alpha1Resource,
-		//mattermost_com_v1alpha1.NewMattermostComClusterInstallationV1Alpha1Resource,
-		//mattermost_com_v1alpha1.NewMattermostComMattermostRestoreDbV1Alpha1Resource,
-		//memorydb_services_k8s_aws_v1alpha1.NewMemorydbServicesK8SAwsAclV1Alpha1Resource,
-		//memorydb_services_k8s_aws_v1alpha1.NewMemorydbServicesK8SAwsClusterV1Alpha1Resource,
-		//memorydb_services_k8s_aws_v1alpha1.NewMemorydbServicesK8SAwsParameterGroupV1Alpha1Resource,
-		//memorydb_services_k8s_aws_v1alpha1.NewMemorydbServicesK8SAwsSnapshotV1Alpha1Resource,
-		//memorydb_services_k8s_aws_v1alpha1.NewMemorydbServicesK8SAwsSubnetGroupV1Alpha1Resource,
-		//memorydb_services_k8s_aws_v1alpha1.NewMemorydbServicesK8SAwsUserV1Alpha1Resource,
-		//metacontroller_k8s_io_v1alpha1.NewMetacontrollerK8SIoCompositeControllerV1Alpha1Resource,
-		//metacontroller_k8s_io_v1alpha1.NewMetacontrollerK8SIoControllerRevisionV1Alpha1Resource,
-		//metacontroller_k8s_io_v1alpha1.NewMetacontrollerK8SIoDecoratorControllerV1Alpha1Resource,
-		//metal3_io_v1alpha1.NewMetal3IoBareMetalHostV1Alpha1Resource,
-		//metal3_io_v1alpha1.NewMetal3IoBmceventSubscriptionV1Alpha1Resource,
-		//metal3_io_v1alpha1.NewMetal3IoDataImageV1Alpha1Resource,
-		//metal3_io_v1alpha1.NewMetal3IoFirmwareSchemaV1Alpha1Resource,
-		//metal3_io_v1alpha1.NewMetal3IoHardwareDataV1Alpha1Resource,
-		//metal3_io_v1alpha1.NewMetal3IoHostFirmwareComponentsV1Alpha1Resource,
-		//metal3_io_v1alpha1.NewMetal3IoHostFirmwareSettingsV1Alpha1Resource,
-		//metal3_io_v1alpha1.NewMetal3IoPreprovisioningImageV1Alpha1Resource,
-		//minio_min_io_v2.NewMinioMinIoTenantV2Resource,
-		//mirrors_kts_studio_v1alpha1.NewMirrorsKtsStudioSecretMirrorV1Alpha1Resource,
-		//mirrors_kts_studio_v1alpha2.NewMirrorsKtsStudioSecretMirrorV1Alpha2Resource,
-		//model_kubedl_io_v1alpha1.NewModelKubedlIoModelV1Alpha1Resource,
-		//model_kubedl_io_v1alpha1.NewModelKubedlIoModelVersionV1Alpha1Resource,
-		//monitoring_coreos_com_v1.NewMonitoringCoreosComAlertmanagerV1Resource,
-		//monitoring_coreos_com_v1.NewMonitoringCoreosComPodMonitorV1Resource,
-		//monitoring_coreos_com_v1.NewMonitoringCoreosComProbeV1Resource,
-		//monitoring_coreos_com_v1.NewMonitoringCoreosComPrometheusRuleV1Resource,
-		//monitoring_coreos_com_v1.NewMonitoringCoreosComPrometheusV1Resource,
-		//monitoring_coreos_com_v1.NewMonitoringCoreosComServiceMonitorV1Resource,
-		//monitoring_coreos_com_v1.NewMonitoringCoreosComThanosRulerV1Resource,
-		//monitoring_coreos_com_v1alpha1.NewMonitoringCoreosComAlertmanagerConfigV1Alpha1Resource,
-		//monitoring_coreos_com_v1alpha1.NewMonitoringCoreosComPrometheusAgentV1Alpha1Resource,
-		//monitoring_coreos_com_v1alpha1.NewMonitoringCoreosComScrapeConfigV1Alpha1Resource,
-		//monitoring_coreos_com_v1beta1.NewMonitoringCoreosComAlertmanagerConfigV1Beta1Resource,
-		//monocle_monocle_change_metrics_io_v1alpha1.NewMonocleMonocleChangeMetricsIoMonocleV1Alpha1Resource,
-		//mq_services_k8s_aws_v1alpha1.NewMqServicesK8SAwsBrokerV1Alpha1Resource,
-		//multicluster_crd_antrea_io_v1alpha1.NewMulticlusterCrdAntreaIoClusterInfoImportV1Alpha1Resource,
-		//multicluster_crd_antrea_io_v1alpha1.NewMulticlusterCrdAntreaIoClusterSetV1Alpha1Resource,
-		//multicluster_crd_antrea_io_v1alpha1.NewMulticlusterCrdAntreaIoGatewayV1Alpha1Resource,
-		//multicluster_crd_antrea_io_v1alpha1.NewMulticlusterCrdAntreaIoLabelIdentityV1Alpha1Resource,
-		//multicluster_crd_antrea_io_v1alpha1.NewMulticlusterCrdAntreaIoMemberClusterAnnounceV1Alpha1Resource,
-		//multicluster_crd_antrea_io_v1alpha1.NewMulticlusterCrdAntreaIoMultiClusterConfigV1Alpha1Resource,
-		//multicluster_crd_antrea_io_v1alpha1.NewMulticlusterCrdAntreaIoResourceExportV1Alpha1Resource,
-		//multicluster_crd_antrea_io_v1alpha1.NewMulticlusterCrdAntreaIoResourceImportV1Alpha1Resource,
-		//multicluster_crd_antrea_io_v1alpha2.NewMulticlusterCrdAntreaIoClusterClaimV1Alpha2Resource,
-		//multicluster_crd_antrea_io_v1alpha2.NewMulticlusterCrdAntreaIoClusterSetV1Alpha2Resource,
-		//multicluster_x_k8s_io_v1alpha1.NewMulticlusterXK8SIoAppliedWorkV1Alpha1Resource,
-		//multicluster_x_k8s_io_v1alpha1.NewMulticlusterXK8SIoServiceImportV1Alpha1Resource,
-		//multicluster_x_k8s_io_v1alpha1.NewMulticlusterXK8SIoWorkV1Alpha1Resource,
-		//mutations_gatekeeper_sh_v1.NewMutationsGatekeeperShAssignMetadataV1Resource,
-		//mutations_gatekeeper_sh_v1.NewMutationsGatekeeperShAssignV1Resource,
-		//mutations_gatekeeper_sh_v1.NewMutationsGatekeeperShModifySetV1Resource,
-		//mutations_gatekeeper_sh_v1alpha1.NewMutationsGatekeeperShAssignImageV1Alpha1Resource,
-		//mutations_gatekeeper_sh_v1alpha1.NewMutationsGatekeeperShAssignMetadataV1Alpha1Resource,
-		//mutations_gatekeeper_sh_v1alpha1.NewMutationsGatekeeperShAssignV1Alpha1Resource,
-		//mutations_gatekeeper_sh_v1alpha1.NewMutationsGatekeeperShModifySetV1Alpha1Resource,
-		//mutations_gatekeeper_sh_v1beta1.NewMutationsGatekeeperShAssignMetadataV1Beta1Resource,
-		//mutations_gatekeeper_sh_v1beta1.NewMutationsGatekeeperShAssignV1Beta1Resource,
-		//mutations_gatekeeper_sh_v1beta1.NewMutationsGatekeeperShModifySetV1Beta1Resource,
-		//nativestor_alauda_io_v1.NewNativestorAlaudaIoRawDeviceV1Resource,
-		//netchecks_io_v1.NewNetchecksIoNetworkAssertionV1Resource,
-		//networkfirewall_services_k8s_aws_v1alpha1.NewNetworkfirewallServicesK8SAwsFirewallPolicyV1Alpha1Resource,
-		//networkfirewall_services_k8s_aws_v1alpha1.NewNetworkfirewallServicesK8SAwsFirewallV1Alpha1Resource,
-		//networkfirewall_services_k8s_aws_v1alpha1.NewNetworkfirewallServicesK8SAwsRuleGroupV1Alpha1Resource,
-		//networking_gke_io_v1.NewNetworkingGkeIoGcpbackendPolicyV1Resource,
-		//networking_gke_io_v1.NewNetworkingGkeIoGcpgatewayPolicyV1Resource,
-		//networking_gke_io_v1.NewNetworkingGkeIoHealthCheckPolicyV1Resource,
-		//networking_gke_io_v1.NewNetworkingGkeIoLbpolicyV1Resource,
-		//networking_gke_io_v1.NewNetworkingGkeIoManagedCertificateV1Resource,
-		//networking_istio_io_v1.NewNetworkingIstioIoDestinationRuleV1Resource,
-		//networking_istio_io_v1.NewNetworkingIstioIoGatewayV1Resource,
-		//networking_istio_io_v1.NewNetworkingIstioIoServiceEntryV1Resource,
-		//networking_istio_io_v1.NewNetworkingIstioIoSidecarV1Resource,
-		//networking_istio_io_v1.NewNetworkingIstioIoVirtualServiceV1Resource,
-		//networking_istio_io_v1.NewNetworkingIstioIoWorkloadEntryV1Resource,
-		//networking_istio_io_v1.NewNetworkingIstioIoWorkloadGroupV1Resource,
-		//networking_istio_io_v1alpha3.NewNetworkingIstioIoDestinationRuleV1Alpha3Resource,
-		//networking_istio_io_v1alpha3.NewNetworkingIstioIoEnvoyFilterV1Alpha3Resource,
-		//networking_istio_io_v1alpha3.NewNetworkingIstioIoGatewayV1Alpha3Resource,
-		//networking_istio_io_v1alpha3.NewNetworkingIstioIoServiceEntryV1Alpha3Resource,
-		//networking_istio_io_v1alpha3.NewNetworkingIstioIoSidecarV1Alpha3Resource,
-		//networking_istio_io_v1alpha3.NewNetworkingIstioIoVirtualServiceV1Alpha3Resource,
-		//networking_istio_io_v1alpha3.NewNetworkingIstioIoWorkloadEntryV1Alpha3Resource,
-		//networking_istio_io_v1alpha3.NewNetworkingIstioIoWorkloadGroupV1Alpha3Resource,
-		//networking_istio_io_v1beta1.NewNetworkingIstioIoDestinationRuleV1Beta1Resource,
-		//networking_istio_io_v1beta1.NewNetworkingIstioIoGatewayV1Beta1Resource,
-		//networking_istio_io_v1beta1.NewNetworkingIstioIoProxyConfigV1Beta1Resource,
-		//networking_istio_io_v1beta1.NewNetworkingIstioIoServiceEntryV1Beta1Resource,
-		//networking_istio_io_v1beta1.NewNetworkingIstioIoSidecarV1Beta1Resource,
-		//networking_istio_io_v1beta1.NewNetworkingIstioIoVirtualServiceV1Beta1Resource,
-		//networking_istio_io_v1beta1.NewNetworkingIstioIoWorkloadEntryV1Beta1Resource,
-		//networking_istio_io_v1beta1.NewNetworkingIstioIoWorkloadGroupV1Beta1Resource,
-		//networking_k8s_aws_v1alpha1.NewNetworkingK8SAwsPolicyEndpointV1Alpha1Resource,
-		//networking_k8s_io_v1.NewNetworkingK8SIoIngressClassV1Resource,
-		//networking_k8s_io_v1.NewNetworkingK8SIoIngressV1Resource,
-		//networking_k8s_io_v1.NewNetworkingK8SIoNetworkPolicyV1Resource,
-		//networking_karmada_io_v1alpha1.NewNetworkingKarmadaIoMultiClusterIngressV1Alpha1Resource,
-		//networking_karmada_io_v1alpha1.NewNetworkingKarmadaIoMultiClusterServiceV1Alpha1Resource,
-		//nfd_k8s_sigs_io_v1alpha1.NewNfdK8SSigsIoNodeFeatureRuleV1Alpha1Resource,
-		//nfd_kubernetes_io_v1.NewNfdKubernetesIoNodeFeatureDiscoveryV1Resource,
-		//nfd_kubernetes_io_v1alpha1.NewNfdKubernetesIoNodeFeatureRuleV1Alpha1Resource,
-		//nifi_stackable_tech_v1alpha1.NewNifiStackableTechNifiClusterV1Alpha1Resource,
-		//nodeinfo_volcano_sh_v1alpha1.NewNodeinfoVolcanoShNumatopologyV1Alpha1Resource,
-		//notebook_kubedl_io_v1alpha1.NewNotebookKubedlIoNotebookV1Alpha1Resource,
-		//notification_toolkit_fluxcd_io_v1.NewNotificationToolkitFluxcdIoReceiverV1Resource,
-		//notification_toolkit_fluxcd_io_v1beta1.NewNotificationToolkitFluxcdIoAlertV1Beta1Resource,
-		//notification_toolkit_fluxcd_io_v1beta1.NewNotificationToolkitFluxcdIoProviderV1Beta1Resource,
-		//notification_toolkit_fluxcd_io_v1beta1.NewNotificationToolkitFluxcdIoReceiverV1Beta1Resource,
-		//notification_toolkit_fluxcd_io_v1beta2.NewNotificationToolkitFluxcdIoAlertV1Beta2Resource,
-		//notification_toolkit_fluxcd_io_v1beta2.NewNotificationToolkitFluxcdIoProviderV1Beta2Resource,
-		//notification_toolkit_fluxcd_io_v1beta2.NewNotificationToolkitFluxcdIoReceiverV1Beta2Resource,
-		//notification_toolkit_fluxcd_io_v1beta3.NewNotificationToolkitFluxcdIoAlertV1Beta3Resource,
-		//notification_toolkit_fluxcd_io_v1beta3.NewNotificationToolkitFluxcdIoProviderV1Beta3Resource,
-		//objectbucket_io_v1alpha1.NewObjectbucketIoObjectBucketClaimV1Alpha1Resource,
-		//objectbucket_io_v1alpha1.NewObjectbucketIoObjectBucketV1Alpha1Resource,
-		//ocmagent_managed_openshift_io_v1alpha1.NewOcmagentManagedOpenshiftIoManagedFleetNotificationV1Alpha1Resource,
-		//ocmagent_managed_openshift_io_v1alpha1.NewOcmagentManagedOpenshiftIoManagedNotificationV1Alpha1Resource,
-		//ocmagent_managed_openshift_io_v1alpha1.NewOcmagentManagedOpenshiftIoOcmAgentV1Alpha1Resource,
-		//onepassword_com_v1.NewOnepasswordComOnePasswordItemV1Resource,
-		//opa_stackable_tech_v1alpha1.NewOpaStackableTechOpaClusterV1Alpha1Resource,
-		//opensearchservice_services_k8s_aws_v1alpha1.NewOpensearchserviceServicesK8SAwsDomainV1Alpha1Resource,
-		//opentelemetry_io_v1alpha1.NewOpentelemetryIoInstrumentationV1Alpha1Resource,
-		//opentelemetry_io_v1alpha1.NewOpentelemetryIoOpAmpbridgeV1Alpha1Resource,
-		//opentelemetry_io_v1alpha1.NewOpentelemetryIoOpenTelemetryCollectorV1Alpha1Resource,
-		//opentelemetry_io_v1beta1.NewOpentelemetryIoOpenTelemetryCollectorV1Beta1Resource,
-		//operations_kubeedge_io_v1alpha1.NewOperationsKubeedgeIoNodeUpgradeJobV1Alpha1Resource,
-		//operator_aquasec_com_v1alpha1.NewOperatorAquasecComAquaCspV1Alpha1Resource,
-		//operator_aquasec_com_v1alpha1.NewOperatorAquasecComAquaDatabaseV1Alpha1Resource,
-		//operator_aquasec_com_v1alpha1.NewOperatorAquasecComAquaEnforcerV1Alpha1Resource,
-		//operator_aquasec_com_v1alpha1.NewOperatorAquasecComAquaGatewayV1Alpha1Resource,
-		//operator_aquasec_com_v1alpha1.NewOperatorAquasecComAquaKubeEnforcerV1Alpha1Resource,
-		//operator_aquasec_com_v1alpha1.NewOperatorAquasecComAquaScannerV1Alpha1Resource,
-		//operator_aquasec_com_v1alpha1.NewOperatorAquasecComAquaServerV1Alpha1Resource,
-		//operator_authorino_kuadrant_io_v1beta1.NewOperatorAuthorinoKuadrantIoAuthorinoV1Beta1Resource,
-		//operator_cluster_x_k8s_io_v1alpha1.NewOperatorClusterXK8SIoBootstrapProviderV1Alpha1Resource,
-		//operator_cluster_x_k8s_io_v1alpha1.NewOperatorClusterXK8SIoControlPlaneProviderV1Alpha1Resource,
-		//operator_cluster_x_k8s_io_v1alpha1.NewOperatorClusterXK8SIoCoreProviderV1Alpha1Resource,
-		//operator_cluster_x_k8s_io_v1alpha1.NewOperatorClusterXK8SIoInfrastructureProviderV1Alpha1Resource,
-		//operator_cluster_x_k8s_io_v1alpha2.NewOperatorClusterXK8SIoAddonProviderV1Alpha2Resource,
-		//operator_cluster_x_k8s_io_v1alpha2.NewOperatorClusterXK8SIoBootstrapProviderV1Alpha2Resource,
-		//operator_cluster_x_k8s_io_v1alpha2.NewOperatorClusterXK8SIoControlPlaneProviderV1Alpha2Resource,
-		//operator_cluster_x_k8s_io_v1alpha2.NewOperatorClusterXK8SIoCoreProviderV1Alpha2Resource,
-		//operator_cluster_x_k8s_io_v1alpha2.NewOperatorClusterXK8SIoInfrastructureProviderV1Alpha2Resource,
-		//operator_cryostat_io_v1beta1.NewOperatorCryostatIoCryostatV1Beta1Resource,
-		//operator_cryostat_io_v1beta2.NewOperatorCryostatIoCryostatV1Beta2Resource,
-		//operator_knative_dev_v1beta1.NewOperatorKnativeDevKnativeEventingV1Beta1Resource,
-		//operator_knative_dev_v1beta1.NewOperatorKnativeDevKnativeServingV1Beta1Resource,
-		//operator_marin3r_3scale_net_v1alpha1.NewOperatorMarin3R3ScaleNetDiscoveryServiceCertificateV1Alpha1Resource,
-		//operator_marin3r_3scale_net_v1alpha1.NewOperatorMarin3R3ScaleNetDiscoveryServiceV1Alpha1Resource,
-		//operator_marin3r_3scale_net_v1alpha1.NewOperatorMarin3R3ScaleNetEnvoyDeploymentV1Alpha1Resource,
-		//operator_open_cluster_management_io_v1.NewOperatorOpenClusterManagementIoClusterManagerV1Resource,
-		//operator_open_cluster_management_io_v1.NewOperatorOpenClusterManagementIoKlusterletV1Resource,
-		//operator_shipwright_io_v1alpha1.NewOperatorShipwrightIoShipwrightBuildV1Alpha1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoAmazonCloudIntegrationV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoApiserverV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoApplicationLayerV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoAuthenticationV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoComplianceV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoEgressGatewayV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoImageSetV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoInstallationV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoIntrusionDetectionV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoLogCollectorV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoLogStorageV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoManagementClusterConnectionV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoManagementClusterV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoManagerV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoMonitorV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoPacketCaptureV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoPolicyRecommendationV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoTenantV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoTigeraStatusV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoTlspassThroughRouteV1Resource,
-		//operator_tigera_io_v1.NewOperatorTigeraIoTlsterminatedRouteV1Resource,
-		//operator_tigera_io_v1beta1.NewOperatorTigeraIoAmazonCloudIntegrationV1Beta1Resource,
-		//operator_victoriametrics_com_v1beta1.NewOperatorVictoriametricsComVlogsV1Beta1Resource,
-		//operator_victoriametrics_com_v1beta1.NewOperatorVictoriametricsComVmagentV1Beta1Resource,
-		//operator_victoriametrics_com_v1beta1.NewOperatorVictoriametricsComVmalertV1Beta1Resource,
-		//operator_victoriametrics_com_v1beta1.NewOperatorVictoriametricsComVmalertmanagerConfigV1Beta1Resource,
-		//operator_victoriametrics_com_v1beta1.NewOperatorVictoriametricsComVmalertmanagerV1Beta1Resource,
-		//operator_victoriametrics_com_v1beta1.NewOperatorVictoriametricsComVmauthV1Beta1Resource,
-		//operator_victoriametrics_com_v1beta1.NewOperatorVictoriametricsComVmclusterV1Beta1Resource,
-		//operator_victoriametrics_com_v1beta1.NewOperatorVictoriametricsComVmnodeScrapeV1Beta1Resource,
-		//operator_victoriametrics_com_v1beta1.NewOperatorVictoriametricsComVmpodScrapeV1Beta1Resource,
-		//operator_victoriametrics_com_v1beta1.NewOperatorVictoriametricsComVmprobeV1Beta1Resource,
-		//operator_victoriametrics_com_v1beta1.NewOperatorVictoriametricsComVmruleV1Beta1Resource,
-		//operator_victoriametrics_com_v1beta1.NewOperatorVictoriametricsComVmscrapeConfigV1Beta1Resource,
-		//operator_victoriametrics_com_v1beta1.NewOperatorVictoriametricsComVmserviceScrapeV1Beta1Resource,
-		//operator_victoriametrics_com_v1beta1.NewOperatorVictoriametricsComVmsingleV1Beta1Resource,
-		//operator_victoriametrics_com_v1beta1.NewOperatorVictoriametricsComVmstaticScrapeV1Beta1Resource,
-		//operator_victoriametrics_com_v1beta1.NewOperatorVictoriametricsComVmuserV1Beta1Resource,
-		//oracle_db_anthosapis_com_v1alpha1.NewOracleDbAnthosapisComBackupScheduleV1Alpha1Resource,
-		//oracle_db_anthosapis_com_v1alpha1.NewOracleDbAnthosapisComBackupV1Alpha1Resource,
-		//oracle_db_anthosapis_com_v1alpha1.NewOracleDbAnthosapisComConfigV1Alpha1Resource,
-		//oracle_db_anthosapis_com_v1alpha1.NewOracleDbAnthosapisComCronAnythingV1Alpha1Resource,
-		//oracle_db_anthosapis_com_v1alpha1.NewOracleDbAnthosapisComDatabaseV1Alpha1Resource,
-		//oracle_db_anthosapis_com_v1alpha1.NewOracleDbAnthosapisComExportV1Alpha1Resource,
-		//oracle_db_anthosapis_com_v1alpha1.NewOracleDbAnthosapisComImportV1Alpha1Resource,
-		//oracle_db_anthosapis_com_v1alpha1.NewOracleDbAnthosapisComInstanceV1Alpha1Resource,
-		//oracle_db_anthosapis_com_v1alpha1.NewOracleDbAnthosapisComPitrV1Alpha1Resource,
-		//oracle_db_anthosapis_com_v1alpha1.NewOracleDbAnthosapisComReleaseV1Alpha1Resource,
-		//org_eclipse_che_v1.NewOrgEclipseCheCheClusterV1Resource,
-		//org_eclipse_che_v2.NewOrgEclipseCheCheClusterV2Resource,
-		//organizations_services_k8s_aws_v1alpha1.NewOrganizationsServicesK8SAwsOrganizationalUnitV1Alpha1Resource,
-		//pgv2_percona_com_v2.NewPgv2PerconaComPerconaPgbackupV2Resource,
-		//pgv2_percona_com_v2.NewPgv2PerconaComPerconaPgclusterV2Resource,
-		//pgv2_percona_com_v2.NewPgv2PerconaComPerconaPgrestoreV2Resource,
-		//pgv2_percona_com_v2.NewPgv2PerconaComPerconaPgupgradeV2Resource,
-		//pipes_services_k8s_aws_v1alpha1.NewPipesServicesK8SAwsPipeV1Alpha1Resource,
-		//pkg_crossplane_io_v1.NewPkgCrossplaneIoConfigurationRevisionV1Resource,
-		//pkg_crossplane_io_v1.NewPkgCrossplaneIoConfigurationV1Resource,
-		//pkg_crossplane_io_v1.NewPkgCrossplaneIoProviderRevisionV1Resource,
-		//pkg_crossplane_io_v1.NewPkgCrossplaneIoProviderV1Resource,
-		//pkg_crossplane_io_v1alpha1.NewPkgCrossplaneIoControllerConfigV1Alpha1Resource,
-		//pkg_crossplane_io_v1beta1.NewPkgCrossplaneIoLockV1Beta1Resource,
-		//policy_clusterpedia_io_v1alpha1.NewPolicyClusterpediaIoClusterImportPolicyV1Alpha1Resource,
-		//policy_clusterpedia_io_v1alpha1.NewPolicyClusterpediaIoPediaClusterLifecycleV1Alpha1Resource,
-		//policy_karmada_io_v1alpha1.NewPolicyKarmadaIoClusterOverridePolicyV1Alpha1Resource,
-		//policy_karmada_io_v1alpha1.NewPolicyKarmadaIoClusterPropagationPolicyV1Alpha1Resource,
-		//policy_karmada_io_v1alpha1.NewPolicyKarmadaIoFederatedResourceQuotaV1Alpha1Resource,
-		//policy_karmada_io_v1alpha1.NewPolicyKarmadaIoOverridePolicyV1Alpha1Resource,
-		//policy_karmada_io_v1alpha1.NewPolicyKarmadaIoPropagationPolicyV1Alpha1Resource,
-		//policy_kubeedge_io_v1alpha1.NewPolicyKubeedgeIoServiceAccountAccessV1Alpha1Resource,
-		//policy_networking_k8s_io_v1alpha1.NewPolicyNetworkingK8SIoAdminNetworkPolicyV1Alpha1Resource,
-		//policy_networking_k8s_io_v1alpha1.NewPolicyNetworkingK8SIoBaselineAdminNetworkPolicyV1Alpha1Resource,
-		//policy_v1.NewPolicyPodDisruptionBudgetV1Resource,
-		//postgres_operator_crunchydata_com_v1beta1.NewPostgresOperatorCrunchydataComPgadminV1Beta1Resource,
-		//postgres_operator_crunchydata_com_v1beta1.NewPostgresOperatorCrunchydataComPgupgradeV1Beta1Resource,
-		//postgres_operator_crunchydata_com_v1beta1.NewPostgresOperatorCrunchydataComPostgresClusterV1Beta1Resource,
-		//postgresql_cnpg_io_v1.NewPostgresqlCnpgIoBackupV1Resource,
-		//postgresql_cnpg_io_v1.NewPostgresqlCnpgIoClusterV1Resource,
-		//postgresql_cnpg_io_v1.NewPostgresqlCnpgIoPoolerV1Resource,
-		//postgresql_cnpg_io_v1.NewPostgresqlCnpgIoScheduledBackupV1Resource,
-		//projectcontour_io_v1.NewProjectcontourIoHttpproxyV1Resource,
-		//projectcontour_io_v1.NewProjectcontourIoTlscertificateDelegationV1Resource,
-		//projectcontour_io_v1alpha1.NewProjectcontourIoContourConfigurationV1Alpha1Resource,
-		//projectcontour_io_v1alpha1.NewProjectcontourIoContourDeploymentV1Alpha1Resource,
-		//projectcontour_io_v1alpha1.NewProjectcontourIoExtensionServiceV1Alpha1Resource,
-		//prometheusservice_services_k8s_aws_v1alpha1.NewPrometheusserviceServicesK8SAwsAlertManagerDefinitionV1Alpha1Resource,
-		//prometheusservice_services_k8s_aws_v1alpha1.NewPrometheusserviceServicesK8SAwsLoggingConfigurationV1Alpha1Resource,
-		//prometheusservice_services_k8s_aws_v1alpha1.NewPrometheusserviceServicesK8SAwsRuleGroupsNamespaceV1Alpha1Resource,
-		//prometheusservice_services_k8s_aws_v1alpha1.NewPrometheusserviceServicesK8SAwsWorkspaceV1Alpha1Resource,
-		//ps_percona_com_v1alpha1.NewPsPerconaComPerconaServerMySqlV1Alpha1Resource,
-		//ps_percona_com_v1alpha1.NewPsPerconaComPerconaServerMySqlbackupV1Alpha1Resource,
-		//ps_percona_com_v1alpha1.NewPsPerconaComPerconaServerMySqlrestoreV1Alpha1Resource,
-		//psmdb_percona_com_v1.NewPsmdbPerconaComPerconaServerMongoDbV1Resource,
-		//psmdb_percona_com_v1.NewPsmdbPerconaComPerconaServerMongoDbbackupV1Resource,
-		//psmdb_percona_com_v1.NewPsmdbPerconaComPerconaServerMongoDbrestoreV1Resource,
-		//ptp_openshift_io_v1.NewPtpOpenshiftIoNodePtpDeviceV1Resource,
-		//ptp_openshift_io_v1.NewPtpOpenshiftIoPtpConfigV1Resource,
-		//ptp_openshift_io_v1.NewPtpOpenshiftIoPtpOperatorConfigV1Resource,
-		//pubsubplus_solace_com_v1beta1.NewPubsubplusSolaceComPubSubPlusEventBrokerV1Beta1Resource,
-		//pxc_percona_com_v1.NewPxcPerconaComPerconaXtraDbclusterBackupV1Resource,
-		//pxc_percona_com_v1.NewPxcPerconaComPerconaXtraDbclusterRestoreV1Resource,
-		//pxc_percona_com_v1.NewPxcPerconaComPerconaXtraDbclusterV1Resource,
-		//quay_redhat_com_v1.NewQuayRedhatComQuayRegistryV1Resource,
-		//quota_codeflare_dev_v1alpha1.NewQuotaCodeflareDevQuotaSubtreeV1Alpha1Resource,
-		//ray_io_v1.NewRayIoRayClusterV1Resource,
-		//ray_io_v1.NewRayIoRayJobV1Resource,
-		//ray_io_v1.NewRayIoRayServiceV1Resource,
-		//ray_io_v1alpha1.NewRayIoRayClusterV1Alpha1Resource,
-		//ray_io_v1alpha1.NewRayIoRayJobV1Alpha1Resource,
-		//ray_io_v1alpha1.NewRayIoRayServiceV1Alpha1Resource,
-		//rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoClusterRoleBindingV1Resource,
-		//rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoClusterRoleV1Resource,
-		//rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoRoleBindingV1Resource,
-		//rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoRoleV1Resource,
-		//rbacmanager_reactiveops_io_v1beta1.NewRbacmanagerReactiveopsIoRbacdefinitionV1Beta1Resource,
-		//rc_app_stacks_v1.NewRcAppStacksRuntimeComponentV1Resource,
-		//rc_app_stacks_v1.NewRcAppStacksRuntimeOperationV1Resource,
-		//rc_app_stacks_v1beta2.NewRcAppStacksRuntimeComponentV1Beta2Resource,
-		//rc_app_stacks_v1beta2.NewRcAppStacksRuntimeOperationV1Beta2Resource,
-		//rds_services_k8s_aws_v1alpha1.NewRdsServicesK8SAwsDbclusterParameterGroupV1Alpha1Resource,
-		//rds_services_k8s_aws_v1alpha1.NewRdsServicesK8SAwsDbclusterV1Alpha1Resource,
-		//rds_services_k8s_aws_v1alpha1.NewRdsServicesK8SAwsDbinstanceV1Alpha1Resource,
-		//rds_services_k8s_aws_v1alpha1.NewRdsServicesK8SAwsDbparameterGroupV1Alpha1Resource,
-		//rds_services_k8s_aws_v1alpha1.NewRdsServicesK8SAwsDbproxyV1Alpha1Resource,
-		//rds_services_k8s_aws_v1alpha1.NewRdsServicesK8SAwsDbsubnetGroupV1Alpha1Resource,
-		//rds_services_k8s_aws_v1alpha1.NewRdsServicesK8SAwsGlobalClusterV1Alpha1Resource,
-		//redhatcop_redhat_io_v1alpha1.NewRedhatcopRedhatIoGroupConfigV1Alpha1Resource,
-		//redhatcop_redhat_io_v1alpha1.NewRedhatcopRedhatIoKeepalivedGroupV1Alpha1Resource,
-		//redhatcop_redhat_io_v1alpha1.NewRedhatcopRedhatIoNamespaceConfigV1Alpha1Resource,
-		//redhatcop_redhat_io_v1alpha1.NewRedhatcopRedhatIoPatchV1Alpha1Resource,
-		//redhatcop_redhat_io_v1alpha1.NewRedhatcopRedhatIoUserConfigV1Alpha1Resource,
-		//registry_apicur_io_v1.NewRegistryApicurIoApicurioRegistryV1Resource,
-		//registry_devfile_io_v1alpha1.NewRegistryDevfileIoClusterDevfileRegistriesListV1Alpha1Resource,
-		//registry_devfile_io_v1alpha1.NewRegistryDevfileIoDevfileRegistriesListV1Alpha1Resource,
-		//registry_devfile_io_v1alpha1.NewRegistryDevfileIoDevfileRegistryV1Alpha1Resource,
-		//reliablesyncs_kubeedge_io_v1alpha1.NewReliablesyncsKubeedgeIoClusterObjectSyncV1Alpha1Resource,
-		//reliablesyncs_kubeedge_io_v1alpha1.NewReliablesyncsKubeedgeIoObjectSyncV1Alpha1Resource,
-		//remediation_medik8s_io_v1alpha1.NewRemediationMedik8SIoNodeHealthCheckV1Alpha1Resource,
-		//repo_manager_pulpproject_org_v1beta2.NewRepoManagerPulpprojectOrgPulpBackupV1Beta2Resource,
-		//repo_manager_pulpproject_org_v1beta2.NewRepoManagerPulpprojectOrgPulpRestoreV1Beta2Resource,
-		//repo_manager_pulpproject_org_v1beta2.NewRepoManagerPulpprojectOrgPulpV1Beta2Resource,
-		//reports_kyverno_io_v1.NewReportsKyvernoIoClusterEphemeralReportV1Resource,
-		//reports_kyverno_io_v1.NewReportsKyvernoIoEphemeralReportV1Resource,
-		//resources_teleport_dev_v1.NewResourcesTeleportDevTeleportLoginRuleV1Resource,
-		//resources_teleport_dev_v1.NewResourcesTeleportDevTeleportOktaImportRuleV1Resource,
-		//resources_teleport_dev_v2.NewResourcesTeleportDevTeleportProvisionTokenV2Resource,
-		//resources_teleport_dev_v2.NewResourcesTeleportDevTeleportSamlconnectorV2Resource,
-		//resources_teleport_dev_v2.NewResourcesTeleportDevTeleportUserV2Resource,
-		//resources_teleport_dev_v3.NewResourcesTeleportDevTeleportGithubConnectorV3Resource,
-		//resources_teleport_dev_v3.NewResourcesTeleportDevTeleportOidcconnectorV3Resource,
-		//resources_teleport_dev_v5.NewResourcesTeleportDevTeleportRoleV5Resource,
-		//resources_teleport_dev_v6.NewResourcesTeleportDevTeleportRoleV6Resource,
-		//ripsaw_cloudbulldozer_io_v1alpha1.NewRipsawCloudbulldozerIoBenchmarkV1Alpha1Resource,
-		//rocketmq_apache_org_v1alpha1.NewRocketmqApacheOrgBrokerV1Alpha1Resource,
-		//rocketmq_apache_org_v1alpha1.NewRocketmqApacheOrgConsoleV1Alpha1Resource,
-		//rocketmq_apache_org_v1alpha1.NewRocketmqApacheOrgNameServiceV1Alpha1Resource,
-		//rocketmq_apache_org_v1alpha1.NewRocketmqApacheOrgTopicTransferV1Alpha1Resource,
-		//route53_services_k8s_aws_v1alpha1.NewRoute53ServicesK8SAwsHostedZoneV1Alpha1Resource,
-		//route53_services_k8s_aws_v1alpha1.NewRoute53ServicesK8SAwsRecordSetV1Alpha1Resource,
-		//route53resolver_services_k8s_aws_v1alpha1.NewRoute53ResolverServicesK8SAwsResolverEndpointV1Alpha1Resource,
-		//route53resolver_services_k8s_aws_v1alpha1.NewRoute53ResolverServicesK8SAwsResolverRuleV1Alpha1Resource,
-		//rules_kubeedge_io_v1.NewRulesKubeedgeIoRuleEndpointV1Resource,
-		//rules_kubeedge_io_v1.NewRulesKubeedgeIoRuleV1Resource,
-		//runtime_cluster_x_k8s_io_v1alpha1.NewRuntimeClusterXK8SIoExtensionConfigV1Alpha1Resource,
-		//s3_services_k8s_aws_v1alpha1.NewS3ServicesK8SAwsBucketV1Alpha1Resource,
-		//s3_snappcloud_io_v1alpha1.NewS3SnappcloudIoS3BucketV1Alpha1Resource,
-		//s3_snappcloud_io_v1alpha1.NewS3SnappcloudIoS3UserClaimV1Alpha1Resource,
-		//s3_snappcloud_io_v1alpha1.NewS3SnappcloudIoS3UserV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsAppV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsDataQualityJobDefinitionV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsDomainV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsEndpointConfigV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsEndpointV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsFeatureGroupV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsHyperParameterTuningJobV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsModelBiasJobDefinitionV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsModelExplainabilityJobDefinitionV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsModelPackageGroupV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsModelPackageV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsModelQualityJobDefinitionV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsModelV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsMonitoringScheduleV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsNotebookInstanceLifecycleConfigV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsNotebookInstanceV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsProcessingJobV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsTrainingJobV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsTransformJobV1Alpha1Resource,
-		//sagemaker_services_k8s_aws_v1alpha1.NewSagemakerServicesK8SAwsUserProfileV1Alpha1Resource,
-		//scheduling_k8s_io_v1.NewSchedulingK8SIoPriorityClassV1Resource,
-		//scheduling_koordinator_sh_v1alpha1.NewSchedulingKoordinatorShDeviceV1Alpha1Resource,
-		//scheduling_koordinator_sh_v1alpha1.NewSchedulingKoordinatorShPodMigrationJobV1Alpha1Resource,
-		//scheduling_koordinator_sh_v1alpha1.NewSchedulingKoordinatorShReservationV1Alpha1Resource,
-		//scheduling_sigs_k8s_io_v1alpha1.NewSchedulingSigsK8SIoElasticQuotaV1Alpha1Resource,
-		//scheduling_sigs_k8s_io_v1alpha1.NewSchedulingSigsK8SIoPodGroupV1Alpha1Resource,
-		//scheduling_volcano_sh_v1beta1.NewSchedulingVolcanoShPodGroupV1Beta1Resource,
-		//scheduling_volcano_sh_v1beta1.NewSchedulingVolcanoShQueueV1Beta1Resource,
-		//schemas_schemahero_io_v1alpha4.NewSchemasSchemaheroIoDataTypeV1Alpha4Resource,
-		//schemas_schemahero_io_v1alpha4.NewSchemasSchemaheroIoMigrationV1Alpha4Resource,
-		//schemas_schemahero_io_v1alpha4.NewSchemasSchemaheroIoTableV1Alpha4Resource,
-		//scylla_scylladb_com_v1.NewScyllaScylladbComScyllaClusterV1Resource,
-		//scylla_scylladb_com_v1alpha1.NewScyllaScylladbComNodeConfigV1Alpha1Resource,
-		//scylla_scylladb_com_v1alpha1.NewScyllaScylladbComScyllaOperatorConfigV1Alpha1Resource,
-		//secretgenerator_mittwald_de_v1alpha1.NewSecretgeneratorMittwaldDeBasicAuthV1Alpha1Resource,
-		//secretgenerator_mittwald_de_v1alpha1.NewSecretgeneratorMittwaldDeSshkeyPairV1Alpha1Resource,
-		//secretgenerator_mittwald_de_v1alpha1.NewSecretgeneratorMittwaldDeStringSecretV1Alpha1Resource,
-		//secrets_crossplane_io_v1alpha1.NewSecretsCrossplaneIoStoreConfigV1Alpha1Resource,
-		//secrets_doppler_com_v1alpha1.NewSecretsDopplerComDopplerSecretV1Alpha1Resource,
-		//secrets_hashicorp_com_v1beta1.NewSecretsHashicorpComHcpauthV1Beta1Resource,
-		//secrets_hashicorp_com_v1beta1.NewSecretsHashicorpComHcpvaultSecretsAppV1Beta1Resource,
-		//secrets_hashicorp_com_v1beta1.NewSecretsHashicorpComVaultAuthV1Beta1Resource,
-		//secrets_hashicorp_com_v1beta1.NewSecretsHashicorpComVaultConnectionV1Beta1Resource,
-		//secrets_hashicorp_com_v1beta1.NewSecretsHashicorpComVaultDynamicSecretV1Beta1Resource,
-		//secrets_hashicorp_com_v1beta1.NewSecretsHashicorpComVaultPkisecretV1Beta1Resource,
-		//secrets_hashicorp_com_v1beta1.NewSecretsHashicorpComVaultStaticSecretV1Beta1Resource,
-		//secrets_stackable_tech_v1alpha1.NewSecretsStackableTechSecretClassV1Alpha1Resource,
-		//secrets_store_csi_x_k8s_io_v1.NewSecretsStoreCsiXK8SIoSecretProviderClassV1Resource,
-		//secrets_store_csi_x_k8s_io_v1alpha1.NewSecretsStoreCsiXK8SIoSecretProviderClassV1Alpha1Resource,
-		//secretsmanager_services_k8s_aws_v1alpha1.NewSecretsmanagerServicesK8SAwsSecretV1Alpha1Resource,
-		//secscan_quay_redhat_com_v1alpha1.NewSecscanQuayRedhatComImageManifestVulnV1Alpha1Resource,
-		//security_istio_io_v1.NewSecurityIstioIoAuthorizationPolicyV1Resource,
-		//security_istio_io_v1.NewSecurityIstioIoPeerAuthenticationV1Resource,
-		//security_istio_io_v1.NewSecurityIstioIoRequestAuthenticationV1Resource,
-		//security_istio_io_v1beta1.NewSecurityIstioIoAuthorizationPolicyV1Beta1Resource,
-		//security_istio_io_v1beta1.NewSecurityIstioIoPeerAuthenticationV1Beta1Resource,
-		//security_istio_io_v1beta1.NewSecurityIstioIoRequestAuthenticationV1Beta1Resource,
-		//security_profiles_operator_x_k8s_io_v1alpha1.NewSecurityProfilesOperatorXK8SIoAppArmorProfileV1Alpha1Resource,
-		//security_profiles_operator_x_k8s_io_v1alpha1.NewSecurityProfilesOperatorXK8SIoProfileBindingV1Alpha1Resource,
-		//security_profiles_operator_x_k8s_io_v1alpha1.NewSecurityProfilesOperatorXK8SIoProfileRecordingV1Alpha1Resource,
-		//security_profiles_operator_x_k8s_io_v1alpha1.NewSecurityProfilesOperatorXK8SIoSecurityProfileNodeStatusV1Alpha1Resource,
-		//security_profiles_operator_x_k8s_io_v1alpha1.NewSecurityProfilesOperatorXK8SIoSecurityProfilesOperatorDaemonV1Alpha1Resource,
-		//security_profiles_operator_x_k8s_io_v1alpha2.NewSecurityProfilesOperatorXK8SIoRawSelinuxProfileV1Alpha2Resource,
-		//security_profiles_operator_x_k8s_io_v1alpha2.NewSecurityProfilesOperatorXK8SIoSelinuxProfileV1Alpha2Resource,
-		//security_profiles_operator_x_k8s_io_v1beta1.NewSecurityProfilesOperatorXK8SIoSeccompProfileV1Beta1Resource,
-		//self_node_remediation_medik8s_io_v1alpha1.NewSelfNodeRemediationMedik8SIoSelfNodeRemediationConfigV1Alpha1Resource,
-		//self_node_remediation_medik8s_io_v1alpha1.NewSelfNodeRemediationMedik8SIoSelfNodeRemediationTemplateV1Alpha1Resource,
-		//self_node_remediation_medik8s_io_v1alpha1.NewSelfNodeRemediationMedik8SIoSelfNodeRemediationV1Alpha1Resource,
-		//sematext_com_v1.NewSematextComSematextAgentV1Resource,
-		//servicebinding_io_v1alpha3.NewServicebindingIoClusterWorkloadResourceMappingV1Alpha3Resource,
-		//servicebinding_io_v1alpha3.NewServicebindingIoServiceBindingV1Alpha3Resource,
-		//servicebinding_io_v1beta1.NewServicebindingIoClusterWorkloadResourceMappingV1Beta1Resource,
-		//servicebinding_io_v1beta1.NewServicebindingIoServiceBindingV1Beta1Resource,
-		//servicemesh_cisco_com_v1alpha1.NewServicemeshCiscoComIstioControlPlaneV1Alpha1Resource,
-		//servicemesh_cisco_com_v1alpha1.NewServicemeshCiscoComIstioMeshGatewayV1Alpha1Resource,
-		//servicemesh_cisco_com_v1alpha1.NewServicemeshCiscoComIstioMeshV1Alpha1Resource,
-		//servicemesh_cisco_com_v1alpha1.NewServicemeshCiscoComPeerIstioControlPlaneV1Alpha1Resource,
-		//services_k8s_aws_v1alpha1.NewServicesK8SAwsAdoptedResourceV1Alpha1Resource,
-		//services_k8s_aws_v1alpha1.NewServicesK8SAwsFieldExportV1Alpha1Resource,
-		//serving_kubedl_io_v1alpha1.NewServingKubedlIoInferenceV1Alpha1Resource,
-		//sfn_services_k8s_aws_v1alpha1.NewSfnServicesK8SAwsActivityV1Alpha1Resource,
-		//sfn_services_k8s_aws_v1alpha1.NewSfnServicesK8SAwsStateMachineV1Alpha1Resource,
-		//site_superedge_io_v1alpha1.NewSiteSuperedgeIoNodeGroupV1Alpha1Resource,
-		//site_superedge_io_v1alpha1.NewSiteSuperedgeIoNodeUnitV1Alpha1Resource,
-		//slo_koordinator_sh_v1alpha1.NewSloKoordinatorShNodeMetricV1Alpha1Resource,
-		//slo_koordinator_sh_v1alpha1.NewSloKoordinatorShNodeSloV1Alpha1Resource,
-		//sloth_slok_dev_v1.NewSlothSlokDevPrometheusServiceLevelV1Resource,
-		//snapscheduler_backube_v1.NewSnapschedulerBackubeSnapshotScheduleV1Resource,
-		//snapshot_storage_k8s_io_v1.NewSnapshotStorageK8SIoVolumeSnapshotClassV1Resource,
-		//snapshot_storage_k8s_io_v1.NewSnapshotStorageK8SIoVolumeSnapshotContentV1Resource,
-		//snapshot_storage_k8s_io_v1.NewSnapshotStorageK8SIoVolumeSnapshotV1Resource,
-		//snapshot_storage_k8s_io_v1beta1.NewSnapshotStorageK8SIoVolumeSnapshotClassV1Beta1Resource,
-		//snapshot_storage_k8s_io_v1beta1.NewSnapshotStorageK8SIoVolumeSnapshotContentV1Beta1Resource,
-		//snapshot_storage_k8s_io_v1beta1.NewSnapshotStorageK8SIoVolumeSnapshotV1Beta1Resource,
-		//sns_services_k8s_aws_v1alpha1.NewSnsServicesK8SAwsPlatformApplicationV1Alpha1Resource,
-		//sns_services_k8s_aws_v1alpha1.NewSnsServicesK8SAwsPlatformEndpointV1Alpha1Resource,
-		//sns_services_k8s_aws_v1alpha1.NewSnsServicesK8SAwsSubscriptionV1Alpha1Resource,
-		//sns_services_k8s_aws_v1alpha1.NewSnsServicesK8SAwsTopicV1Alpha1Resource,
-		//sonataflow_org_v1alpha08.NewSonataflowOrgSonataFlowBuildV1Alpha08Resource,
-		//sonataflow_org_v1alpha08.NewSonataflowOrgSonataFlowPlatformV1Alpha08Resource,
-		//sonataflow_org_v1alpha08.NewSonataflowOrgSonataFlowV1Alpha08Resource,
-		//source_toolkit_fluxcd_io_v1.NewSourceToolkitFluxcdIoBucketV1Resource,
-		//source_toolkit_fluxcd_io_v1.NewSourceToolkitFluxcdIoGitRepositoryV1Resource,
-		//source_toolkit_fluxcd_io_v1.NewSourceToolkitFluxcdIoHelmChartV1Resource,
-		//source_toolkit_fluxcd_io_v1.NewSourceToolkitFluxcdIoHelmRepositoryV1Resource,
-		//source_toolkit_fluxcd_io_v1beta1.NewSourceToolkitFluxcdIoBucketV1Beta1Resource,
-		//source_toolkit_fluxcd_io_v1beta1.NewSourceToolkitFluxcdIoGitRepositoryV1Beta1Resource,
-		//source_toolkit_fluxcd_io_v1beta1.NewSourceToolkitFluxcdIoHelmChartV1Beta1Resource,
-		//source_toolkit_fluxcd_io_v1beta1.NewSourceToolkitFluxcdIoHelmRepositoryV1Beta1Resource,
-		//source_toolkit_fluxcd_io_v1beta2.NewSourceToolkitFluxcdIoBucketV1Beta2Resource,
-		//source_toolkit_fluxcd_io_v1beta2.NewSourceToolkitFluxcdIoGitRepositoryV1Beta2Resource,
-		//source_toolkit_fluxcd_io_v1beta2.NewSourceToolkitFluxcdIoHelmChartV1Beta2Resource,
-		//source_toolkit_fluxcd_io_v1beta2.NewSourceToolkitFluxcdIoHelmRepositoryV1Beta2Resource,
-		//source_toolkit_fluxcd_io_v1beta2.NewSourceToolkitFluxcdIoOcirepositoryV1Beta2Resource,
-		//spark_stackable_tech_v1alpha1.NewSparkStackableTechSparkApplicationV1Alpha1Resource,
-		//spark_stackable_tech_v1alpha1.NewSparkStackableTechSparkHistoryServerV1Alpha1Resource,
-		//sparkoperator_k8s_io_v1beta2.NewSparkoperatorK8SIoScheduledSparkApplicationV1Beta2Resource,
-		//sparkoperator_k8s_io_v1beta2.NewSparkoperatorK8SIoSparkApplicationV1Beta2Resource,
-		//spv_no_v1.NewSpvNoAzureKeyVaultSecretV1Resource,
-		//spv_no_v1alpha1.NewSpvNoAzureKeyVaultIdentityV1Alpha1Resource,
-		//spv_no_v1alpha1.NewSpvNoAzureKeyVaultSecretV1Alpha1Resource,
-		//spv_no_v1alpha1.NewSpvNoAzureManagedIdentityV1Alpha1Resource,
-		//spv_no_v2alpha1.NewSpvNoAzureKeyVaultSecretV2Alpha1Resource,
-		//spv_no_v2beta1.NewSpvNoAzureKeyVaultSecretV2Beta1Resource,
-		//sqs_services_k8s_aws_v1alpha1.NewSqsServicesK8SAwsQueueV1Alpha1Resource,
-		//sriovnetwork_openshift_io_v1.NewSriovnetworkOpenshiftIoOvsnetworkV1Resource,
-		//sriovnetwork_openshift_io_v1.NewSriovnetworkOpenshiftIoSriovIbnetworkV1Resource,
-		//sriovnetwork_openshift_io_v1.NewSriovnetworkOpenshiftIoSriovNetworkNodePolicyV1Resource,
-		//sriovnetwork_openshift_io_v1.NewSriovnetworkOpenshiftIoSriovNetworkNodeStateV1Resource,
-		//sriovnetwork_openshift_io_v1.NewSriovnetworkOpenshiftIoSriovNetworkPoolConfigV1Resource,
-		//sriovnetwork_openshift_io_v1.NewSriovnetworkOpenshiftIoSriovNetworkV1Resource,
-		//sriovnetwork_openshift_io_v1.NewSriovnetworkOpenshiftIoSriovOperatorConfigV1Resource,
-		//storage_k8s_io_v1.NewStorageK8SIoCsidriverV1Resource,
-		//storage_k8s_io_v1.NewStorageK8SIoCsinodeV1Resource,
-		//storage_k8s_io_v1.NewStorageK8SIoStorageClassV1Resource,
-		//storage_k8s_io_v1.NewStorageK8SIoVolumeAttachmentV1Resource,
-		//storage_kubeblocks_io_v1alpha1.NewStorageKubeblocksIoStorageProviderV1Alpha1Resource,
-		//storageos_com_v1.NewStorageosComStorageOsclusterV1Resource,
-		//sts_min_io_v1alpha1.NewStsMinIoPolicyBindingV1Alpha1Resource,
-		//sts_min_io_v1beta1.NewStsMinIoPolicyBindingV1Beta1Resource,
-		//stunner_l7mp_io_v1.NewStunnerL7MpIoDataplaneV1Resource,
-		//stunner_l7mp_io_v1.NewStunnerL7MpIoGatewayConfigV1Resource,
-		//stunner_l7mp_io_v1.NewStunnerL7MpIoStaticServiceV1Resource,
-		//stunner_l7mp_io_v1.NewStunnerL7MpIoUdprouteV1Resource,
-		//stunner_l7mp_io_v1alpha1.NewStunnerL7MpIoDataplaneV1Alpha1Resource,
-		//stunner_l7mp_io_v1alpha1.NewStunnerL7MpIoGatewayConfigV1Alpha1Resource,
-		//stunner_l7mp_io_v1alpha1.NewStunnerL7MpIoStaticServiceV1Alpha1Resource,
-		//submariner_io_v1alpha1.NewSubmarinerIoBrokerV1Alpha1Resource,
-		//submariner_io_v1alpha1.NewSubmarinerIoServiceDiscoveryV1Alpha1Resource,
-		//submariner_io_v1alpha1.NewSubmarinerIoSubmarinerV1Alpha1Resource,
-		//superset_stackable_tech_v1alpha1.NewSupersetStackableTechDruidConnectionV1Alpha1Resource,
-		//superset_stackable_tech_v1alpha1.NewSupersetStackableTechSupersetClusterV1Alpha1Resource,
-		//telemetry_istio_io_v1.NewTelemetryIstioIoTelemetryV1Resource,
-		//telemetry_istio_io_v1alpha1.NewTelemetryIstioIoTelemetryV1Alpha1Resource,
-		//templates_gatekeeper_sh_v1.NewTemplatesGatekeeperShConstraintTemplateV1Resource,
-		//templates_gatekeeper_sh_v1alpha1.NewTemplatesGatekeeperShConstraintTemplateV1Alpha1Resource,
-		//templates_gatekeeper_sh_v1beta1.NewTemplatesGatekeeperShConstraintTemplateV1Beta1Resource,
-		//tempo_grafana_com_v1alpha1.NewTempoGrafanaComTempoMonolithicV1Alpha1Resource,
-		//tempo_grafana_com_v1alpha1.NewTempoGrafanaComTempoStackV1Alpha1Resource,
-		//temporal_io_v1beta1.NewTemporalIoTemporalClusterClientV1Beta1Resource,
-		//temporal_io_v1beta1.NewTemporalIoTemporalClusterV1Beta1Resource,
-		//temporal_io_v1beta1.NewTemporalIoTemporalNamespaceV1Beta1Resource,
-		//temporal_io_v1beta1.NewTemporalIoTemporalWorkerProcessV1Beta1Resource,
-		//tests_testkube_io_v1.NewTestsTestkubeIoScriptV1Resource,
-		//tests_testkube_io_v1.NewTestsTestkubeIoTestExecutionV1Resource,
-		//tests_testkube_io_v1.NewTestsTestkubeIoTestSourceV1Resource,
-		//tests_testkube_io_v1.NewTestsTestkubeIoTestSuiteExecutionV1Resource,
-		//tests_testkube_io_v1.NewTestsTestkubeIoTestSuiteV1Resource,
-		//tests_testkube_io_v1.NewTestsTestkubeIoTestTriggerV1Resource,
-		//tests_testkube_io_v1.NewTestsTestkubeIoTestV1Resource,
-		//tests_testkube_io_v2.NewTestsTestkubeIoScriptV2Resource,
-		//tests_testkube_io_v2.NewTestsTestkubeIoTestSuiteV2Resource,
-		//tests_testkube_io_v2.NewTestsTestkubeIoTestV2Resource,
-		//tests_testkube_io_v3.NewTestsTestkubeIoTestSuiteV3Resource,
-		//tests_testkube_io_v3.NewTestsTestkubeIoTestV3Resource,
-		//tf_tungsten_io_v1alpha1.NewTfTungstenIoAnalyticsAlarmV1Alpha1Resource,
-		//tf_tungsten_io_v1alpha1.NewTfTungstenIoAnalyticsSnmpV1Alpha1Resource,
-		//tf_tungsten_io_v1alpha1.NewTfTungstenIoAnalyticsV1Alpha1Resource,
-		//tf_tungsten_io_v1alpha1.NewTfTungstenIoCassandraV1Alpha1Resource,
-		//tf_tungsten_io_v1alpha1.NewTfTungstenIoConfigV1Alpha1Resource,
-		//tf_tungsten_io_v1alpha1.NewTfTungstenIoControlV1Alpha1Resource,
-		//tf_tungsten_io_v1alpha1.NewTfTungstenIoKubemanagerV1Alpha1Resource,
-		//tf_tungsten_io_v1alpha1.NewTfTungstenIoManagerV1Alpha1Resource,
-		//tf_tungsten_io_v1alpha1.NewTfTungstenIoQueryEngineV1Alpha1Resource,
-		//tf_tungsten_io_v1alpha1.NewTfTungstenIoRabbitmqV1Alpha1Resource,
-		//tf_tungsten_io_v1alpha1.NewTfTungstenIoRedisV1Alpha1Resource,
-		//tf_tungsten_io_v1alpha1.NewTfTungstenIoVrouterV1Alpha1Resource,
-		//tf_tungsten_io_v1alpha1.NewTfTungstenIoWebuiV1Alpha1Resource,
-		//tf_tungsten_io_v1alpha1.NewTfTungstenIoZookeeperV1Alpha1Resource,
-		//theketch_io_v1beta1.NewTheketchIoAppV1Beta1Resource,
-		//theketch_io_v1beta1.NewTheketchIoJobV1Beta1Resource,
-		//tinkerbell_org_v1alpha1.NewTinkerbellOrgHardwareV1Alpha1Resource,
-		//tinkerbell_org_v1alpha1.NewTinkerbellOrgOsieV1Alpha1Resource,
-		//tinkerbell_org_v1alpha1.NewTinkerbellOrgStackV1Alpha1Resource,
-		//tinkerbell_org_v1alpha1.NewTinkerbellOrgTemplateV1Alpha1Resource,
-		//tinkerbell_org_v1alpha1.NewTinkerbellOrgWorkflowV1Alpha1Resource,
-		//tinkerbell_org_v1alpha2.NewTinkerbellOrgHardwareV1Alpha2Resource,
-		//tinkerbell_org_v1alpha2.NewTinkerbellOrgOsieV1Alpha2Resource,
-		//tinkerbell_org_v1alpha2.NewTinkerbellOrgTemplateV1Alpha2Resource,
-		//tinkerbell_org_v1alpha2.NewTinkerbellOrgWorkflowV1Alpha2Resource,
-		//topology_node_k8s_io_v1alpha1.NewTopologyNodeK8SIoNodeResourceTopologyV1Alpha1Resource,
-		//topolvm_cybozu_com_v1.NewTopolvmCybozuComLogicalVolumeV1Resource,
-		//topolvm_cybozu_com_v2.NewTopolvmCybozuComTopolvmClusterV2Resource,
-		//traefik_io_v1alpha1.NewTraefikIoIngressRouteTcpV1Alpha1Resource,
-		//traefik_io_v1alpha1.NewTraefikIoIngressRouteUdpV1Alpha1Resource,
-		//traefik_io_v1alpha1.NewTraefikIoIngressRouteV1Alpha1Resource,
-		//traefik_io_v1alpha1.NewTraefikIoMiddlewareTcpV1Alpha1Resource,
-		//traefik_io_v1alpha1.NewTraefikIoMiddlewareV1Alpha1Resource,
-		//traefik_io_v1alpha1.NewTraefikIoServersTransportTcpV1Alpha1Resource,
-		//traefik_io_v1alpha1.NewTraefikIoServersTransportV1Alpha1Resource,
-		//traefik_io_v1alpha1.NewTraefikIoTlsoptionV1Alpha1Resource,
-		//traefik_io_v1alpha1.NewTraefikIoTlsstoreV1Alpha1Resource,
-		//traefik_io_v1alpha1.NewTraefikIoTraefikServiceV1Alpha1Resource,
-		//training_kubedl_io_v1alpha1.NewTrainingKubedlIoElasticDljobV1Alpha1Resource,
-		//training_kubedl_io_v1alpha1.NewTrainingKubedlIoMarsJobV1Alpha1Resource,
-		//training_kubedl_io_v1alpha1.NewTrainingKubedlIoMpijobV1Alpha1Resource,
-		//training_kubedl_io_v1alpha1.NewTrainingKubedlIoPyTorchJobV1Alpha1Resource,
-		//training_kubedl_io_v1alpha1.NewTrainingKubedlIoTfjobV1Alpha1Resource,
-		//training_kubedl_io_v1alpha1.NewTrainingKubedlIoXdljobV1Alpha1Resource,
-		//training_kubedl_io_v1alpha1.NewTrainingKubedlIoXgboostJobV1Alpha1Resource,
-		//trino_stackable_tech_v1alpha1.NewTrinoStackableTechTrinoCatalogV1Alpha1Resource,
-		//trino_stackable_tech_v1alpha1.NewTrinoStackableTechTrinoClusterV1Alpha1Resource,
-		//trust_cert_manager_io_v1alpha1.NewTrustCertManagerIoBundleV1Alpha1Resource,
-		//upgrade_cattle_io_v1.NewUpgradeCattleIoPlanV1Resource,
-		//upgrade_managed_openshift_io_v1alpha1.NewUpgradeManagedOpenshiftIoUpgradeConfigV1Alpha1Resource,
-		//velero_io_v1.NewVeleroIoBackupRepositoryV1Resource,
-		//velero_io_v1.NewVeleroIoBackupStorageLocationV1Resource,
-		//velero_io_v1.NewVeleroIoBackupV1Resource,
-		//velero_io_v1.NewVeleroIoDeleteBackupRequestV1Resource,
-		//velero_io_v1.NewVeleroIoDownloadRequestV1Resource,
-		//velero_io_v1.NewVeleroIoPodVolumeBackupV1Resource,
-		//velero_io_v1.NewVeleroIoPodVolumeRestoreV1Resource,
-		//velero_io_v1.NewVeleroIoRestoreV1Resource,
-		//velero_io_v1.NewVeleroIoScheduleV1Resource,
-		//velero_io_v1.NewVeleroIoServerStatusRequestV1Resource,
-		//velero_io_v1.NewVeleroIoVolumeSnapshotLocationV1Resource,
-		//velero_io_v2alpha1.NewVeleroIoDataDownloadV2Alpha1Resource,
-		//velero_io_v2alpha1.NewVeleroIoDataUploadV2Alpha1Resource,
-		//virt_virtink_smartx_com_v1alpha1.NewVirtVirtinkSmartxComVirtualMachineMigrationV1Alpha1Resource,
-		//virt_virtink_smartx_com_v1alpha1.NewVirtVirtinkSmartxComVirtualMachineV1Alpha1Resource,
-		//volsync_backube_v1alpha1.NewVolsyncBackubeReplicationDestinationV1Alpha1Resource,
-		//volsync_backube_v1alpha1.NewVolsyncBackubeReplicationSourceV1Alpha1Resource,
-		//vpcresources_k8s_aws_v1alpha1.NewVpcresourcesK8SAwsCninodeV1Alpha1Resource,
-		//vpcresources_k8s_aws_v1beta1.NewVpcresourcesK8SAwsSecurityGroupPolicyV1Beta1Resource,
-		//wgpolicyk8s_io_v1alpha1.NewWgpolicyk8SIoClusterPolicyReportV1Alpha1Resource,
-		//wgpolicyk8s_io_v1alpha1.NewWgpolicyk8SIoPolicyReportV1Alpha1Resource,
-		//wgpolicyk8s_io_v1alpha2.NewWgpolicyk8SIoClusterPolicyReportV1Alpha2Resource,
-		//wgpolicyk8s_io_v1alpha2.NewWgpolicyk8SIoPolicyReportV1Alpha2Resource,
-		//wgpolicyk8s_io_v1beta1.NewWgpolicyk8SIoClusterPolicyReportV1Beta1Resource,
-		//wgpolicyk8s_io_v1beta1.NewWgpolicyk8SIoPolicyReportV1Beta1Resource,
-		//wildfly_org_v1alpha1.NewWildflyOrgWildFlyServerV1Alpha1Resource,
-		//work_karmada_io_v1alpha1.NewWorkKarmadaIoClusterResourceBindingV1Alpha1Resource,
-		//work_karmada_io_v1alpha1.NewWorkKarmadaIoResourceBindingV1Alpha1Resource,
-		//work_karmada_io_v1alpha1.NewWorkKarmadaIoWorkV1Alpha1Resource,
-		//work_karmada_io_v1alpha2.NewWorkKarmadaIoClusterResourceBindingV1Alpha2Resource,
-		//work_karmada_io_v1alpha2.NewWorkKarmadaIoResourceBindingV1Alpha2Resource,
-		//workload_codeflare_dev_v1beta1.NewWorkloadCodeflareDevAppWrapperV1Beta1Resource,
-		//workload_codeflare_dev_v1beta1.NewWorkloadCodeflareDevSchedulingSpecV1Beta1Resource,
-		//workload_codeflare_dev_v1beta2.NewWorkloadCodeflareDevAppWrapperV1Beta2Resource,
-		//workloads_kubeblocks_io_v1.NewWorkloadsKubeblocksIoInstanceSetV1Resource,
-		//workloads_kubeblocks_io_v1alpha1.NewWorkloadsKubeblocksIoInstanceSetV1Alpha1Resource,
-		//workloads_kubeblocks_io_v1alpha1.NewWorkloadsKubeblocksIoReplicatedStateMachineV1Alpha1Resource,
-		//zonecontrol_k8s_aws_v1.NewZonecontrolK8SAwsZoneAwareUpdateV1Resource,
-		//zonecontrol_k8s_aws_v1.NewZonecontrolK8SAwsZoneDisruptionBudgetV1Resource,
-		//zookeeper_pravega_io_v1beta1.NewZookeeperPravegaIoZookeeperClusterV1Beta1Resource,
-		//zookeeper_stackable_tech_v1alpha1.NewZookeeperStackableTechZookeeperClusterV1Alpha1Resource,
-		//zookeeper_stackable_tech_v1alpha1.NewZookeeperStackableTechZookeeperZnodeV1Alpha1Resource,
-
+func allResources() map[string][]func() resource.Resource {
+	return map[string][]func() resource.Resource{
+		"admissionregistration.k8s.io": {
+			admissionregistration_k8s_io_v1.NewAdmissionregistrationK8SIoMutatingWebhookConfigurationV1Resource,
+			admissionregistration_k8s_io_v1.NewAdmissionregistrationK8SIoValidatingWebhookConfigurationV1Resource,
+		},
+		"apiregistration.k8s.io": {
+			apiregistration_k8s_io_v1.NewApiregistrationK8SIoApiserviceV1Resource,
+		},
+		"apps": {
+			apps_v1.NewAppsDaemonSetV1Resource,
+			apps_v1.NewAppsDeploymentV1Resource,
+			apps_v1.NewAppsReplicaSetV1Resource,
+			apps_v1.NewAppsStatefulSetV1Resource,
+		},
+		"autoscaling": {
+			autoscaling_v1.NewAutoscalingHorizontalPodAutoscalerV1Resource,
+			autoscaling_v2.NewAutoscalingHorizontalPodAutoscalerV2Resource,
+		},
+		"batch": {
+			batch_v1.NewBatchCronJobV1Resource,
+			batch_v1.NewBatchJobV1Resource,
+		},
+		"certificates.k8s.io": {
+			certificates_k8s_io_v1.NewCertificatesK8SIoCertificateSigningRequestV1Resource,
+		},
+		"core": {
+			core_v1.NewConfigMapV1Resource,
+			core_v1.NewEndpointsV1Resource,
+			core_v1.NewLimitRangeV1Resource,
+			core_v1.NewNamespaceV1Resource,
+			core_v1.NewPersistentVolumeClaimV1Resource,
+			core_v1.NewPersistentVolumeV1Resource,
+			core_v1.NewPodV1Resource,
+			core_v1.NewReplicationControllerV1Resource,
+			core_v1.NewSecretV1Resource,
+			core_v1.NewServiceAccountV1Resource,
+			core_v1.NewServiceV1Resource,
+		},
+		"discovery.k8s.io": {
+			discovery_k8s_io_v1.NewDiscoveryK8SIoEndpointSliceV1Resource,
+		},
+		"events.k8s.io": {
+			events_k8s_io_v1.NewEventsK8SIoEventV1Resource,
+		},
+		"flowcontrol.apiserver.k8s.io": {
+			flowcontrol_apiserver_k8s_io_v1beta3.NewFlowcontrolApiserverK8SIoFlowSchemaV1Beta3Resource,
+			flowcontrol_apiserver_k8s_io_v1beta3.NewFlowcontrolApiserverK8SIoPriorityLevelConfigurationV1Beta3Resource,
+		},
+		"networking.k8s.io": {
+			networking_k8s_io_v1.NewNetworkingK8SIoIngressClassV1Resource,
+			networking_k8s_io_v1.NewNetworkingK8SIoIngressV1Resource,
+			networking_k8s_io_v1.NewNetworkingK8SIoNetworkPolicyV1Resource,
+		},
+		"policy": {
+			policy_v1.NewPolicyPodDisruptionBudgetV1Resource,
+		},
+		"rbac.authorization.k8s.io": {
+			rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoClusterRoleBindingV1Resource,
+			rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoClusterRoleV1Resource,
+			rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoRoleBindingV1Resource,
+			rbac_authorization_k8s_io_v1.NewRbacAuthorizationK8SIoRoleV1Resource,
+		},
+		"scheduling.k8s.io": {
+			scheduling_k8s_io_v1.NewSchedulingK8SIoPriorityClassV1Resource,
+		},
+		"storage.k8s.io": {
+			storage_k8s_io_v1.NewStorageK8SIoCsidriverV1Resource,
+			storage_k8s_io_v1.NewStorageK8SIoCsinodeV1Resource,
+			storage_k8s_io_v1.NewStorageK8SIoStorageClassV1Resource,
+			storage_k8s_io_v1.NewStorageK8SIoVolumeAttachmentV1Resource,
+		},
 	}
 }
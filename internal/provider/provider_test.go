@@ -7,8 +7,22 @@ package provider_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
 	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/metio/terraform-provider-k8s/internal/provider"
+	"github.com/metio/terraform-provider-k8s/internal/utilities"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -28,3 +42,449 @@ func TestProvider_Schema(t *testing.T) {
 		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
 	}
 }
+
+func TestProvider_Resources(t *testing.T) {
+	ctx := context.Background()
+
+	t.Setenv("TF_K8S_RESOURCE_GROUPS", "")
+	if resources := provider.New().Resources(ctx); len(resources) != 2 {
+		t.Fatalf("Expected only the generic k8s_object and k8s_manifest_bundle resources without opt-in, got %d", len(resources))
+	}
+
+	t.Setenv("TF_K8S_RESOURCE_GROUPS", "apps, apps")
+	resources := provider.New().Resources(ctx)
+	if len(resources) <= 2 {
+		t.Fatalf("Expected resources for the 'apps' group")
+	}
+	for _, newResource := range resources {
+		metadataResponse := &fwresource.MetadataResponse{}
+		newResource().Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "k8s"}, metadataResponse)
+		if metadataResponse.TypeName != "k8s_object" && metadataResponse.TypeName != "k8s_manifest_bundle" && !strings.HasPrefix(metadataResponse.TypeName, "k8s_apps_") {
+			t.Fatalf("Unexpected resource %s for the 'apps' group", metadataResponse.TypeName)
+		}
+	}
+}
+
+func TestProvider_DataSources(t *testing.T) {
+	ctx := context.Background()
+
+	dataSources := map[string]bool{}
+	for _, newDataSource := range provider.New().DataSources(ctx) {
+		metadataResponse := &fwdatasource.MetadataResponse{}
+		newDataSource().Metadata(ctx, fwdatasource.MetadataRequest{ProviderTypeName: "k8s"}, metadataResponse)
+		dataSources[metadataResponse.TypeName] = true
+	}
+
+	t.Setenv("TF_K8S_RESOURCE_GROUPS", "*")
+	for _, newResource := range provider.New().Resources(ctx) {
+		metadataResponse := &fwresource.MetadataResponse{}
+		newResource().Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "k8s"}, metadataResponse)
+		if metadataResponse.TypeName == "k8s_object" || metadataResponse.TypeName == "k8s_manifest_bundle" {
+			continue
+		}
+		if !dataSources[metadataResponse.TypeName+"_list"] {
+			t.Errorf("Missing list data source for resource %s", metadataResponse.TypeName)
+		}
+	}
+}
+
+func TestProvider_Configure(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("KUBECONFIG", filepath.Join(t.TempDir(), "missing"))
+	t.Setenv("TF_K8S_CONFIG", "")
+	t.Setenv("TF_K8S_CONTEXT", "")
+	t.Setenv("TF_K8S_CLIENT_CERTIFICATE", "")
+	t.Setenv("TF_K8S_CLIENT_KEY", "")
+
+	clientCertificate, clientKey := testClientCertificate(t)
+	clusterConfig := filepath.Join(t.TempDir(), "cluster")
+	if err := os.WriteFile(clusterConfig, []byte(testKubeconfigCluster), 0600); err != nil {
+		t.Fatal(err)
+	}
+	userConfig := filepath.Join(t.TempDir(), "user")
+	if err := os.WriteFile(userConfig, []byte(testKubeconfigUser), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	type testCase struct {
+		attributes  map[string]tftypes.Value
+		environment map[string]string
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"host and token": {
+			attributes: map[string]tftypes.Value{
+				"offline": tftypes.NewValue(tftypes.Bool, false),
+				"host":    tftypes.NewValue(tftypes.String, "https://kubernetes.example.com"),
+				"token":   tftypes.NewValue(tftypes.String, "secret"),
+			},
+			expectError: false,
+		},
+		"host and exec": {
+			attributes: map[string]tftypes.Value{
+				"offline": tftypes.NewValue(tftypes.Bool, false),
+				"host":    tftypes.NewValue(tftypes.String, "https://kubernetes.example.com"),
+				"exec": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+					"api_version": tftypes.String,
+					"command":     tftypes.String,
+					"args":        tftypes.List{ElementType: tftypes.String},
+					"env":         tftypes.Map{ElementType: tftypes.String},
+				}}, map[string]tftypes.Value{
+					"api_version": tftypes.NewValue(tftypes.String, "client.authentication.k8s.io/v1beta1"),
+					"command":     tftypes.NewValue(tftypes.String, "aws"),
+					"args": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "eks"),
+						tftypes.NewValue(tftypes.String, "get-token"),
+					}),
+					"env": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+						"AWS_PROFILE": tftypes.NewValue(tftypes.String, "example"),
+					}),
+				}),
+			},
+			expectError: false,
+		},
+		"insecure host": {
+			attributes: map[string]tftypes.Value{
+				"offline":  tftypes.NewValue(tftypes.Bool, false),
+				"host":     tftypes.NewValue(tftypes.String, "https://kubernetes.example.com"),
+				"insecure": tftypes.NewValue(tftypes.Bool, true),
+			},
+			expectError: false,
+		},
+		"client certificate": {
+			attributes: map[string]tftypes.Value{
+				"offline":            tftypes.NewValue(tftypes.Bool, false),
+				"host":               tftypes.NewValue(tftypes.String, "https://kubernetes.example.com"),
+				"client_certificate": tftypes.NewValue(tftypes.String, clientCertificate),
+				"client_key":         tftypes.NewValue(tftypes.String, clientKey),
+			},
+			expectError: false,
+		},
+		"client key from environment": {
+			attributes: map[string]tftypes.Value{
+				"offline":            tftypes.NewValue(tftypes.Bool, false),
+				"host":               tftypes.NewValue(tftypes.String, "https://kubernetes.example.com"),
+				"client_certificate": tftypes.NewValue(tftypes.String, clientCertificate),
+			},
+			environment: map[string]string{
+				"TF_K8S_CLIENT_KEY": clientKey,
+			},
+			expectError: false,
+		},
+		"client certificate without key": {
+			attributes: map[string]tftypes.Value{
+				"offline":            tftypes.NewValue(tftypes.Bool, false),
+				"host":               tftypes.NewValue(tftypes.String, "https://kubernetes.example.com"),
+				"client_certificate": tftypes.NewValue(tftypes.String, clientCertificate),
+			},
+			expectError: true,
+		},
+		"retry policy": {
+			attributes: map[string]tftypes.Value{
+				"offline":            tftypes.NewValue(tftypes.Bool, false),
+				"host":               tftypes.NewValue(tftypes.String, "https://kubernetes.example.com"),
+				"retry_max_attempts": tftypes.NewValue(tftypes.Number, 3),
+				"retry_backoff":      tftypes.NewValue(tftypes.Number, 0),
+			},
+			expectError: false,
+		},
+		"invalid retry_max_attempts": {
+			attributes: map[string]tftypes.Value{
+				"offline":            tftypes.NewValue(tftypes.Bool, false),
+				"host":               tftypes.NewValue(tftypes.String, "https://kubernetes.example.com"),
+				"retry_max_attempts": tftypes.NewValue(tftypes.Number, 0),
+			},
+			expectError: true,
+		},
+		"rate limits": {
+			attributes: map[string]tftypes.Value{
+				"offline": tftypes.NewValue(tftypes.Bool, false),
+				"host":    tftypes.NewValue(tftypes.String, "https://kubernetes.example.com"),
+				"qps":     tftypes.NewValue(tftypes.Number, 12.5),
+				"burst":   tftypes.NewValue(tftypes.Number, 25),
+			},
+			expectError: false,
+		},
+		"invalid burst": {
+			attributes: map[string]tftypes.Value{
+				"offline": tftypes.NewValue(tftypes.Bool, false),
+				"host":    tftypes.NewValue(tftypes.String, "https://kubernetes.example.com"),
+				"burst":   tftypes.NewValue(tftypes.Number, 0),
+			},
+			expectError: true,
+		},
+		"without host and kubeconfig": {
+			attributes: map[string]tftypes.Value{
+				"offline": tftypes.NewValue(tftypes.Bool, false),
+				"token":   tftypes.NewValue(tftypes.String, "secret"),
+			},
+			expectError: true,
+		},
+		"kubeconfig_raw": {
+			attributes: map[string]tftypes.Value{
+				"offline":        tftypes.NewValue(tftypes.Bool, false),
+				"kubeconfig_raw": tftypes.NewValue(tftypes.String, testKubeconfigCluster+testKubeconfigUser),
+			},
+			expectError: false,
+		},
+		"invalid kubeconfig_raw": {
+			attributes: map[string]tftypes.Value{
+				"offline":        tftypes.NewValue(tftypes.Bool, false),
+				"kubeconfig_raw": tftypes.NewValue(tftypes.String, "clusters: {"),
+			},
+			expectError: true,
+		},
+		"kubeconfig_paths": {
+			attributes: map[string]tftypes.Value{
+				"offline": tftypes.NewValue(tftypes.Bool, false),
+				"kubeconfig_paths": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, clusterConfig),
+					tftypes.NewValue(tftypes.String, userConfig),
+				}),
+			},
+			expectError: false,
+		},
+		"incomplete kubeconfig_paths": {
+			attributes: map[string]tftypes.Value{
+				"offline": tftypes.NewValue(tftypes.Bool, false),
+				"kubeconfig_paths": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, clusterConfig),
+				}),
+			},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for key, value := range test.environment {
+				t.Setenv(key, value)
+			}
+			configureRequest := fwprovider.ConfigureRequest{Config: providerConfig(t, test.attributes)}
+			configureResponse := &fwprovider.ConfigureResponse{}
+			provider.New().Configure(ctx, configureRequest, configureResponse)
+
+			if !configureResponse.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if configureResponse.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", configureResponse.Diagnostics)
+			}
+
+			if !test.expectError {
+				resourceData, ok := configureResponse.ResourceData.(*utilities.ResourceData)
+				if !ok || resourceData.Client == nil {
+					t.Fatalf("expected Kubernetes client, got %+v", configureResponse.ResourceData)
+				}
+			}
+		})
+	}
+}
+
+// testClientCertificate creates a self-signed PEM-encoded client certificate and its private key.
+func testClientCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{SerialNumber: big.NewInt(1)}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privateKey}))
+}
+
+const testKubeconfigCluster = `
+apiVersion: v1
+kind: Config
+current-context: example
+clusters:
+- name: example
+  cluster:
+    server: https://kubernetes.example.com
+contexts:
+- name: example
+  context:
+    cluster: example
+    user: example
+`
+
+const testKubeconfigUser = `
+users:
+- name: example
+  user:
+    token: secret
+`
+
+func TestProvider_ConfigureUnknown(t *testing.T) {
+	ctx := context.Background()
+
+	type testCase struct {
+		attributes      map[string]tftypes.Value
+		deferralAllowed bool
+		expectDeferred  bool
+		expectClient    bool
+	}
+	tests := map[string]testCase{
+		"deferred": {
+			attributes: map[string]tftypes.Value{
+				"offline": tftypes.NewValue(tftypes.Bool, false),
+				"host":    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+			deferralAllowed: true,
+			expectDeferred:  true,
+			expectClient:    false,
+		},
+		"fallback without deferral": {
+			attributes: map[string]tftypes.Value{
+				"offline": tftypes.NewValue(tftypes.Bool, false),
+				"host":    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+			deferralAllowed: false,
+			expectDeferred:  false,
+			expectClient:    false,
+		},
+		"unknown offline": {
+			attributes: map[string]tftypes.Value{
+				"offline": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			},
+			deferralAllowed: true,
+			expectDeferred:  true,
+			expectClient:    false,
+		},
+		"unknown exec argument": {
+			attributes: map[string]tftypes.Value{
+				"offline": tftypes.NewValue(tftypes.Bool, false),
+				"host":    tftypes.NewValue(tftypes.String, "https://kubernetes.example.com"),
+				"exec": tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+					"api_version": tftypes.String,
+					"command":     tftypes.String,
+					"args":        tftypes.List{ElementType: tftypes.String},
+					"env":         tftypes.Map{ElementType: tftypes.String},
+				}}, map[string]tftypes.Value{
+					"api_version": tftypes.NewValue(tftypes.String, "client.authentication.k8s.io/v1beta1"),
+					"command":     tftypes.NewValue(tftypes.String, "aws"),
+					"args": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+					"env": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				}),
+			},
+			deferralAllowed: true,
+			expectDeferred:  true,
+			expectClient:    false,
+		},
+		"unknown retry settings": {
+			attributes: map[string]tftypes.Value{
+				"offline":            tftypes.NewValue(tftypes.Bool, false),
+				"retry_max_attempts": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+				"retry_backoff":      tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			},
+			deferralAllowed: true,
+			expectDeferred:  true,
+			expectClient:    false,
+		},
+		"unknown rate limits": {
+			attributes: map[string]tftypes.Value{
+				"offline": tftypes.NewValue(tftypes.Bool, false),
+				"qps":     tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+				"burst":   tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			},
+			deferralAllowed: true,
+			expectDeferred:  true,
+			expectClient:    false,
+		},
+		"offline mode ignores unknown host": {
+			attributes: map[string]tftypes.Value{
+				"offline": tftypes.NewValue(tftypes.Bool, true),
+				"host":    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			},
+			deferralAllowed: true,
+			expectDeferred:  false,
+			expectClient:    false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			configureRequest := fwprovider.ConfigureRequest{
+				Config: providerConfig(t, test.attributes),
+				ClientCapabilities: fwprovider.ConfigureProviderClientCapabilities{
+					DeferralAllowed: test.deferralAllowed,
+				},
+			}
+			configureResponse := &fwprovider.ConfigureResponse{}
+			provider.New().Configure(ctx, configureRequest, configureResponse)
+
+			if configureResponse.Diagnostics.HasError() {
+				t.Fatalf("got unexpected error: %s", configureResponse.Diagnostics)
+			}
+
+			if deferred := configureResponse.Deferred != nil; deferred != test.expectDeferred {
+				t.Fatalf("expected deferred %t, got %t", test.expectDeferred, deferred)
+			}
+
+			if !test.expectDeferred {
+				resourceData, ok := configureResponse.ResourceData.(*utilities.ResourceData)
+				if !ok {
+					t.Fatalf("expected resource data, got %+v", configureResponse.ResourceData)
+				}
+				if hasClient := resourceData.Client != nil; hasClient != test.expectClient {
+					t.Fatalf("expected client %t, got %t", test.expectClient, hasClient)
+				}
+			}
+		})
+	}
+}
+
+func providerConfig(t *testing.T, attributes map[string]tftypes.Value) tfsdk.Config {
+	ctx := context.Background()
+	schemaResponse := &fwprovider.SchemaResponse{}
+	provider.New().Schema(ctx, fwprovider.SchemaRequest{}, schemaResponse)
+
+	objectType := schemaResponse.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := attributes[name]; ok {
+			values[name] = value
+		} else {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	for name := range attributes {
+		if _, ok := objectType.AttributeTypes[name]; !ok {
+			t.Fatalf("unknown provider attribute %s", name)
+		}
+	}
+
+	return tfsdk.Config{
+		Schema: schemaResponse.Schema,
+		Raw:    tftypes.NewValue(objectType, values),
+	}
+}
+
+func TestProvider_ConfigureUnknownResourceGroup(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("TF_K8S_RESOURCE_GROUPS", "apps, cert-manager.io")
+
+	configureRequest := fwprovider.ConfigureRequest{Config: providerConfig(t, map[string]tftypes.Value{
+		"offline": tftypes.NewValue(tftypes.Bool, true),
+	})}
+	configureResponse := &fwprovider.ConfigureResponse{}
+	provider.New().Configure(ctx, configureRequest, configureResponse)
+
+	if configureResponse.Diagnostics.HasError() {
+		t.Fatalf("got unexpected error: %s", configureResponse.Diagnostics)
+	}
+	warnings := configureResponse.Diagnostics.Warnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "'cert-manager.io'") {
+		t.Fatalf("expected a single warning for the 'cert-manager.io' group, got %v", warnings)
+	}
+}
@@ -7,9 +7,14 @@ package utilities
 
 import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"strings"
 )
 
+// DeletionProtectionAnnotation marks objects which must not be deleted by resources using the 'abandon_if_annotated'
+// delete behavior.
+const DeletionProtectionAnnotation = "terraform-provider-k8s/prevent-destroy"
+
 func MapDeletionPropagation(value string) *v1.DeletionPropagation {
 	var propagation v1.DeletionPropagation
 	switch strings.ToLower(value) {
@@ -24,3 +29,11 @@ func MapDeletionPropagation(value string) *v1.DeletionPropagation {
 	}
 	return &propagation
 }
+
+// IsDeletionProtected reports whether the given object carries the DeletionProtectionAnnotation with a value of 'true'.
+func IsDeletionProtected(object *unstructured.Unstructured) bool {
+	if object == nil {
+		return false
+	}
+	return strings.EqualFold(object.GetAnnotations()[DeletionProtectionAnnotation], "true")
+}
@@ -0,0 +1,55 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"testing"
+)
+
+func TestIsDeletionProtected(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		annotations map[string]string
+		protected   bool
+	}
+	tests := map[string]testCase{
+		"without annotations": {
+			annotations: nil,
+			protected:   false,
+		},
+		"protected": {
+			annotations: map[string]string{DeletionProtectionAnnotation: "true"},
+			protected:   true,
+		},
+		"case insensitive": {
+			annotations: map[string]string{DeletionProtectionAnnotation: "True"},
+			protected:   true,
+		},
+		"disabled": {
+			annotations: map[string]string{DeletionProtectionAnnotation: "false"},
+			protected:   false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			object := &unstructured.Unstructured{Object: map[string]interface{}{}}
+			object.SetAnnotations(test.annotations)
+
+			if actual := IsDeletionProtected(object); actual != test.protected {
+				t.Errorf("expected %t, got %t", test.protected, actual)
+			}
+		})
+	}
+
+	if IsDeletionProtected(nil) {
+		t.Error("expected missing object to be unprotected")
+	}
+}
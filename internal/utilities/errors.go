@@ -9,10 +9,18 @@ import (
 	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	apiMeta "k8s.io/apimachinery/pkg/api/meta"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
 	"net/http"
+	"regexp"
 )
 
+var matchConflictManager = regexp.MustCompile(`conflict with "([^"]*)"`)
+
 func GetNamespacedResourceError(err error, name string, namespace string) diag.Diagnostic {
 	if diagnostic := notFoundGetError(err, fmt.Sprintf("The requested resource cannot be found. "+
 		"Make sure that it does exist in your cluster and you have set the correct name and namespace configured.\n\n"+
@@ -51,6 +59,24 @@ func genericGetError(err error) diag.ErrorDiagnostic {
 	)
 }
 
+func ListResourcesError(err error) diag.ErrorDiagnostic {
+	if k8sErrors.IsBadRequest(err) {
+		return diag.NewErrorDiagnostic(
+			"Invalid List Request",
+			fmt.Sprintf("The Kubernetes API server rejected the list request. "+
+				"Make sure that 'label_selector' and 'field_selector' are valid and that the field selector is "+
+				"supported by this resource.\n\n"+
+				"LIST Error: %s", err.Error()),
+		)
+	}
+	return diag.NewErrorDiagnostic(
+		"Unable to LIST resources",
+		fmt.Sprintf("An unexpected error occurred while listing the resources. "+
+			"Please report this issue to the provider developers.\n\n"+
+			"LIST Error (%T): %s", err, err.Error()),
+	)
+}
+
 func MarshalJsonError(err error) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Unable to marshal response",
@@ -94,6 +120,102 @@ func OfflineProviderError() diag.ErrorDiagnostic {
 	)
 }
 
+func UnknownProviderConfigurationError() diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Unknown Provider Configuration",
+		"This provider cannot connect to a Kubernetes cluster since some of its configuration values are unknown. "+
+			"Either target apply the source of the values first, set the values statically in the configuration, or use a Terraform version which supports deferred actions.",
+	)
+}
+
+func UnknownProviderConfigurationWarning() diag.WarningDiagnostic {
+	return diag.NewWarningDiagnostic(
+		"Unknown Provider Configuration",
+		"This provider cannot connect to a Kubernetes cluster since some of its configuration values are unknown. "+
+			"The last known state is used until the configuration values are known.",
+	)
+}
+
+func UnknownResourceGroupWarning(group string) diag.WarningDiagnostic {
+	return diag.NewWarningDiagnostic(
+		"Unknown Resource Group",
+		fmt.Sprintf("The API group '%s' listed in TF_K8S_RESOURCE_GROUPS has no generated resources and is ignored. "+
+			"Resources are currently generated for the built-in Kubernetes API groups only. "+
+			"Use the generic k8s_object resource to manage objects of other groups, e.g. custom resources.", group),
+	)
+}
+
+func ImportIDError(id string, format string, err error) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Error importing resource",
+		fmt.Sprintf("Expected import identifier with format: '[context:]%s', '[context:]apiVersion/kind/%s' or "+
+			"'[context:]uid=<uid>' Got: %q\n\n"+
+			"Error: %s", format, format, id, err.Error()),
+	)
+}
+
+func ObjectImportIDError(id string, err error) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Error importing resource",
+		fmt.Sprintf("Expected import identifier with format: '[context:]apiVersion/kind/namespace/name' or "+
+			"'[context:]apiVersion/kind/name' Got: %q\n\n"+
+			"Error: %s", id, err.Error()),
+	)
+}
+
+func RESTMappingError(err error, apiVersion string, kind string) diag.ErrorDiagnostic {
+	if apiMeta.IsNoMatchError(err) {
+		return diag.NewErrorDiagnostic(
+			"Unknown Kind",
+			fmt.Sprintf("The Kubernetes API server does not serve the requested kind. "+
+				"Make sure that 'api_version' and 'kind' are spelled correctly and that the matching CRD is installed in your cluster.\n\n"+
+				"API Version: %s\n"+
+				"Kind: %s", apiVersion, kind),
+		)
+	}
+	return diag.NewErrorDiagnostic(
+		"Unable to discover resource",
+		fmt.Sprintf("An unexpected error occurred while resolving the API resource of the requested kind. "+
+			"Please report this issue to the provider developers.\n\n"+
+			"Discovery Error (%T): %s", err, err.Error()),
+	)
+}
+
+func MissingRESTMapperError() diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Discovery Unavailable",
+		"This provider cannot resolve arbitrary kinds since it was configured without a discovery client. "+
+			"Please report this issue to the provider developers.",
+	)
+}
+
+func MissingKindError(kubernetesContext string, resource k8sSchema.GroupVersionResource) diag.ErrorDiagnostic {
+	cluster := "the configured cluster"
+	if kubernetesContext != "" {
+		cluster = fmt.Sprintf("context %s", kubernetesContext)
+	}
+	missing := fmt.Sprintf("API resource %s.%s/%s is not served on %s.", resource.Resource, resource.Group, resource.Version, cluster)
+	if resource.Group == "" {
+		missing = fmt.Sprintf("API resource %s/%s is not served on %s.", resource.Version, resource.Resource, cluster)
+	}
+	return diag.NewErrorDiagnostic(
+		"Missing API Resource",
+		missing+" "+
+			"Custom resources require their CRD to be installed before planning, built-in resources require a Kubernetes "+
+			"version which serves this API version. If the CRD is installed by another resource of this configuration, "+
+			"either target apply that resource first or use a Terraform version which supports deferred actions.",
+	)
+}
+
+func InvalidManifestError(reason string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Manifest",
+		"The supplied manifest cannot be applied to the cluster. "+
+			"Check the error below and adjust your configuration.\n\n"+
+			"Error: "+reason,
+	)
+}
+
 func UnexpectedDataSourceDataError(data any) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Unexpected Data Source Configure Type",
@@ -125,6 +247,120 @@ func PatchError(err error) diag.ErrorDiagnostic {
 	)
 }
 
+// PatchErrors converts errors of PATCH requests into diagnostics. Field manager conflicts and validation errors are
+// reported individually and attached to the offending attribute, all other errors are reported as a single PatchError.
+func PatchErrors(err error) diag.Diagnostics {
+	if diagnostics := fieldErrors(err); len(diagnostics) > 0 {
+		return diagnostics
+	}
+	return diag.Diagnostics{PatchError(err)}
+}
+
+// ObjectPatchErrors works like PatchErrors for untyped objects whose fields have no matching attributes in the schema.
+// All diagnostics are attached to the given attribute holding the object and name the object in their detail, the
+// offending Kubernetes field remains part of the detail of field errors.
+func ObjectPatchErrors(err error, attributePath path.Path, object *unstructured.Unstructured) diag.Diagnostics {
+	diagnostics := fieldErrors(err)
+	if len(diagnostics) == 0 {
+		diagnostics = diag.Diagnostics{PatchError(err)}
+	}
+
+	var objectDiagnostics diag.Diagnostics
+	for _, diagnostic := range diagnostics {
+		objectDiagnostics.AddAttributeError(attributePath, diagnostic.Summary(),
+			fmt.Sprintf("%s\n\nObject: %s", diagnostic.Detail(), describeObject(object)))
+	}
+	return objectDiagnostics
+}
+
+func describeObject(object *unstructured.Unstructured) string {
+	if object.GetNamespace() == "" {
+		return fmt.Sprintf("%s %s %s", object.GetAPIVersion(), object.GetKind(), object.GetName())
+	}
+	return fmt.Sprintf("%s %s %s/%s", object.GetAPIVersion(), object.GetKind(), object.GetNamespace(), object.GetName())
+}
+
+// DryRunPatchErrors works like PatchErrors for PATCH requests sent in dry-run mode.
+func DryRunPatchErrors(err error) diag.Diagnostics {
+	if diagnostics := fieldErrors(err); len(diagnostics) > 0 {
+		return diagnostics
+	}
+	return diag.Diagnostics{DryRunPatchError(err)}
+}
+
+func fieldErrors(err error) diag.Diagnostics {
+	var statusError k8sErrors.APIStatus
+	if !errors.As(err, &statusError) || statusError.Status().Details == nil {
+		return nil
+	}
+	switch statusError.Status().Reason {
+	case meta.StatusReasonConflict:
+		return conflictErrors(statusError.Status().Details.Causes)
+	case meta.StatusReasonInvalid:
+		return invalidFieldErrors(statusError.Status().Details.Causes)
+	default:
+		return nil
+	}
+}
+
+func conflictErrors(causes []meta.StatusCause) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+	for _, cause := range causes {
+		if cause.Type != meta.CauseTypeFieldManagerConflict {
+			continue
+		}
+		manager := "<unknown>"
+		if match := matchConflictManager.FindStringSubmatch(cause.Message); match != nil {
+			manager = match[1]
+		}
+		diagnostics.AddAttributeError(
+			AttributePath(cause.Field),
+			"Field Manager Conflict",
+			fmt.Sprintf("The field is currently managed by another field manager. Either remove the field from your configuration, "+
+				"coordinate with the owner of the field to stop managing it, or set 'force_conflicts' to true to take "+
+				"ownership of the field.\n\n"+
+				"Field: %s\n"+
+				"Manager: %s\n"+
+				"Message: %s", cause.Field, manager, cause.Message),
+		)
+	}
+	return diagnostics
+}
+
+func invalidFieldErrors(causes []meta.StatusCause) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+	for _, cause := range causes {
+		diagnostics.AddAttributeError(
+			AttributePath(cause.Field),
+			"Invalid Attribute Value",
+			fmt.Sprintf("The Kubernetes API server rejected the value of this attribute. "+
+				"Adjust your configuration according to the message below.\n\n"+
+				"Field: %s\n"+
+				"Reason: %s\n"+
+				"Message: %s", cause.Field, cause.Type, cause.Message),
+		)
+	}
+	return diagnostics
+}
+
+func DryRunPatchError(err error) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Unable to PATCH resource in dry-run mode",
+		fmt.Sprintf("The Kubernetes API server rejected the planned resource during a server-side dry-run. "+
+			"Check the error below, adjust your configuration and run plan again.\n\n"+
+			"PATCH Error (%T): %s", err, err.Error()),
+	)
+}
+
+func ManagedFieldsError(err error) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Unable to determine owned fields",
+		fmt.Sprintf("An unexpected error occurred while reading the managed fields of the resource. "+
+			"Please report this issue to the provider developers.\n\n"+
+			"Managed Fields Error (%T): %s", err, err.Error()),
+	)
+}
+
 func DeleteError(err error) diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Unable to DELETE resource",
@@ -134,6 +370,15 @@ func DeleteError(err error) diag.ErrorDiagnostic {
 	)
 }
 
+func DeletionProtectedError() diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Deletion Protected",
+		fmt.Sprintf("The object in the cluster carries the annotation '%s: true' and the resource uses the "+
+			"'abandon_if_annotated' delete behavior, therefore the object will not be deleted. Remove the annotation "+
+			"from the object or change 'delete_behavior' to destroy this resource.", DeletionProtectionAnnotation),
+	)
+}
+
 func WaitTimeoutExceeded() diag.ErrorDiagnostic {
 	return diag.NewErrorDiagnostic(
 		"Wait Timeout Exceeded",
@@ -142,3 +387,58 @@ func WaitTimeoutExceeded() diag.ErrorDiagnostic {
 			"'timeout' parameter to wait a longer period of time.",
 	)
 }
+
+func WaitForUpsertTimeoutExceeded(jsonPath string, expected string, observed string) diag.ErrorDiagnostic {
+	if expected == "" {
+		expected = "<any non-empty value>"
+	}
+	if observed == "" {
+		observed = "<empty>"
+	}
+	return diag.NewErrorDiagnostic(
+		"Wait Timeout Exceeded",
+		fmt.Sprintf("The allocated maximum wait time was exceeded before the JSONPath condition was met. Your resource "+
+			"was applied, but it did not reach the expected state in time. Re-run 'terraform apply' and optionally "+
+			"increase the 'timeout' parameter to wait a longer period of time.\n\n"+
+			"JSONPath: %s\n"+
+			"Expected Value: %s\n"+
+			"Last Observed Value: %s", jsonPath, expected, observed),
+	)
+}
+
+func InvalidJSONPathError(jsonPath string, err error) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid JSONPath Expression",
+		fmt.Sprintf("The JSONPath expression configured in 'wait_for_upsert' cannot be evaluated. "+
+			"Please check the syntax of your expression.\n\n"+
+			"JSONPath: %s\n"+
+			"Error: %s", jsonPath, err.Error()),
+	)
+}
+
+func WaitCancelledError() diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Wait Cancelled",
+		"Waiting for your resource was cancelled before the configured condition was met, e.g. because Terraform was "+
+			"interrupted. Re-run 'terraform apply' to continue waiting for your resource.",
+	)
+}
+
+func WaitForReadyTimeoutExceeded(reason string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Wait Timeout Exceeded",
+		fmt.Sprintf("The allocated maximum wait time was exceeded before your resource became ready. Your resource "+
+			"was applied, but it did not reach a ready state in time. Re-run 'terraform apply' and optionally "+
+			"increase the 'wait_for_ready_timeout' parameter to wait a longer period of time.\n\n"+
+			"Last Observed State: %s", reason),
+	)
+}
+
+func NotReadyError(err error) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Resource Not Ready",
+		"Your resource was applied, but it will not become ready without further intervention. "+
+			"Check the status of your resource in the cluster for more details.\n\n"+
+			"Error: "+err.Error(),
+	)
+}
@@ -0,0 +1,217 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"errors"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"strings"
+	"testing"
+)
+
+func TestPatchErrors(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		err             error
+		expectedPaths   []path.Path
+		expectedDetails []string
+	}
+	tests := map[string]testCase{
+		"generic error": {
+			err:             errors.New("connection refused"),
+			expectedPaths:   []path.Path{path.Empty()},
+			expectedDetails: []string{"connection refused"},
+		},
+		"field manager conflicts": {
+			err: k8sErrors.NewApplyConflict([]meta.StatusCause{
+				{
+					Type:    meta.CauseTypeFieldManagerConflict,
+					Message: `conflict with "kube-controller-manager" using apps/v1`,
+					Field:   ".spec.replicas",
+				},
+				{
+					Type:    meta.CauseTypeFieldManagerConflict,
+					Message: `conflict with "kubectl-client-side-apply" using apps/v1`,
+					Field:   ".metadata.labels.app",
+				},
+			}, "Apply failed with 2 conflicts"),
+			expectedPaths: []path.Path{
+				path.Root("spec").AtName("replicas"),
+				path.Root("metadata").AtName("labels").AtMapKey("app"),
+			},
+			expectedDetails: []string{"kube-controller-manager", "kubectl-client-side-apply"},
+		},
+		"invalid fields": {
+			err: k8sErrors.NewInvalid(k8sSchema.GroupKind{Group: "apps", Kind: "Deployment"}, "example", field.ErrorList{
+				field.Required(field.NewPath("spec", "template", "spec", "containers").Index(0).Child("image"), ""),
+				field.Invalid(field.NewPath("spec", "replicas"), -1, "must be greater than or equal to 0"),
+			}),
+			expectedPaths: []path.Path{
+				path.Root("spec").AtName("template").AtName("spec").AtName("containers").AtListIndex(0).AtName("image"),
+				path.Root("spec").AtName("replicas"),
+			},
+			expectedDetails: []string{"Required value", "must be greater than or equal to 0"},
+		},
+		"conflict without causes": {
+			err:             k8sErrors.NewConflict(k8sSchema.GroupResource{Group: "apps", Resource: "deployments"}, "example", errors.New("object was modified")),
+			expectedPaths:   []path.Path{path.Empty()},
+			expectedDetails: []string{"object was modified"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diagnostics := PatchErrors(test.err)
+			if len(diagnostics) != len(test.expectedPaths) {
+				t.Fatalf("expected %d diagnostics, got %d: %v", len(test.expectedPaths), len(diagnostics), diagnostics)
+			}
+			for index, diagnostic := range diagnostics {
+				if diagnostic.Severity() != diag.SeverityError {
+					t.Errorf("expected error diagnostic, got %v", diagnostic.Severity())
+				}
+				if !strings.Contains(diagnostic.Detail(), test.expectedDetails[index]) {
+					t.Errorf("expected detail to contain %q, got %q", test.expectedDetails[index], diagnostic.Detail())
+				}
+				actualPath := path.Empty()
+				if withPath, ok := diagnostic.(diag.DiagnosticWithPath); ok {
+					actualPath = withPath.Path()
+				}
+				if !actualPath.Equal(test.expectedPaths[index]) {
+					t.Errorf("expected path %s, got %s", test.expectedPaths[index], actualPath)
+				}
+			}
+		})
+	}
+}
+
+func TestObjectPatchErrors(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		err             error
+		object          *unstructured.Unstructured
+		expectedDetails []string
+	}
+	tests := map[string]testCase{
+		"generic error": {
+			err: errors.New("connection refused"),
+			object: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"name": "example", "namespace": "default"},
+			}},
+			expectedDetails: []string{"connection refused\n\nObject: v1 ConfigMap default/example"},
+		},
+		"invalid fields": {
+			err: k8sErrors.NewInvalid(k8sSchema.GroupKind{Group: "apps", Kind: "Deployment"}, "example", field.ErrorList{
+				field.Invalid(field.NewPath("spec", "replicas"), -1, "must be greater than or equal to 0"),
+			}),
+			object: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "example", "namespace": "default"},
+			}},
+			expectedDetails: []string{"Field: spec.replicas"},
+		},
+		"cluster-scoped object": {
+			err: k8sErrors.NewApplyConflict([]meta.StatusCause{
+				{
+					Type:    meta.CauseTypeFieldManagerConflict,
+					Message: `conflict with "kube-controller-manager" using rbac.authorization.k8s.io/v1`,
+					Field:   ".rules",
+				},
+			}, "Apply failed with 1 conflict"),
+			object: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "rbac.authorization.k8s.io/v1",
+				"kind":       "ClusterRole",
+				"metadata":   map[string]interface{}{"name": "system:aggregate-to-admin"},
+			}},
+			expectedDetails: []string{"Object: rbac.authorization.k8s.io/v1 ClusterRole system:aggregate-to-admin"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diagnostics := ObjectPatchErrors(test.err, path.Root("manifest"), test.object)
+			if len(diagnostics) != len(test.expectedDetails) {
+				t.Fatalf("expected %d diagnostics, got %d: %v", len(test.expectedDetails), len(diagnostics), diagnostics)
+			}
+			for index, diagnostic := range diagnostics {
+				if !strings.Contains(diagnostic.Detail(), test.expectedDetails[index]) {
+					t.Errorf("expected detail to contain %q, got %q", test.expectedDetails[index], diagnostic.Detail())
+				}
+				withPath, ok := diagnostic.(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(path.Root("manifest")) {
+					t.Errorf("expected diagnostic attached to manifest, got %v", diagnostic)
+				}
+			}
+		})
+	}
+}
+
+func TestMissingKindError(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		kubernetesContext string
+		resource          k8sSchema.GroupVersionResource
+		expectedDetail    string
+	}
+	tests := map[string]testCase{
+		"custom resource": {
+			kubernetesContext: "production",
+			resource:          k8sSchema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"},
+			expectedDetail:    "API resource certificates.cert-manager.io/v1 is not served on context production.",
+		},
+		"without context": {
+			resource:       k8sSchema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"},
+			expectedDetail: "API resource certificates.cert-manager.io/v1 is not served on the configured cluster.",
+		},
+		"core group": {
+			kubernetesContext: "production",
+			resource:          k8sSchema.GroupVersionResource{Version: "v1", Resource: "configmaps"},
+			expectedDetail:    "API resource v1/configmaps is not served on context production.",
+		},
+		"built-in group": {
+			resource:       k8sSchema.GroupVersionResource{Group: "policy", Version: "v1beta1", Resource: "podsecuritypolicies"},
+			expectedDetail: "API resource podsecuritypolicies.policy/v1beta1 is not served on the configured cluster.",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diagnostic := MissingKindError(test.kubernetesContext, test.resource)
+			if diagnostic.Summary() != "Missing API Resource" {
+				t.Errorf("expected neutral summary, got %q", diagnostic.Summary())
+			}
+			if !strings.HasPrefix(diagnostic.Detail(), test.expectedDetail) {
+				t.Errorf("expected detail to start with %q, got %q", test.expectedDetail, diagnostic.Detail())
+			}
+		})
+	}
+}
+
+func TestImportIDError(t *testing.T) {
+	t.Parallel()
+
+	diagnostic := ImportIDError("default/example/extra", "namespace/name", errors.New("unexpected segment"))
+	if !strings.Contains(diagnostic.Detail(), `Got: "default/example/extra"`) {
+		t.Errorf("expected detail to quote the identifier once, got %q", diagnostic.Detail())
+	}
+}
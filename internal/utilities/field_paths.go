@@ -0,0 +1,76 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/metio/terraform-provider-k8s/internal/naming"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	matchAttributeName = regexp.MustCompile(`^[a-zA-Z0-9$-][a-zA-Z0-9_$-]*$`)
+)
+
+// AttributePath translates a Kubernetes field path like '.spec.minReadySeconds' or
+// 'spec.template.spec.containers[0].image' into the matching Terraform attribute path of the generated resources.
+// Translation stops at the first segment which cannot be mapped to an attribute, e.g. list item selectors like
+// '[name="app"]', so that the returned path points to the closest known parent attribute.
+func AttributePath(fieldPath string) path.Path {
+	attributePath := path.Empty()
+	remaining := strings.TrimPrefix(fieldPath, ".")
+	for depth := 0; remaining != ""; depth++ {
+		if depth == 2 && isMetadataMap(attributePath) && !strings.HasPrefix(remaining, "[") {
+			return attributePath.AtMapKey(remaining)
+		}
+
+		var segment string
+		segment, remaining = nextSegment(remaining)
+		switch {
+		case strings.HasPrefix(segment, "["):
+			key := strings.TrimSuffix(strings.TrimPrefix(segment, "["), "]")
+			if index, err := strconv.Atoi(key); err == nil {
+				attributePath = attributePath.AtListIndex(index)
+			} else if !strings.Contains(key, "=") {
+				attributePath = attributePath.AtMapKey(key)
+			} else {
+				return attributePath
+			}
+		case matchAttributeName.MatchString(segment):
+			attributePath = attributePath.AtName(naming.TerraformAttributeName(segment, depth == 0))
+		default:
+			return attributePath
+		}
+	}
+	return attributePath
+}
+
+// nextSegment splits off the first field name or bracketed subscript of the given field path.
+func nextSegment(fieldPath string) (string, string) {
+	if strings.HasPrefix(fieldPath, "[") {
+		inQuotes := false
+		for index, char := range fieldPath {
+			switch {
+			case char == '"':
+				inQuotes = !inQuotes
+			case char == ']' && !inQuotes:
+				return fieldPath[:index+1], strings.TrimPrefix(fieldPath[index+1:], ".")
+			}
+		}
+		return fieldPath, ""
+	}
+	if end := strings.IndexAny(fieldPath, ".["); end >= 0 {
+		return fieldPath[:end], strings.TrimPrefix(fieldPath[end:], ".")
+	}
+	return fieldPath, ""
+}
+
+func isMetadataMap(attributePath path.Path) bool {
+	return attributePath.Equal(path.Root("metadata").AtName("labels")) ||
+		attributePath.Equal(path.Root("metadata").AtName("annotations"))
+}
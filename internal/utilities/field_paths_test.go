@@ -0,0 +1,80 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"testing"
+)
+
+func TestAttributePath(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		fieldPath string
+		expected  path.Path
+	}
+	tests := map[string]testCase{
+		"simple field": {
+			fieldPath: ".spec.replicas",
+			expected:  path.Root("spec").AtName("replicas"),
+		},
+		"camel case": {
+			fieldPath: ".spec.minReadySeconds",
+			expected:  path.Root("spec").AtName("min_ready_seconds"),
+		},
+		"abbreviations": {
+			fieldPath: ".spec.podCIDR",
+			expected:  path.Root("spec").AtName("pod_cidr"),
+		},
+		"special prefixes": {
+			fieldPath: ".spec.$ref.-dashed",
+			expected:  path.Root("spec").AtName("dollarref").AtName("dashed"),
+		},
+		"without leading dot": {
+			fieldPath: "metadata.name",
+			expected:  path.Root("metadata").AtName("name"),
+		},
+		"label key": {
+			fieldPath: ".metadata.labels.app.kubernetes.io/name",
+			expected:  path.Root("metadata").AtName("labels").AtMapKey("app.kubernetes.io/name"),
+		},
+		"keyed list item": {
+			fieldPath: `.spec.containers[name="app"].image`,
+			expected:  path.Root("spec").AtName("containers"),
+		},
+		"list index": {
+			fieldPath: "spec.template.spec.containers[0].image",
+			expected:  path.Root("spec").AtName("template").AtName("spec").AtName("containers").AtListIndex(0).AtName("image"),
+		},
+		"nested list indices": {
+			fieldPath: "spec.rules[1].http.paths[2].backend",
+			expected:  path.Root("spec").AtName("rules").AtListIndex(1).AtName("http").AtName("paths").AtListIndex(2).AtName("backend"),
+		},
+		"map key subscript": {
+			fieldPath: "spec.nodeSelector[kubernetes.io/os]",
+			expected:  path.Root("spec").AtName("node_selector").AtMapKey("kubernetes.io/os"),
+		},
+		"label key subscript": {
+			fieldPath: "metadata.labels[app.kubernetes.io/name]",
+			expected:  path.Root("metadata").AtName("labels").AtMapKey("app.kubernetes.io/name"),
+		},
+		"renamed root attribute": {
+			fieldPath: ".provisioner",
+			expected:  path.Root("k8s_provisioner"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := AttributePath(test.fieldPath); !actual.Equal(test.expected) {
+				t.Errorf("expected %s, got %s", test.expected, actual)
+			}
+		})
+	}
+}
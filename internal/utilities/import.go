@@ -0,0 +1,214 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"regexp"
+	"strings"
+)
+
+var coreVersion = regexp.MustCompile(`^v[0-9]+((alpha|beta)[0-9]+)?$`)
+
+// ImportID is the parsed form of an import identifier. Only the name (and the namespace for namespaced resources) or
+// the UID are required, all other fields are optional and empty if not specified.
+type ImportID struct {
+	Context    string
+	APIVersion string
+	Kind       string
+	Namespace  string
+	Name       string
+	UID        string
+}
+
+// ParseImportID parses import identifiers with the format '[context:]namespace/name' for namespaced resources and
+// '[context:]name' for cluster-scoped resources. The object can optionally be prefixed with its apiVersion and kind,
+// e.g. 'apps/v1/Deployment/namespace/name', or replaced by its UID, e.g. 'uid=<uid>'. Since both kubeconfig contexts,
+// e.g. EKS cluster ARNs, and names, e.g. 'system:aggregate-to-admin', may contain colons, a prefix is only split off
+// as context if it matches the given active context or if the identifier is not a valid object without it.
+func ParseImportID(id string, namespaced bool, activeContext string) (ImportID, error) {
+	if index := strings.LastIndex(id, "uid="); index == 0 || (index > 0 && id[index-1] == ':') {
+		importID := ImportID{UID: id[index+len("uid="):]}
+		if index > 0 {
+			importID.Context = id[:index-1]
+			if importID.Context == "" {
+				return ImportID{}, errors.New("context prefix must not be empty")
+			}
+		}
+		if importID.UID == "" {
+			return ImportID{}, errors.New("uid must not be empty")
+		}
+		return importID, nil
+	}
+
+	return splitImportContext(id, activeContext, func(object string) (ImportID, error) {
+		var importID ImportID
+		identifying := 1
+		if namespaced {
+			identifying = 2
+		}
+
+		parts := strings.Split(object, "/")
+		switch len(parts) - identifying {
+		case 0:
+		case 2:
+			importID.APIVersion = parts[0]
+			importID.Kind = parts[1]
+		case 3:
+			importID.APIVersion = parts[0] + "/" + parts[1]
+			importID.Kind = parts[2]
+		default:
+			return ImportID{}, fmt.Errorf("unexpected number of segments in %q", object)
+		}
+
+		if namespaced {
+			importID.Namespace = parts[len(parts)-2]
+		}
+		importID.Name = parts[len(parts)-1]
+
+		return importID, validateSegments(object, parts)
+	})
+}
+
+// ParseObjectImportID parses import identifiers of untyped objects with the format
+// '[context:]apiVersion/kind/namespace/name' for namespaced kinds and '[context:]apiVersion/kind/name' for
+// cluster-scoped kinds. The apiVersion of the core group, e.g. 'v1', is detected by its version format since the
+// scope of the kind is not known before its REST mapping is resolved. Context prefixes are handled like in
+// ParseImportID.
+func ParseObjectImportID(id string, activeContext string) (ImportID, error) {
+	return splitImportContext(id, activeContext, func(object string) (ImportID, error) {
+		var importID ImportID
+		parts := strings.Split(object, "/")
+		if err := validateSegments(object, parts); err != nil {
+			return ImportID{}, err
+		}
+
+		var identifying []string
+		if coreVersion.MatchString(parts[0]) && len(parts) >= 3 {
+			importID.APIVersion = parts[0]
+			importID.Kind = parts[1]
+			identifying = parts[2:]
+		} else if len(parts) >= 4 {
+			importID.APIVersion = parts[0] + "/" + parts[1]
+			importID.Kind = parts[2]
+			identifying = parts[3:]
+		}
+
+		switch {
+		case importID.Kind == "":
+			return ImportID{}, fmt.Errorf("missing apiVersion or kind in %q", object)
+		case len(identifying) == 1:
+			importID.Name = identifying[0]
+		case len(identifying) == 2:
+			importID.Namespace = identifying[0]
+			importID.Name = identifying[1]
+		default:
+			return ImportID{}, fmt.Errorf("unexpected number of segments in %q", object)
+		}
+
+		return importID, nil
+	})
+}
+
+// splitImportContext parses the given identifier as object if it starts with the active context or if it is a valid
+// object on its own. Otherwise, the context is split off at the last colon which is followed by a valid object.
+func splitImportContext(id string, activeContext string, parseObject func(object string) (ImportID, error)) (ImportID, error) {
+	if activeContext != "" {
+		if object, found := strings.CutPrefix(id, activeContext+":"); found {
+			if importID, err := parseObject(object); err == nil {
+				importID.Context = activeContext
+				return importID, nil
+			}
+		}
+	}
+
+	importID, err := parseObject(id)
+	if err == nil {
+		return importID, nil
+	}
+	for index := strings.LastIndex(id, ":"); index >= 0; index = strings.LastIndex(id[:index], ":") {
+		contextImportID, contextErr := parseObject(id[index+1:])
+		if contextErr != nil {
+			continue
+		}
+		if index == 0 {
+			return ImportID{}, errors.New("context prefix must not be empty")
+		}
+		contextImportID.Context = id[:index]
+		return contextImportID, nil
+	}
+	return ImportID{}, err
+}
+
+// validateSegments rejects empty segments as well as colons outside the name, which is the last segment, since
+// namespaces, apiVersions, and kinds never contain colons.
+func validateSegments(object string, parts []string) error {
+	for index, part := range parts {
+		if part == "" {
+			return fmt.Errorf("empty segment in %q", object)
+		}
+		if index < len(parts)-1 && strings.Contains(part, ":") {
+			return fmt.Errorf("unexpected colon in segment %q of %q", part, object)
+		}
+	}
+	return nil
+}
+
+// ResolveImportID verifies that the given import identifier matches the resource type and the kubeconfig context of
+// the provider. Identifiers using a UID are resolved into the namespace and name of the matching object, which
+// requires a list request against the cluster.
+func ResolveImportID(ctx context.Context, importID ImportID, client dynamic.Interface, gvr k8sSchema.GroupVersionResource, activeContext string, apiVersion string, kind string) (ImportID, error) {
+	if importID.Context != "" && activeContext != "" && importID.Context != activeContext {
+		return ImportID{}, fmt.Errorf("import identifier targets context %q but the provider is configured for context %q", importID.Context, activeContext)
+	}
+	if importID.APIVersion != "" && importID.APIVersion != apiVersion {
+		return ImportID{}, fmt.Errorf("import identifier targets apiVersion %q but this resource manages %q", importID.APIVersion, apiVersion)
+	}
+	if importID.Kind != "" && importID.Kind != kind {
+		return ImportID{}, fmt.Errorf("import identifier targets kind %q but this resource manages %q", importID.Kind, kind)
+	}
+	if importID.UID == "" {
+		return importID, nil
+	}
+	if client == nil {
+		return ImportID{}, errors.New("importing by uid requires a configured Kubernetes client")
+	}
+
+	object, err := findByUID(ctx, client.Resource(gvr), importID.UID)
+	if err != nil {
+		return ImportID{}, err
+	}
+
+	importID.Namespace = object.GetNamespace()
+	importID.Name = object.GetName()
+	return importID, nil
+}
+
+// findByUID lists all objects with the given UID. Most resources do not support field selectors on 'metadata.uid', in
+// which case the API server rejects the request and the objects are filtered on the client instead.
+func findByUID(ctx context.Context, resource dynamic.NamespaceableResourceInterface, uid string) (*unstructured.Unstructured, error) {
+	list, err := resource.List(ctx, meta.ListOptions{FieldSelector: "metadata.uid=" + uid})
+	if k8sErrors.IsBadRequest(err) {
+		list, err = resource.List(ctx, meta.ListOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range list.Items {
+		if string(list.Items[index].GetUID()) == uid {
+			return &list.Items[index], nil
+		}
+	}
+	return nil, fmt.Errorf("no object with uid %q found", uid)
+}
@@ -0,0 +1,335 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"context"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sTesting "k8s.io/client-go/testing"
+	"testing"
+)
+
+func TestParseImportID(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		id            string
+		namespaced    bool
+		activeContext string
+		expected      ImportID
+		expectError   bool
+	}
+	tests := map[string]testCase{
+		"namespaced": {
+			id:         "default/example",
+			namespaced: true,
+			expected:   ImportID{Namespace: "default", Name: "example"},
+		},
+		"cluster-scoped": {
+			id:       "example",
+			expected: ImportID{Name: "example"},
+		},
+		"context": {
+			id:         "production:default/example",
+			namespaced: true,
+			expected:   ImportID{Context: "production", Namespace: "default", Name: "example"},
+		},
+		"context with colons": {
+			id:         "arn:aws:eks:eu-central-1:123456789012:cluster/production:default/example",
+			namespaced: true,
+			expected:   ImportID{Context: "arn:aws:eks:eu-central-1:123456789012:cluster/production", Namespace: "default", Name: "example"},
+		},
+		"active context": {
+			id:            "production:example",
+			activeContext: "production",
+			expected:      ImportID{Context: "production", Name: "example"},
+		},
+		"active context with colons": {
+			id:            "arn:aws:eks:eu-central-1:123456789012:cluster/production:system:aggregate-to-admin",
+			activeContext: "arn:aws:eks:eu-central-1:123456789012:cluster/production",
+			expected:      ImportID{Context: "arn:aws:eks:eu-central-1:123456789012:cluster/production", Name: "system:aggregate-to-admin"},
+		},
+		"cluster role with colons": {
+			id:       "system:aggregate-to-admin",
+			expected: ImportID{Name: "system:aggregate-to-admin"},
+		},
+		"cluster role binding with colons": {
+			id:            "system:controller:job-controller",
+			activeContext: "production",
+			expected:      ImportID{Name: "system:controller:job-controller"},
+		},
+		"cluster role with colons and context": {
+			id:            "production:system:aggregate-to-admin",
+			activeContext: "production",
+			expected:      ImportID{Context: "production", Name: "system:aggregate-to-admin"},
+		},
+		"cluster role with colons and kind": {
+			id:       "rbac.authorization.k8s.io/v1/ClusterRole/system:aggregate-to-admin",
+			expected: ImportID{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "system:aggregate-to-admin"},
+		},
+		"role binding with colons and context": {
+			id:         "production:kube-system/system:controller:bootstrap-signer",
+			namespaced: true,
+			expected:   ImportID{Context: "production", Namespace: "kube-system", Name: "system:controller:bootstrap-signer"},
+		},
+		"role binding with colons": {
+			id:         "kube-system/system:controller:bootstrap-signer",
+			namespaced: true,
+			expected:   ImportID{Namespace: "kube-system", Name: "system:controller:bootstrap-signer"},
+		},
+		"core apiVersion and kind": {
+			id:         "v1/ConfigMap/default/example",
+			namespaced: true,
+			expected:   ImportID{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "example"},
+		},
+		"grouped apiVersion and kind": {
+			id:       "rbac.authorization.k8s.io/v1/ClusterRole/example",
+			expected: ImportID{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "example"},
+		},
+		"uid": {
+			id:       "uid=0b7f8a9e-3c1d-4e2f-8a5b-6c7d8e9f0a1b",
+			expected: ImportID{UID: "0b7f8a9e-3c1d-4e2f-8a5b-6c7d8e9f0a1b"},
+		},
+		"uid with context": {
+			id:         "production:uid=0b7f8a9e",
+			namespaced: true,
+			expected:   ImportID{Context: "production", UID: "0b7f8a9e"},
+		},
+		"missing namespace": {
+			id:          "example",
+			namespaced:  true,
+			expectError: true,
+		},
+		"empty name": {
+			id:          "default/",
+			namespaced:  true,
+			expectError: true,
+		},
+		"empty context": {
+			id:          ":default/example",
+			namespaced:  true,
+			expectError: true,
+		},
+		"empty uid": {
+			id:          "uid=",
+			expectError: true,
+		},
+		"empty uid context": {
+			id:          ":uid=0b7f8a9e",
+			expectError: true,
+		},
+		"too many segments": {
+			id:          "a/b/c/d/e/f",
+			namespaced:  true,
+			expectError: true,
+		},
+		"empty": {
+			id:          "",
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := ParseImportID(test.id, test.namespaced, test.activeContext)
+			if test.expectError {
+				if err == nil {
+					t.Fatalf("expected error but got %+v", actual)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != test.expected {
+				t.Errorf("expected %+v, got %+v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestResolveImportID(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		importID            ImportID
+		activeContext       string
+		rejectFieldSelector bool
+		expected            ImportID
+		expectError         bool
+	}
+	tests := map[string]testCase{
+		"name": {
+			importID: ImportID{Namespace: "default", Name: "example"},
+			expected: ImportID{Namespace: "default", Name: "example"},
+		},
+		"matching context": {
+			importID:      ImportID{Context: "production", Namespace: "default", Name: "example"},
+			activeContext: "production",
+			expected:      ImportID{Context: "production", Namespace: "default", Name: "example"},
+		},
+		"other context": {
+			importID:      ImportID{Context: "staging", Namespace: "default", Name: "example"},
+			activeContext: "production",
+			expectError:   true,
+		},
+		"other apiVersion": {
+			importID:    ImportID{APIVersion: "v2", Kind: "ConfigMap", Namespace: "default", Name: "example"},
+			expectError: true,
+		},
+		"other kind": {
+			importID:    ImportID{APIVersion: "v1", Kind: "Secret", Namespace: "default", Name: "example"},
+			expectError: true,
+		},
+		"uid": {
+			importID: ImportID{UID: "1234"},
+			expected: ImportID{UID: "1234", Namespace: "default", Name: "example"},
+		},
+		"uid without field selector support": {
+			importID:            ImportID{UID: "1234"},
+			rejectFieldSelector: true,
+			expected:            ImportID{UID: "1234", Namespace: "default", Name: "example"},
+		},
+		"unknown uid": {
+			importID:    ImportID{UID: "5678"},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			object := &unstructured.Unstructured{}
+			object.SetAPIVersion("v1")
+			object.SetKind("ConfigMap")
+			object.SetNamespace("default")
+			object.SetName("example")
+			object.SetUID("1234")
+			fakeClient := newFakeClient()
+			if _, err := fakeClient.Resource(configMaps).Namespace("default").Update(context.Background(), object, meta.UpdateOptions{}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if test.rejectFieldSelector {
+				fakeClient.PrependReactor("list", "configmaps", func(action k8sTesting.Action) (bool, runtime.Object, error) {
+					if action.(k8sTesting.ListAction).GetListRestrictions().Fields.Empty() {
+						return false, nil, nil
+					}
+					return true, nil, k8sErrors.NewBadRequest("field label not supported: metadata.uid")
+				})
+			}
+
+			actual, err := ResolveImportID(context.Background(), test.importID, fakeClient, configMaps, test.activeContext, "v1", "ConfigMap")
+			if test.expectError {
+				if err == nil {
+					t.Fatalf("expected error but got %+v", actual)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != test.expected {
+				t.Errorf("expected %+v, got %+v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestParseObjectImportID(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		id            string
+		activeContext string
+		expected      ImportID
+		expectError   bool
+	}
+	tests := map[string]testCase{
+		"core namespaced": {
+			id:       "v1/ConfigMap/default/example",
+			expected: ImportID{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "example"},
+		},
+		"core cluster-scoped": {
+			id:       "v1/Namespace/example",
+			expected: ImportID{APIVersion: "v1", Kind: "Namespace", Name: "example"},
+		},
+		"grouped namespaced": {
+			id:       "cert-manager.io/v1/Certificate/default/example",
+			expected: ImportID{APIVersion: "cert-manager.io/v1", Kind: "Certificate", Namespace: "default", Name: "example"},
+		},
+		"grouped cluster-scoped": {
+			id:       "apps/v1beta1/Example/example",
+			expected: ImportID{APIVersion: "apps/v1beta1", Kind: "Example", Name: "example"},
+		},
+		"context": {
+			id:       "production:v1/ConfigMap/default/example",
+			expected: ImportID{Context: "production", APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "example"},
+		},
+		"cluster role with colons": {
+			id:       "rbac.authorization.k8s.io/v1/ClusterRole/system:aggregate-to-admin",
+			expected: ImportID{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "system:aggregate-to-admin"},
+		},
+		"cluster role binding with colons and context": {
+			id:       "production:rbac.authorization.k8s.io/v1/ClusterRoleBinding/system:node",
+			expected: ImportID{Context: "production", APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRoleBinding", Name: "system:node"},
+		},
+		"active context with colons": {
+			id:            "arn:aws:eks:eu-central-1:123456789012:cluster/production:rbac.authorization.k8s.io/v1/ClusterRole/system:aggregate-to-admin",
+			activeContext: "arn:aws:eks:eu-central-1:123456789012:cluster/production",
+			expected:      ImportID{Context: "arn:aws:eks:eu-central-1:123456789012:cluster/production", APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole", Name: "system:aggregate-to-admin"},
+		},
+		"missing name": {
+			id:          "v1/ConfigMap",
+			expectError: true,
+		},
+		"missing kind": {
+			id:          "default/example",
+			expectError: true,
+		},
+		"name only": {
+			id:          "example",
+			expectError: true,
+		},
+		"empty segment": {
+			id:          "v1/ConfigMap//example",
+			expectError: true,
+		},
+		"too many segments": {
+			id:          "cert-manager.io/v1/Certificate/default/example/extra",
+			expectError: true,
+		},
+		"empty context": {
+			id:          ":v1/ConfigMap/default/example",
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := ParseObjectImportID(test.id, test.activeContext)
+			if test.expectError {
+				if err == nil {
+					t.Fatalf("expected error but got %+v", actual)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != test.expected {
+				t.Errorf("expected %+v, got %+v", test.expected, actual)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ package utilities
 
 import (
 	"errors"
+	"fmt"
 	"k8s.io/client-go/util/jsonpath"
 	cmdget "k8s.io/kubectl/pkg/cmd/get"
 	"strings"
@@ -37,3 +38,31 @@ func ProcessJSONPathInput(jsonPathExpression, jsonPathCond string) (string, stri
 
 	return relaxedJSONPathExp, jsonPathCond, nil
 }
+
+// JSONPathConditionMet evaluates the parsed jsonpath against the given object and reports whether the condition holds.
+// An empty expected value is satisfied by any non-empty result. The observed value is returned alongside in order
+// to support meaningful error messages.
+func JSONPathConditionMet(jsonPath *jsonpath.JSONPath, object map[string]interface{}, expected string) (bool, string, error) {
+	results, err := jsonPath.FindResults(object)
+	if err != nil {
+		return false, "", err
+	}
+	if len(results) == 0 || len(results[0]) == 0 {
+		return false, "", nil
+	}
+	if len(results) > 1 || len(results[0]) > 1 {
+		return false, "", errors.New("given jsonpath expression matches more than one value")
+	}
+	result := results[0][0].Interface()
+	switch result.(type) {
+	case map[string]interface{}, []interface{}:
+		if expected != "" {
+			return false, "", errors.New("jsonpath leads to a nested object or list which is not supported")
+		}
+	}
+	observed := strings.TrimSpace(fmt.Sprintf("%v", result))
+	if expected == "" {
+		return observed != "", observed, nil
+	}
+	return observed == strings.TrimSpace(expected), observed, nil
+}
@@ -0,0 +1,96 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"testing"
+)
+
+func TestJSONPathConditionMet(t *testing.T) {
+	t.Parallel()
+
+	object := map[string]interface{}{
+		"status": map[string]interface{}{
+			"phase":    "Running",
+			"replicas": int64(3),
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True"},
+			},
+		},
+	}
+
+	type testCase struct {
+		expression  string
+		expected    string
+		met         bool
+		observed    string
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"matching string": {
+			expression: ".status.phase",
+			expected:   "Running",
+			met:        true,
+			observed:   "Running",
+		},
+		"mismatching string": {
+			expression: ".status.phase",
+			expected:   "Succeeded",
+			met:        false,
+			observed:   "Running",
+		},
+		"matching number": {
+			expression: ".status.replicas",
+			expected:   "3",
+			met:        true,
+			observed:   "3",
+		},
+		"filter expression": {
+			expression: `.status.conditions[?(@.type=="Ready")].status`,
+			expected:   "True",
+			met:        true,
+			observed:   "True",
+		},
+		"any value": {
+			expression: ".status.phase",
+			met:        true,
+			observed:   "Running",
+		},
+		"missing key": {
+			expression: ".status.missing",
+			met:        false,
+		},
+		"nested object with value": {
+			expression:  ".status",
+			expected:    "Running",
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			jsonPath, err := NewJSONPathParser(test.expression)
+			if err != nil {
+				t.Fatalf("got unexpected parse error: %s", err)
+			}
+
+			met, observed, err := JSONPathConditionMet(jsonPath, object, test.expected)
+
+			if err == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+			if err != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+			if met != test.met {
+				t.Fatalf("expected met to be %v, got %v", test.met, met)
+			}
+			if observed != test.observed {
+				t.Fatalf("expected observed value %q, got %q", test.observed, observed)
+			}
+		})
+	}
+}
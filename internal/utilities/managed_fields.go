@@ -0,0 +1,159 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"strconv"
+	"strings"
+)
+
+// OwnedFieldsJSON returns the JSON representation of the given object restricted to the fields owned by the given
+// field manager according to 'metadata.managedFields'. Fields owned by other managers are taken from the previous
+// JSON representation of the object instead. Objects without any field owned by the given field manager, e.g. after
+// an import, are returned in full.
+func OwnedFieldsJSON(object *unstructured.Unstructured, fieldManager string, previous []byte) ([]byte, error) {
+	owned, found, err := ownedFieldSet(object.GetManagedFields(), fieldManager)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return object.MarshalJSON()
+	}
+
+	var state map[string]interface{}
+	if err := json.Unmarshal(previous, &state); err != nil {
+		return nil, err
+	}
+	pruned := map[string]interface{}{}
+	if hasChildFields(owned) {
+		pruned, _ = pruneFields(object.Object, owned).(map[string]interface{})
+	}
+	return json.Marshal(mergeFields(state, pruned))
+}
+
+func ownedFieldSet(entries []meta.ManagedFieldsEntry, fieldManager string) (map[string]interface{}, bool, error) {
+	for _, entry := range entries {
+		if entry.Manager != fieldManager || entry.Operation != meta.ManagedFieldsOperationApply || entry.Subresource != "" {
+			continue
+		}
+		if entry.FieldsV1 == nil {
+			return map[string]interface{}{}, true, nil
+		}
+		var fields map[string]interface{}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			return nil, false, fmt.Errorf("cannot decode managed fields of field manager %q: %w", fieldManager, err)
+		}
+		return fields, true, nil
+	}
+	return nil, false, nil
+}
+
+func pruneFields(value interface{}, fields map[string]interface{}) interface{} {
+	if !hasChildFields(fields) {
+		return value
+	}
+
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		pruned := map[string]interface{}{}
+		for key, children := range fields {
+			name, isField := strings.CutPrefix(key, "f:")
+			if !isField {
+				continue
+			}
+			if child, exists := typed[name]; exists {
+				pruned[name] = pruneFields(child, asFields(children))
+			}
+		}
+		return pruned
+	case []interface{}:
+		var pruned []interface{}
+		for index, item := range typed {
+			if children, owned := listItemFields(fields, index, item); owned {
+				pruned = append(pruned, pruneFields(item, children))
+			}
+		}
+		return pruned
+	default:
+		return value
+	}
+}
+
+func listItemFields(fields map[string]interface{}, index int, item interface{}) (map[string]interface{}, bool) {
+	for key, children := range fields {
+		switch {
+		case strings.HasPrefix(key, "i:"):
+			if position, err := strconv.Atoi(key[2:]); err == nil && position == index {
+				return asFields(children), true
+			}
+		case strings.HasPrefix(key, "v:"):
+			var value interface{}
+			if err := json.Unmarshal([]byte(key[2:]), &value); err == nil && jsonEqual(value, item) {
+				return asFields(children), true
+			}
+		case strings.HasPrefix(key, "k:"):
+			var keys map[string]interface{}
+			if err := json.Unmarshal([]byte(key[2:]), &keys); err == nil && matchesKeys(keys, item) {
+				return asFields(children), true
+			}
+		}
+	}
+	return nil, false
+}
+
+func matchesKeys(keys map[string]interface{}, item interface{}) bool {
+	object, ok := item.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	for name, value := range keys {
+		if !jsonEqual(value, object[name]) {
+			return false
+		}
+	}
+	return true
+}
+
+func jsonEqual(left interface{}, right interface{}) bool {
+	leftBytes, leftErr := json.Marshal(left)
+	rightBytes, rightErr := json.Marshal(right)
+	return leftErr == nil && rightErr == nil && bytes.Equal(leftBytes, rightBytes)
+}
+
+func hasChildFields(fields map[string]interface{}) bool {
+	for key := range fields {
+		if key != "." {
+			return true
+		}
+	}
+	return false
+}
+
+func asFields(value interface{}) map[string]interface{} {
+	fields, _ := value.(map[string]interface{})
+	return fields
+}
+
+func mergeFields(target map[string]interface{}, source map[string]interface{}) map[string]interface{} {
+	if target == nil {
+		target = map[string]interface{}{}
+	}
+	for key, value := range source {
+		sourceMap, sourceIsMap := value.(map[string]interface{})
+		targetMap, targetIsMap := target[key].(map[string]interface{})
+		if sourceIsMap && targetIsMap {
+			target[key] = mergeFields(targetMap, sourceMap)
+		} else {
+			target[key] = value
+		}
+	}
+	return target
+}
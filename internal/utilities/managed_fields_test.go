@@ -0,0 +1,154 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"encoding/json"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"reflect"
+	"testing"
+)
+
+func TestOwnedFieldsJSON(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		object        map[string]interface{}
+		managedFields []meta.ManagedFieldsEntry
+		previous      string
+		expected      map[string]interface{}
+	}
+	tests := map[string]testCase{
+		"replicas owned by other manager": {
+			object: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "example"},
+				"spec":     map[string]interface{}{"replicas": int64(5), "paused": true},
+			},
+			managedFields: []meta.ManagedFieldsEntry{
+				managedFieldsEntry("terraform", meta.ManagedFieldsOperationApply, `{"f:spec":{"f:paused":{}}}`),
+				managedFieldsEntry("hpa", meta.ManagedFieldsOperationUpdate, `{"f:spec":{"f:replicas":{}}}`),
+			},
+			previous: `{"metadata":{"name":"example"},"spec":{"replicas":2,"paused":false}}`,
+			expected: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "example"},
+				"spec":     map[string]interface{}{"replicas": float64(2), "paused": true},
+			},
+		},
+		"labels owned by other manager": {
+			object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":   "example",
+					"labels": map[string]interface{}{"app": "example", "injected": "true"},
+				},
+			},
+			managedFields: []meta.ManagedFieldsEntry{
+				managedFieldsEntry("terraform", meta.ManagedFieldsOperationApply, `{"f:metadata":{"f:labels":{".":{},"f:app":{}}}}`),
+			},
+			previous: `{"metadata":{"name":"example","labels":{"app":"old"}}}`,
+			expected: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":   "example",
+					"labels": map[string]interface{}{"app": "example"},
+				},
+			},
+		},
+		"keyed list items": {
+			object: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "app", "image": "app:2", "imagePullPolicy": "Always"},
+						map[string]interface{}{"name": "sidecar", "image": "sidecar:1"},
+					},
+				},
+			},
+			managedFields: []meta.ManagedFieldsEntry{
+				managedFieldsEntry("terraform", meta.ManagedFieldsOperationApply, `{"f:spec":{"f:containers":{"k:{\"name\":\"app\"}":{".":{},"f:name":{},"f:image":{}}}}}`),
+			},
+			previous: `{"spec":{"containers":[{"name":"app","image":"app:1"}]}}`,
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "app", "image": "app:2"},
+					},
+				},
+			},
+		},
+		"set items": {
+			object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"finalizers": []interface{}{"example.com/first", "example.com/second"},
+				},
+			},
+			managedFields: []meta.ManagedFieldsEntry{
+				managedFieldsEntry("terraform", meta.ManagedFieldsOperationApply, `{"f:metadata":{"f:finalizers":{"v:\"example.com/second\"":{}}}}`),
+			},
+			previous: `{"metadata":{}}`,
+			expected: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"finalizers": []interface{}{"example.com/second"},
+				},
+			},
+		},
+		"update operations are ignored": {
+			object: map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": int64(5)},
+			},
+			managedFields: []meta.ManagedFieldsEntry{
+				managedFieldsEntry("terraform", meta.ManagedFieldsOperationUpdate, `{"f:spec":{"f:replicas":{}}}`),
+			},
+			previous: `{"spec":{"replicas":2}}`,
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": float64(5)},
+			},
+		},
+		"imported object": {
+			object: map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": int64(5)},
+			},
+			previous: `{}`,
+			expected: map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": float64(5)},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			object := &unstructured.Unstructured{Object: test.object}
+			object.SetManagedFields(test.managedFields)
+
+			result, err := OwnedFieldsJSON(object, "terraform", []byte(test.previous))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var actual map[string]interface{}
+			if err := json.Unmarshal(result, &actual); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if metadata, ok := actual["metadata"].(map[string]interface{}); ok {
+				delete(metadata, "managedFields")
+				if len(metadata) == 0 && test.expected["metadata"] == nil {
+					delete(actual, "metadata")
+				}
+			}
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func managedFieldsEntry(manager string, operation meta.ManagedFieldsOperationType, fields string) meta.ManagedFieldsEntry {
+	return meta.ManagedFieldsEntry{
+		Manager:   manager,
+		Operation: operation,
+		FieldsV1:  &meta.FieldsV1{Raw: []byte(fields)},
+	}
+}
@@ -0,0 +1,111 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	k8sYaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+	"sort"
+	"strings"
+)
+
+// applyPriorities lists the kinds which other objects of a bundle may depend on. Kinds without a priority are applied
+// afterwards in the order of their documents.
+var applyPriorities = map[k8sSchema.GroupKind]int{
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}: 0,
+	{Group: "", Kind: "Namespace"}:                                    1,
+}
+
+// DecodeManifests splits the given multi-document YAML into its objects. Empty documents are skipped and objects of
+// kind 'List' are expanded into their items. Every object must specify its apiVersion, kind and name.
+func DecodeManifests(content string) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+
+	reader := k8sYaml.NewYAMLReader(bufio.NewReader(strings.NewReader(content)))
+	for index := 0; ; index++ {
+		document, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read document %d: %w", index, err)
+		}
+
+		jsonBytes, err := yaml.YAMLToJSON(document)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse document %d: %w", index, err)
+		}
+		if string(jsonBytes) == "null" {
+			continue
+		}
+
+		object := &unstructured.Unstructured{}
+		if err = object.UnmarshalJSON(jsonBytes); err != nil {
+			return nil, fmt.Errorf("cannot decode document %d: %w", index, err)
+		}
+
+		if object.IsList() {
+			err = object.EachListItem(func(item runtime.Object) error {
+				listItem := item.(*unstructured.Unstructured)
+				if err := validateManifest(listItem); err != nil {
+					return fmt.Errorf("invalid item in document %d: %w", index, err)
+				}
+				objects = append(objects, listItem)
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err = validateManifest(object); err != nil {
+			return nil, fmt.Errorf("invalid document %d: %w", index, err)
+		}
+		objects = append(objects, object)
+	}
+}
+
+func validateManifest(object *unstructured.Unstructured) error {
+	switch {
+	case object.GetAPIVersion() == "":
+		return errors.New("missing 'apiVersion'")
+	case object.GetKind() == "":
+		return errors.New("missing 'kind'")
+	case object.GetName() == "":
+		return fmt.Errorf("missing 'metadata.name' in %s", object.GetKind())
+	}
+	return nil
+}
+
+// SortByApplyOrder sorts the given objects so that CustomResourceDefinitions and Namespaces are applied before all
+// other objects, which keep the order of their documents. Deleting objects should happen in reverse order.
+func SortByApplyOrder(objects []*unstructured.Unstructured) {
+	sort.SliceStable(objects, func(i, j int) bool {
+		return applyPriority(objects[i]) < applyPriority(objects[j])
+	})
+}
+
+func applyPriority(object *unstructured.Unstructured) int {
+	if priority, exists := applyPriorities[object.GroupVersionKind().GroupKind()]; exists {
+		return priority
+	}
+	return len(applyPriorities)
+}
+
+// ManifestKey identifies an object independent of its API version, e.g. to find objects which were removed from a
+// bundle between two applies.
+func ManifestKey(apiVersion string, kind string, namespace string, name string) string {
+	groupVersion, _ := k8sSchema.ParseGroupVersion(apiVersion)
+	return fmt.Sprintf("%s/%s/%s/%s", groupVersion.Group, kind, namespace, name)
+}
@@ -0,0 +1,173 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeManifests(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		content     string
+		expected    []string
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"single document": {
+			content: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: example
+  namespace: default
+`,
+			expected: []string{"v1/ConfigMap/default/example"},
+		},
+		"multiple documents": {
+			content: `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: example
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: example
+  namespace: example
+`,
+			expected: []string{"v1/Namespace//example", "apps/v1/Deployment/example/example"},
+		},
+		"empty documents and comments": {
+			content: `
+---
+# only a comment
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: example
+---
+`,
+			expected: []string{"v1/ConfigMap//example"},
+		},
+		"list": {
+			content: `
+apiVersion: v1
+kind: List
+items:
+  - apiVersion: v1
+    kind: ConfigMap
+    metadata:
+      name: first
+  - apiVersion: v1
+    kind: ConfigMap
+    metadata:
+      name: second
+`,
+			expected: []string{"v1/ConfigMap//first", "v1/ConfigMap//second"},
+		},
+		"empty": {
+			content:  "",
+			expected: nil,
+		},
+		"missing name": {
+			content: `
+apiVersion: v1
+kind: ConfigMap
+`,
+			expectError: true,
+		},
+		"missing kind": {
+			content: `
+apiVersion: v1
+metadata:
+  name: example
+`,
+			expectError: true,
+		},
+		"invalid yaml": {
+			content:     "apiVersion: [v1",
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			objects, err := DecodeManifests(test.content)
+			if test.expectError {
+				if err == nil {
+					t.Fatalf("expected error but got %d objects", len(objects))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual := objectIdentifiers(objects); !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSortByApplyOrder(t *testing.T) {
+	t.Parallel()
+
+	objects := []*unstructured.Unstructured{
+		manifest("apps/v1", "Deployment", "example"),
+		manifest("v1", "Namespace", "example"),
+		manifest("cert-manager.io/v1", "Certificate", "example"),
+		manifest("apiextensions.k8s.io/v1", "CustomResourceDefinition", "certificates.cert-manager.io"),
+		manifest("v1", "ConfigMap", "example"),
+	}
+
+	SortByApplyOrder(objects)
+
+	expected := []string{
+		"apiextensions.k8s.io/v1/CustomResourceDefinition//certificates.cert-manager.io",
+		"v1/Namespace//example",
+		"apps/v1/Deployment//example",
+		"cert-manager.io/v1/Certificate//example",
+		"v1/ConfigMap//example",
+	}
+	if actual := objectIdentifiers(objects); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestManifestKey(t *testing.T) {
+	t.Parallel()
+
+	if ManifestKey("cert-manager.io/v1", "Certificate", "default", "example") != ManifestKey("cert-manager.io/v1beta1", "Certificate", "default", "example") {
+		t.Errorf("expected keys to ignore the API version")
+	}
+	if ManifestKey("v1", "ConfigMap", "default", "example") == ManifestKey("v1", "ConfigMap", "other", "example") {
+		t.Errorf("expected keys to include the namespace")
+	}
+}
+
+func manifest(apiVersion string, kind string, name string) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{}
+	object.SetAPIVersion(apiVersion)
+	object.SetKind(kind)
+	object.SetName(name)
+	return object
+}
+
+func objectIdentifiers(objects []*unstructured.Unstructured) []string {
+	var identifiers []string
+	for _, object := range objects {
+		identifiers = append(identifiers, object.GetAPIVersion()+"/"+object.GetKind()+"/"+object.GetNamespace()+"/"+object.GetName())
+	}
+	return identifiers
+}
@@ -0,0 +1,58 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// NullUnconfiguredAttributes replaces values of the given attributes with null unless they are configured. Computed
+// values planned from a server-side dry-run, e.g. labels added by other field managers, are thereby not applied and
+// remain owned by their managers, and unknown values are left to the API server instead of failing to decode the plan.
+func NullUnconfiguredAttributes(plan tfsdk.Plan, config tfsdk.Config, paths ...path.Path) (tfsdk.Plan, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributePaths := make([]*tftypes.AttributePath, 0, len(paths))
+	for _, attributePath := range paths {
+		attributePaths = append(attributePaths, tftypesAttributePath(attributePath))
+	}
+
+	raw, err := tftypes.Transform(plan.Raw, func(current *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		for _, attributePath := range attributePaths {
+			if !current.Equal(attributePath) {
+				continue
+			}
+			configured, _, err := tftypes.WalkAttributePath(config.Raw, attributePath)
+			if err != nil {
+				return value, err
+			}
+			if configuredValue, ok := configured.(tftypes.Value); !value.IsKnown() || !ok || configuredValue.IsNull() {
+				return tftypes.NewValue(value.Type(), nil), nil
+			}
+		}
+		return value, nil
+	})
+	if err != nil {
+		diags.AddError("Unable to prepare plan", "An unexpected error occurred while removing unconfigured values from the plan.\n\nError: "+err.Error())
+		return plan, diags
+	}
+
+	plan.Raw = raw
+	return plan, diags
+}
+
+func tftypesAttributePath(attributePath path.Path) *tftypes.AttributePath {
+	converted := tftypes.NewAttributePath()
+	for _, step := range attributePath.Steps() {
+		if name, ok := step.(path.PathStepAttributeName); ok {
+			converted = converted.WithAttributeName(string(name))
+		}
+	}
+	return converted
+}
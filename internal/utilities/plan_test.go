@@ -0,0 +1,109 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"testing"
+)
+
+func TestNullUnconfiguredAttributes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	metadataType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":        tftypes.String,
+		"uid":         tftypes.String,
+		"labels":      tftypes.Map{ElementType: tftypes.String},
+		"annotations": tftypes.Map{ElementType: tftypes.String},
+		"finalizers":  tftypes.List{ElementType: tftypes.String},
+	}}
+	planSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"metadata": schema.SingleNestedAttribute{
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"name":        schema.StringAttribute{Required: true},
+					"uid":         schema.StringAttribute{Computed: true},
+					"labels":      schema.MapAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+					"annotations": schema.MapAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+					"finalizers":  schema.ListAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+				},
+			},
+		},
+	}
+	metadata := func(uid tftypes.Value, labels tftypes.Value, annotations tftypes.Value, finalizers tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"metadata": metadataType}}, map[string]tftypes.Value{
+			"metadata": tftypes.NewValue(metadataType, map[string]tftypes.Value{
+				"name":        tftypes.NewValue(tftypes.String, "example"),
+				"uid":         uid,
+				"labels":      labels,
+				"annotations": annotations,
+				"finalizers":  finalizers,
+			}),
+		})
+	}
+	stringMap := tftypes.Map{ElementType: tftypes.String}
+	stringList := tftypes.List{ElementType: tftypes.String}
+	plan := tfsdk.Plan{
+		Schema: planSchema,
+		Raw: metadata(
+			tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			tftypes.NewValue(stringMap, tftypes.UnknownValue),
+			tftypes.NewValue(stringMap, map[string]tftypes.Value{
+				"example.com/other-manager": tftypes.NewValue(tftypes.String, "value"),
+			}),
+			tftypes.NewValue(stringList, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "example.com/cleanup"),
+			})),
+	}
+	config := tfsdk.Config{
+		Schema: planSchema,
+		Raw: metadata(
+			tftypes.NewValue(tftypes.String, nil),
+			tftypes.NewValue(stringMap, nil),
+			tftypes.NewValue(stringMap, nil),
+			tftypes.NewValue(stringList, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "example.com/cleanup"),
+			})),
+	}
+
+	nulled, diags := NullUnconfiguredAttributes(plan, config,
+		path.Root("metadata").AtName("labels"),
+		path.Root("metadata").AtName("annotations"),
+		path.Root("metadata").AtName("finalizers"))
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var labels, annotations types.Map
+	var finalizers types.List
+	var uid types.String
+	diags.Append(nulled.GetAttribute(ctx, path.Root("metadata").AtName("labels"), &labels)...)
+	diags.Append(nulled.GetAttribute(ctx, path.Root("metadata").AtName("annotations"), &annotations)...)
+	diags.Append(nulled.GetAttribute(ctx, path.Root("metadata").AtName("finalizers"), &finalizers)...)
+	diags.Append(nulled.GetAttribute(ctx, path.Root("metadata").AtName("uid"), &uid)...)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !labels.IsNull() {
+		t.Errorf("expected unknown labels to be null, got %v", labels)
+	}
+	if !annotations.IsNull() {
+		t.Errorf("expected unconfigured annotations to be null, got %v", annotations)
+	}
+	if len(finalizers.Elements()) != 1 {
+		t.Errorf("expected configured finalizers to be kept, got %v", finalizers)
+	}
+	if !uid.IsUnknown() {
+		t.Errorf("expected other unknown attributes to be kept, got %v", uid)
+	}
+}
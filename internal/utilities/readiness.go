@@ -0,0 +1,176 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"fmt"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ReadinessRule decides whether an object is ready. In case the object is not ready yet, a human-readable reason is
+// returned. Errors signal that the object will never become ready, e.g. because a Job failed.
+type ReadinessRule func(object *unstructured.Unstructured) (bool, string, error)
+
+var readinessRules = map[k8sSchema.GroupKind]ReadinessRule{
+	{Group: "apps", Kind: "Deployment"}:                               deploymentReady,
+	{Group: "apps", Kind: "StatefulSet"}:                              statefulSetReady,
+	{Group: "apps", Kind: "DaemonSet"}:                                daemonSetReady,
+	{Group: "batch", Kind: "Job"}:                                     jobReady,
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}: customResourceDefinitionReady,
+}
+
+// IsReady applies the readiness rule matching the kind of the given object. Kinds without a specific rule are
+// considered ready once their 'Ready' condition is 'True'.
+func IsReady(object *unstructured.Unstructured) (bool, string, error) {
+	if object == nil {
+		return false, "object does not exist", nil
+	}
+	if rule, exists := readinessRules[object.GroupVersionKind().GroupKind()]; exists {
+		return rule(object)
+	}
+	return conditionsReady(object)
+}
+
+func deploymentReady(object *unstructured.Unstructured) (bool, string, error) {
+	if observed, reason := generationObserved(object); !observed {
+		return false, reason, nil
+	}
+	if condition := findCondition(object, "Progressing"); condition != nil && condition["reason"] == "ProgressDeadlineExceeded" {
+		return false, "", fmt.Errorf("deployment %q exceeded its progress deadline", object.GetName())
+	}
+	replicas := specReplicas(object)
+	updated := statusInt64(object, "updatedReplicas")
+	total := statusInt64(object, "replicas")
+	available := statusInt64(object, "availableReplicas")
+	if updated < replicas {
+		return false, fmt.Sprintf("%d out of %d new replicas have been updated", updated, replicas), nil
+	}
+	if total > updated {
+		return false, fmt.Sprintf("%d old replicas are pending termination", total-updated), nil
+	}
+	if available < updated {
+		return false, fmt.Sprintf("%d of %d updated replicas are available", available, updated), nil
+	}
+	return true, "", nil
+}
+
+func statefulSetReady(object *unstructured.Unstructured) (bool, string, error) {
+	if observed, reason := generationObserved(object); !observed {
+		return false, reason, nil
+	}
+	strategy, _, _ := unstructured.NestedString(object.Object, "spec", "updateStrategy", "type")
+	if strategy == "OnDelete" {
+		return true, "", nil
+	}
+	replicas := specReplicas(object)
+	ready := statusInt64(object, "readyReplicas")
+	if ready < replicas {
+		return false, fmt.Sprintf("%d of %d replicas are ready", ready, replicas), nil
+	}
+	partition, found, _ := unstructured.NestedInt64(object.Object, "spec", "updateStrategy", "rollingUpdate", "partition")
+	if found && partition > 0 {
+		updated := statusInt64(object, "updatedReplicas")
+		if updated < replicas-partition {
+			return false, fmt.Sprintf("%d of %d partitioned replicas have been updated", updated, replicas-partition), nil
+		}
+		return true, "", nil
+	}
+	currentRevision, _, _ := unstructured.NestedString(object.Object, "status", "currentRevision")
+	updateRevision, _, _ := unstructured.NestedString(object.Object, "status", "updateRevision")
+	if currentRevision != updateRevision {
+		return false, fmt.Sprintf("waiting for update to revision %s", updateRevision), nil
+	}
+	return true, "", nil
+}
+
+func daemonSetReady(object *unstructured.Unstructured) (bool, string, error) {
+	if observed, reason := generationObserved(object); !observed {
+		return false, reason, nil
+	}
+	strategy, _, _ := unstructured.NestedString(object.Object, "spec", "updateStrategy", "type")
+	if strategy == "OnDelete" {
+		return true, "", nil
+	}
+	desired := statusInt64(object, "desiredNumberScheduled")
+	updated := statusInt64(object, "updatedNumberScheduled")
+	available := statusInt64(object, "numberAvailable")
+	if updated < desired {
+		return false, fmt.Sprintf("%d out of %d new pods have been updated", updated, desired), nil
+	}
+	if available < desired {
+		return false, fmt.Sprintf("%d of %d updated pods are available", available, desired), nil
+	}
+	return true, "", nil
+}
+
+func jobReady(object *unstructured.Unstructured) (bool, string, error) {
+	if condition := findCondition(object, "Failed"); condition != nil && condition["status"] == "True" {
+		return false, "", fmt.Errorf("job %q failed: %v", object.GetName(), condition["message"])
+	}
+	if condition := findCondition(object, "Complete"); condition != nil && condition["status"] == "True" {
+		return true, "", nil
+	}
+	return false, "job has not completed yet", nil
+}
+
+func customResourceDefinitionReady(object *unstructured.Unstructured) (bool, string, error) {
+	condition := findCondition(object, "Established")
+	if condition == nil || condition["status"] != "True" {
+		return false, "waiting for the CRD to be established", nil
+	}
+	return true, "", nil
+}
+
+func conditionsReady(object *unstructured.Unstructured) (bool, string, error) {
+	if observed, reason := generationObserved(object); !observed {
+		return false, reason, nil
+	}
+	condition := findCondition(object, "Ready")
+	if condition == nil {
+		return false, "waiting for condition 'Ready' to be reported", nil
+	}
+	if condition["status"] != "True" {
+		if message, ok := condition["message"].(string); ok && message != "" {
+			return false, message, nil
+		}
+		return false, fmt.Sprintf("condition 'Ready' is '%v'", condition["status"]), nil
+	}
+	return true, "", nil
+}
+
+// generationObserved checks whether the controller has seen the latest spec. Objects that do not report an
+// observed generation are assumed to be up-to-date.
+func generationObserved(object *unstructured.Unstructured) (bool, string) {
+	observedGeneration, found, _ := unstructured.NestedInt64(object.Object, "status", "observedGeneration")
+	if found && observedGeneration < object.GetGeneration() {
+		return false, "waiting for the latest generation to be observed"
+	}
+	return true, ""
+}
+
+func specReplicas(object *unstructured.Unstructured) int64 {
+	replicas, found, _ := unstructured.NestedInt64(object.Object, "spec", "replicas")
+	if !found {
+		return 1
+	}
+	return replicas
+}
+
+func statusInt64(object *unstructured.Unstructured, field string) int64 {
+	value, _, _ := unstructured.NestedInt64(object.Object, "status", field)
+	return value
+}
+
+func findCondition(object *unstructured.Unstructured, conditionType string) map[string]interface{} {
+	conditions, _, _ := unstructured.NestedSlice(object.Object, "status", "conditions")
+	for _, element := range conditions {
+		if condition, ok := element.(map[string]interface{}); ok && condition["type"] == conditionType {
+			return condition
+		}
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"testing"
+)
+
+func TestIsReady(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		object      map[string]interface{}
+		ready       bool
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"deployment rolled out": {
+			object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "example", "generation": int64(2)},
+				"spec":       map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{
+					"observedGeneration": int64(2),
+					"replicas":           int64(3),
+					"updatedReplicas":    int64(3),
+					"availableReplicas":  int64(3),
+				},
+			},
+			ready: true,
+		},
+		"deployment with stale generation": {
+			object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "example", "generation": int64(3)},
+				"spec":       map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{
+					"observedGeneration": int64(2),
+					"replicas":           int64(3),
+					"updatedReplicas":    int64(3),
+					"availableReplicas":  int64(3),
+				},
+			},
+			ready: false,
+		},
+		"deployment with unavailable replicas": {
+			object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "example"},
+				"spec":       map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{
+					"replicas":          int64(3),
+					"updatedReplicas":   int64(3),
+					"availableReplicas": int64(1),
+				},
+			},
+			ready: false,
+		},
+		"deployment exceeded progress deadline": {
+			object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "example"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded"},
+					},
+				},
+			},
+			expectError: true,
+		},
+		"stateful set with pending revision": {
+			object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "StatefulSet",
+				"metadata":   map[string]interface{}{"name": "example"},
+				"spec":       map[string]interface{}{"replicas": int64(2)},
+				"status": map[string]interface{}{
+					"readyReplicas":   int64(2),
+					"currentRevision": "example-1",
+					"updateRevision":  "example-2",
+				},
+			},
+			ready: false,
+		},
+		"stateful set rolled out": {
+			object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "StatefulSet",
+				"metadata":   map[string]interface{}{"name": "example"},
+				"spec":       map[string]interface{}{"replicas": int64(2)},
+				"status": map[string]interface{}{
+					"readyReplicas":   int64(2),
+					"currentRevision": "example-2",
+					"updateRevision":  "example-2",
+				},
+			},
+			ready: true,
+		},
+		"daemon set rolled out": {
+			object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "DaemonSet",
+				"metadata":   map[string]interface{}{"name": "example"},
+				"status": map[string]interface{}{
+					"desiredNumberScheduled": int64(4),
+					"updatedNumberScheduled": int64(4),
+					"numberAvailable":        int64(4),
+				},
+			},
+			ready: true,
+		},
+		"daemon set updating": {
+			object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "DaemonSet",
+				"metadata":   map[string]interface{}{"name": "example"},
+				"status": map[string]interface{}{
+					"desiredNumberScheduled": int64(4),
+					"updatedNumberScheduled": int64(2),
+					"numberAvailable":        int64(4),
+				},
+			},
+			ready: false,
+		},
+		"job completed": {
+			object: map[string]interface{}{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+				"metadata":   map[string]interface{}{"name": "example"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Complete", "status": "True"},
+					},
+				},
+			},
+			ready: true,
+		},
+		"job failed": {
+			object: map[string]interface{}{
+				"apiVersion": "batch/v1",
+				"kind":       "Job",
+				"metadata":   map[string]interface{}{"name": "example"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Failed", "status": "True", "message": "BackoffLimitExceeded"},
+					},
+				},
+			},
+			expectError: true,
+		},
+		"custom resource ready": {
+			object: map[string]interface{}{
+				"apiVersion": "cert-manager.io/v1",
+				"kind":       "Certificate",
+				"metadata":   map[string]interface{}{"name": "example"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Ready", "status": "True"},
+					},
+				},
+			},
+			ready: true,
+		},
+		"custom resource not ready": {
+			object: map[string]interface{}{
+				"apiVersion": "cert-manager.io/v1",
+				"kind":       "Certificate",
+				"metadata":   map[string]interface{}{"name": "example"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Ready", "status": "False", "message": "Issuing certificate"},
+					},
+				},
+			},
+			ready: false,
+		},
+		"custom resource without status": {
+			object: map[string]interface{}{
+				"apiVersion": "cert-manager.io/v1",
+				"kind":       "Certificate",
+				"metadata":   map[string]interface{}{"name": "example"},
+			},
+			ready: false,
+		},
+		"custom resource definition established": {
+			object: map[string]interface{}{
+				"apiVersion": "apiextensions.k8s.io/v1",
+				"kind":       "CustomResourceDefinition",
+				"metadata":   map[string]interface{}{"name": "certificates.cert-manager.io"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Established", "status": "True"},
+					},
+				},
+			},
+			ready: true,
+		},
+		"custom resource definition not established": {
+			object: map[string]interface{}{
+				"apiVersion": "apiextensions.k8s.io/v1",
+				"kind":       "CustomResourceDefinition",
+				"metadata":   map[string]interface{}{"name": "certificates.cert-manager.io"},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "NamesAccepted", "status": "True"},
+					},
+				},
+			},
+			ready: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ready, _, err := IsReady(&unstructured.Unstructured{Object: test.object})
+
+			if err == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+			if err != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+			if ready != test.ready {
+				t.Fatalf("expected ready to be %v, got %v", test.ready, ready)
+			}
+		})
+	}
+}
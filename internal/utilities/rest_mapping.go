@@ -0,0 +1,139 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	apiMeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"strings"
+)
+
+// NewRESTMapper creates a RESTMapper which lazily reads the API resources of the cluster through discovery and caches
+// them in memory for the lifetime of the provider.
+func NewRESTMapper(config *rest.Config) (apiMeta.ResettableRESTMapper, error) {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return newDiscoveryRESTMapper(discoveryClient), nil
+}
+
+func newDiscoveryRESTMapper(client discovery.DiscoveryInterface) *discoveryRESTMapper {
+	return &discoveryRESTMapper{
+		DeferredDiscoveryRESTMapper: restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(client)),
+		discovery:                   client,
+	}
+}
+
+// kindChecker is implemented by mappers which can check whether the cluster serves a kind without resetting their
+// cache.
+type kindChecker interface {
+	ServesKind(groupVersion k8sSchema.GroupVersion, kind string) (bool, error)
+}
+
+// discoveryRESTMapper caches the API resources of the cluster. Resetting the cache discovers all API groups again,
+// thus kinds missing from the cache are looked up in their group version first.
+type discoveryRESTMapper struct {
+	*restmapper.DeferredDiscoveryRESTMapper
+	discovery discovery.DiscoveryInterface
+}
+
+// ServesKind reads the API resources of a single group version to check whether the cluster serves the given kind.
+func (m *discoveryRESTMapper) ServesKind(groupVersion k8sSchema.GroupVersion, kind string) (bool, error) {
+	resources, err := m.discovery.ServerResourcesForGroupVersion(groupVersion.String())
+	if IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, resource := range resources.APIResources {
+		if resource.Kind == kind && !strings.Contains(resource.Name, "/") {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// RESTMapping resolves the REST mapping of the given apiVersion and kind. The discovery cache of the mapper is reset
+// if the kind is unknown, since its CRD might have been installed after the cache was filled. Mappers implementing
+// kindChecker are only reset if the cluster serves the kind by now, so that unknown kinds do not trigger a full
+// discovery on every call.
+func RESTMapping(mapper apiMeta.ResettableRESTMapper, apiVersion string, kind string) (*apiMeta.RESTMapping, error) {
+	groupVersion, err := k8sSchema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	groupKind := k8sSchema.GroupKind{Group: groupVersion.Group, Kind: kind}
+
+	mapping, err := mapper.RESTMapping(groupKind, groupVersion.Version)
+	if !apiMeta.IsNoMatchError(err) {
+		return mapping, err
+	}
+	if checker, ok := mapper.(kindChecker); ok {
+		if served, checkErr := checker.ServesKind(groupVersion, kind); checkErr != nil || !served {
+			return mapping, err
+		}
+	}
+	mapper.Reset()
+	return mapper.RESTMapping(groupKind, groupVersion.Version)
+}
+
+// GroupVersionResource resolves the API resource of the given kind through the mapper. The fallback, e.g. the plural
+// generated from the schema of the kind, is used whenever the kind cannot be resolved through discovery.
+func GroupVersionResource(mapper apiMeta.ResettableRESTMapper, kind string, fallback k8sSchema.GroupVersionResource) k8sSchema.GroupVersionResource {
+	if mapper == nil {
+		return fallback
+	}
+	mapping, err := RESTMapping(mapper, fallback.GroupVersion().String(), kind)
+	if err != nil {
+		return fallback
+	}
+	return mapping.Resource
+}
+
+// IsKindServed reports whether the cluster serves the given kind, e.g. whether the CRD of a custom resource is installed.
+// Discovery failures other than an unknown kind are ignored, since subsequent API requests report them in more detail.
+func IsKindServed(mapper apiMeta.ResettableRESTMapper, apiVersion string, kind string) bool {
+	if mapper == nil {
+		return true
+	}
+	_, err := RESTMapping(mapper, apiVersion, kind)
+	return !apiMeta.IsNoMatchError(err)
+}
+
+// DynamicResource resolves the API resource of the given object through the mapper and returns a client which is
+// scoped to the namespace of the object for namespaced kinds.
+func DynamicResource(client dynamic.Interface, mapper apiMeta.ResettableRESTMapper, object *unstructured.Unstructured) (dynamic.ResourceInterface, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if mapper == nil {
+		diags.Append(MissingRESTMapperError())
+		return nil, diags
+	}
+
+	mapping, err := RESTMapping(mapper, object.GetAPIVersion(), object.GetKind())
+	if err != nil {
+		diags.Append(RESTMappingError(err, object.GetAPIVersion(), object.GetKind()))
+		return nil, diags
+	}
+
+	if mapping.Scope.Name() != apiMeta.RESTScopeNameNamespace {
+		return client.Resource(mapping.Resource), diags
+	}
+	if object.GetNamespace() == "" {
+		diags.Append(InvalidManifestError("'metadata.namespace' is required since " + object.GetKind() + " is a namespaced kind"))
+		return nil, diags
+	}
+	return client.Resource(mapping.Resource).Namespace(object.GetNamespace()), diags
+}
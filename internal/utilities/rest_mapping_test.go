@@ -0,0 +1,322 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	apiMeta "k8s.io/apimachinery/pkg/api/meta"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	discoveryfake "k8s.io/client-go/discovery/fake"
+	k8sTesting "k8s.io/client-go/testing"
+	"testing"
+)
+
+type resettableMapper struct {
+	*apiMeta.DefaultRESTMapper
+	onReset func()
+}
+
+func (m *resettableMapper) Reset() {
+	m.onReset()
+}
+
+func TestRESTMapping(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		apiVersion        string
+		kind              string
+		installOnReset    bool
+		expectedResource  k8sSchema.GroupVersionResource
+		expectedNamespace bool
+		expectError       bool
+	}
+	tests := map[string]testCase{
+		"core": {
+			apiVersion:        "v1",
+			kind:              "ConfigMap",
+			expectedResource:  configMaps,
+			expectedNamespace: true,
+		},
+		"cluster-scoped": {
+			apiVersion:       "rbac.authorization.k8s.io/v1",
+			kind:             "ClusterRole",
+			expectedResource: k8sSchema.GroupVersionResource{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"},
+		},
+		"installed after cache was filled": {
+			apiVersion:        "cert-manager.io/v1",
+			kind:              "Certificate",
+			installOnReset:    true,
+			expectedResource:  k8sSchema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"},
+			expectedNamespace: true,
+		},
+		"unknown kind": {
+			apiVersion:  "cert-manager.io/v1",
+			kind:        "Certificate",
+			expectError: true,
+		},
+		"invalid apiVersion": {
+			apiVersion:  "a/b/c",
+			kind:        "Example",
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			defaultMapper := apiMeta.NewDefaultRESTMapper(nil)
+			defaultMapper.Add(k8sSchema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, apiMeta.RESTScopeNamespace)
+			defaultMapper.Add(k8sSchema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}, apiMeta.RESTScopeRoot)
+			mapper := &resettableMapper{DefaultRESTMapper: defaultMapper, onReset: func() {
+				if test.installOnReset {
+					defaultMapper.Add(k8sSchema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}, apiMeta.RESTScopeNamespace)
+				}
+			}}
+
+			mapping, err := RESTMapping(mapper, test.apiVersion, test.kind)
+			if test.expectError {
+				if err == nil {
+					t.Fatalf("expected error but got %+v", mapping)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if mapping.Resource != test.expectedResource {
+				t.Errorf("expected resource %v, got %v", test.expectedResource, mapping.Resource)
+			}
+			if namespaced := mapping.Scope.Name() == apiMeta.RESTScopeNameNamespace; namespaced != test.expectedNamespace {
+				t.Errorf("expected namespaced %t, got %t", test.expectedNamespace, namespaced)
+			}
+		})
+	}
+}
+
+func TestRESTMapping_DiscoveryResets(t *testing.T) {
+	t.Parallel()
+
+	fakeDiscovery := &discoveryfake.FakeDiscovery{Fake: &k8sTesting.Fake{}}
+	fakeDiscovery.Resources = []*meta.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []meta.APIResource{{Name: "configmaps", Kind: "ConfigMap", Namespaced: true}},
+		},
+	}
+	mapper := newDiscoveryRESTMapper(fakeDiscovery)
+	groupDiscoveries := func() int {
+		count := 0
+		for _, action := range fakeDiscovery.Actions() {
+			if action.GetResource().Resource == "group" {
+				count++
+			}
+		}
+		return count
+	}
+
+	if _, err := RESTMapping(mapper, "v1", "ConfigMap"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for range 3 {
+		if _, err := RESTMapping(mapper, "cert-manager.io/v1", "Certificate"); !apiMeta.IsNoMatchError(err) {
+			t.Fatalf("expected no match error, got %v", err)
+		}
+	}
+	if discoveries := groupDiscoveries(); discoveries != 1 {
+		t.Errorf("expected a single discovery for missing kinds, got %d", discoveries)
+	}
+
+	fakeDiscovery.Resources = append(fakeDiscovery.Resources, &meta.APIResourceList{
+		GroupVersion: "cert-manager.io/v1",
+		APIResources: []meta.APIResource{{Name: "certificates", Kind: "Certificate", Namespaced: true}},
+	})
+	mapping, err := RESTMapping(mapper, "cert-manager.io/v1", "Certificate")
+	if err != nil {
+		t.Fatalf("unexpected error after installing the kind: %v", err)
+	}
+	if mapping.Resource.Resource != "certificates" {
+		t.Errorf("expected resource certificates, got %v", mapping.Resource)
+	}
+	if discoveries := groupDiscoveries(); discoveries != 2 {
+		t.Errorf("expected a second discovery after installing the kind, got %d", discoveries)
+	}
+}
+
+func TestDynamicResource(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		apiVersion  string
+		kind        string
+		namespace   string
+		nilMapper   bool
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"namespaced": {
+			apiVersion: "v1",
+			kind:       "ConfigMap",
+			namespace:  "default",
+		},
+		"namespaced without namespace": {
+			apiVersion:  "v1",
+			kind:        "ConfigMap",
+			expectError: true,
+		},
+		"cluster-scoped": {
+			apiVersion: "rbac.authorization.k8s.io/v1",
+			kind:       "ClusterRole",
+		},
+		"unknown kind": {
+			apiVersion:  "cert-manager.io/v1",
+			kind:        "Certificate",
+			namespace:   "default",
+			expectError: true,
+		},
+		"without mapper": {
+			apiVersion:  "v1",
+			kind:        "ConfigMap",
+			namespace:   "default",
+			nilMapper:   true,
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			defaultMapper := apiMeta.NewDefaultRESTMapper(nil)
+			defaultMapper.Add(k8sSchema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, apiMeta.RESTScopeNamespace)
+			defaultMapper.Add(k8sSchema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}, apiMeta.RESTScopeRoot)
+			var mapper apiMeta.ResettableRESTMapper = &resettableMapper{DefaultRESTMapper: defaultMapper, onReset: func() {}}
+			if test.nilMapper {
+				mapper = nil
+			}
+
+			object := &unstructured.Unstructured{}
+			object.SetAPIVersion(test.apiVersion)
+			object.SetKind(test.kind)
+			object.SetNamespace(test.namespace)
+			object.SetName("example")
+
+			resource, diags := DynamicResource(newFakeClient(), mapper, object)
+			if test.expectError {
+				if !diags.HasError() {
+					t.Fatalf("expected error but got %v", resource)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestGroupVersionResource(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		kind      string
+		fallback  k8sSchema.GroupVersionResource
+		nilMapper bool
+		expected  k8sSchema.GroupVersionResource
+	}
+	tests := map[string]testCase{
+		"irregular plural": {
+			kind:     "Cactus",
+			fallback: k8sSchema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "cactuses"},
+			expected: k8sSchema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "cacti"},
+		},
+		"unknown kind": {
+			kind:     "Certificate",
+			fallback: k8sSchema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"},
+			expected: k8sSchema.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"},
+		},
+		"without mapper": {
+			kind:      "Cactus",
+			fallback:  k8sSchema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "cactuses"},
+			nilMapper: true,
+			expected:  k8sSchema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "cactuses"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			defaultMapper := apiMeta.NewDefaultRESTMapper(nil)
+			defaultMapper.AddSpecific(
+				k8sSchema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Cactus"},
+				k8sSchema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "cacti"},
+				k8sSchema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "cactus"},
+				apiMeta.RESTScopeNamespace)
+			var mapper apiMeta.ResettableRESTMapper = &resettableMapper{DefaultRESTMapper: defaultMapper, onReset: func() {}}
+			if test.nilMapper {
+				mapper = nil
+			}
+
+			if actual := GroupVersionResource(mapper, test.kind, test.fallback); actual != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestIsKindServed(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		apiVersion string
+		kind       string
+		nilMapper  bool
+		expected   bool
+	}
+	tests := map[string]testCase{
+		"served": {
+			apiVersion: "v1",
+			kind:       "ConfigMap",
+			expected:   true,
+		},
+		"missing CRD": {
+			apiVersion: "cert-manager.io/v1",
+			kind:       "Certificate",
+			expected:   false,
+		},
+		"without mapper": {
+			apiVersion: "cert-manager.io/v1",
+			kind:       "Certificate",
+			nilMapper:  true,
+			expected:   true,
+		},
+		"invalid apiVersion": {
+			apiVersion: "a/b/c",
+			kind:       "Example",
+			expected:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			defaultMapper := apiMeta.NewDefaultRESTMapper(nil)
+			defaultMapper.Add(k8sSchema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, apiMeta.RESTScopeNamespace)
+			var mapper apiMeta.ResettableRESTMapper = &resettableMapper{DefaultRESTMapper: defaultMapper, onReset: func() {}}
+			if test.nilMapper {
+				mapper = nil
+			}
+
+			if actual := IsKindServed(mapper, test.apiVersion, test.kind); actual != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	k8sTypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"time"
+)
+
+// RetryPolicy configures how requests against the Kubernetes API server which failed with a transient error are
+// retried. The backoff between two attempts doubles after every attempt.
+type RetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+}
+
+// NewRetryingClient wraps the given client so that every request is retried according to the given policy.
+func NewRetryingClient(client dynamic.Interface, policy RetryPolicy) dynamic.Interface {
+	return &retryingClient{client: client, policy: policy}
+}
+
+// IsRetryable reports whether the given error is transient and the failed request should be retried. Field manager
+// conflicts are never retried since they require user interaction.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if k8sErrors.IsConflict(err) {
+		return !hasFieldManagerConflict(err)
+	}
+	return k8sErrors.IsTooManyRequests(err) ||
+		k8sErrors.IsInternalError(err) ||
+		k8sErrors.IsServiceUnavailable(err) ||
+		k8sErrors.IsServerTimeout(err) ||
+		k8sErrors.IsTimeout(err)
+}
+
+func hasFieldManagerConflict(err error) bool {
+	var statusError k8sErrors.APIStatus
+	if !errors.As(err, &statusError) || statusError.Status().Details == nil {
+		return false
+	}
+	for _, cause := range statusError.Status().Details.Causes {
+		if cause.Type == meta.CauseTypeFieldManagerConflict {
+			return true
+		}
+	}
+	return false
+}
+
+func withRetry[T any](ctx context.Context, policy RetryPolicy, operation string, request func() (T, error)) (T, error) {
+	backoff := wait.Backoff{
+		Duration: policy.Backoff,
+		Factor:   2,
+		Jitter:   0.1,
+		Steps:    policy.MaxAttempts,
+	}
+	for attempt := 1; ; attempt++ {
+		result, err := request()
+		if attempt >= policy.MaxAttempts || !IsRetryable(err) {
+			return result, err
+		}
+
+		delay := backoff.Step()
+		if seconds, ok := k8sErrors.SuggestsClientDelay(err); ok && time.Duration(seconds)*time.Second > delay {
+			delay = time.Duration(seconds) * time.Second
+		}
+		tflog.Warn(ctx, "Retrying request against Kubernetes API server", map[string]interface{}{
+			"operation":    operation,
+			"attempt":      attempt,
+			"max_attempts": policy.MaxAttempts,
+			"backoff":      delay.String(),
+			"error":        err.Error(),
+		})
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, err
+		case <-timer.C:
+		}
+	}
+}
+
+type retryingClient struct {
+	client dynamic.Interface
+	policy RetryPolicy
+}
+
+func (c *retryingClient) Resource(resource k8sSchema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	namespaceable := c.client.Resource(resource)
+	return &retryingNamespaceableResource{
+		retryingResource: retryingResource{resource: namespaceable, policy: c.policy},
+		namespaceable:    namespaceable,
+	}
+}
+
+type retryingNamespaceableResource struct {
+	retryingResource
+	namespaceable dynamic.NamespaceableResourceInterface
+}
+
+func (r *retryingNamespaceableResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return &retryingResource{resource: r.namespaceable.Namespace(namespace), policy: r.policy}
+}
+
+type retryingResource struct {
+	resource dynamic.ResourceInterface
+	policy   RetryPolicy
+}
+
+func (r *retryingResource) Create(ctx context.Context, obj *unstructured.Unstructured, options meta.CreateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return withRetry(ctx, r.policy, "create", func() (*unstructured.Unstructured, error) {
+		return r.resource.Create(ctx, obj, options, subresources...)
+	})
+}
+
+func (r *retryingResource) Update(ctx context.Context, obj *unstructured.Unstructured, options meta.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return withRetry(ctx, r.policy, "update", func() (*unstructured.Unstructured, error) {
+		return r.resource.Update(ctx, obj, options, subresources...)
+	})
+}
+
+func (r *retryingResource) UpdateStatus(ctx context.Context, obj *unstructured.Unstructured, options meta.UpdateOptions) (*unstructured.Unstructured, error) {
+	return withRetry(ctx, r.policy, "update status", func() (*unstructured.Unstructured, error) {
+		return r.resource.UpdateStatus(ctx, obj, options)
+	})
+}
+
+func (r *retryingResource) Delete(ctx context.Context, name string, options meta.DeleteOptions, subresources ...string) error {
+	_, err := withRetry(ctx, r.policy, "delete", func() (struct{}, error) {
+		return struct{}{}, r.resource.Delete(ctx, name, options, subresources...)
+	})
+	return err
+}
+
+func (r *retryingResource) DeleteCollection(ctx context.Context, options meta.DeleteOptions, listOptions meta.ListOptions) error {
+	_, err := withRetry(ctx, r.policy, "delete collection", func() (struct{}, error) {
+		return struct{}{}, r.resource.DeleteCollection(ctx, options, listOptions)
+	})
+	return err
+}
+
+func (r *retryingResource) Get(ctx context.Context, name string, options meta.GetOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return withRetry(ctx, r.policy, "get", func() (*unstructured.Unstructured, error) {
+		return r.resource.Get(ctx, name, options, subresources...)
+	})
+}
+
+func (r *retryingResource) List(ctx context.Context, opts meta.ListOptions) (*unstructured.UnstructuredList, error) {
+	return withRetry(ctx, r.policy, "list", func() (*unstructured.UnstructuredList, error) {
+		return r.resource.List(ctx, opts)
+	})
+}
+
+func (r *retryingResource) Watch(ctx context.Context, opts meta.ListOptions) (watch.Interface, error) {
+	return withRetry(ctx, r.policy, "watch", func() (watch.Interface, error) {
+		return r.resource.Watch(ctx, opts)
+	})
+}
+
+func (r *retryingResource) Patch(ctx context.Context, name string, pt k8sTypes.PatchType, data []byte, options meta.PatchOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return withRetry(ctx, r.policy, "patch", func() (*unstructured.Unstructured, error) {
+		return r.resource.Patch(ctx, name, pt, data, options, subresources...)
+	})
+}
+
+func (r *retryingResource) Apply(ctx context.Context, name string, obj *unstructured.Unstructured, options meta.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error) {
+	return withRetry(ctx, r.policy, "apply", func() (*unstructured.Unstructured, error) {
+		return r.resource.Apply(ctx, name, obj, options, subresources...)
+	})
+}
+
+func (r *retryingResource) ApplyStatus(ctx context.Context, name string, obj *unstructured.Unstructured, options meta.ApplyOptions) (*unstructured.Unstructured, error) {
+	return withRetry(ctx, r.policy, "apply status", func() (*unstructured.Unstructured, error) {
+		return r.resource.ApplyStatus(ctx, name, obj, options)
+	})
+}
@@ -0,0 +1,99 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"context"
+	"errors"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sTesting "k8s.io/client-go/testing"
+	"testing"
+)
+
+func TestNewRetryingClient(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		failures         []error
+		maxAttempts      int
+		expectedAttempts int
+		expectError      bool
+	}
+	tests := map[string]testCase{
+		"success": {
+			maxAttempts:      3,
+			expectedAttempts: 1,
+		},
+		"too many requests": {
+			failures:         []error{k8sErrors.NewTooManyRequests("slow down", 0)},
+			maxAttempts:      3,
+			expectedAttempts: 2,
+		},
+		"service unavailable": {
+			failures: []error{
+				k8sErrors.NewServiceUnavailable("starting"),
+				k8sErrors.NewInternalError(errors.New("failed calling webhook")),
+			},
+			maxAttempts:      3,
+			expectedAttempts: 3,
+		},
+		"attempts exhausted": {
+			failures: []error{
+				k8sErrors.NewServerTimeout(configMaps.GroupResource(), "get", 0),
+				k8sErrors.NewServerTimeout(configMaps.GroupResource(), "get", 0),
+				k8sErrors.NewServerTimeout(configMaps.GroupResource(), "get", 0),
+			},
+			maxAttempts:      2,
+			expectedAttempts: 2,
+			expectError:      true,
+		},
+		"not found": {
+			failures:         []error{k8sErrors.NewNotFound(configMaps.GroupResource(), "example")},
+			maxAttempts:      3,
+			expectedAttempts: 1,
+			expectError:      true,
+		},
+		"field manager conflict": {
+			failures: []error{k8sErrors.NewApplyConflict([]meta.StatusCause{
+				{Type: meta.CauseTypeFieldManagerConflict, Field: ".data.key"},
+			}, "Apply failed with 1 conflict")},
+			maxAttempts:      3,
+			expectedAttempts: 1,
+			expectError:      true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fakeClient := newFakeClient()
+			attempts := 0
+			fakeClient.PrependReactor("get", "configmaps", func(action k8sTesting.Action) (bool, runtime.Object, error) {
+				attempts++
+				if attempts <= len(test.failures) {
+					return true, nil, test.failures[attempts-1]
+				}
+				return false, nil, nil
+			})
+
+			client := NewRetryingClient(fakeClient, RetryPolicy{MaxAttempts: test.maxAttempts})
+			_, err := client.Resource(configMaps).Namespace("default").Get(context.Background(), "example", meta.GetOptions{})
+
+			if test.expectError && err == nil {
+				t.Fatal("expected error but got none")
+			}
+			if !test.expectError && err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+			if attempts != test.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", test.expectedAttempts, attempts)
+			}
+		})
+	}
+}
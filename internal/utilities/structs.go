@@ -5,16 +5,23 @@
 
 package utilities
 
-import "k8s.io/client-go/dynamic"
+import (
+	apiMeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/dynamic"
+)
 
 type ResourceData struct {
 	Client         dynamic.Interface
+	Context        string
 	FieldManager   string
 	ForceConflicts bool
 	Offline        bool
+	RESTMapper     apiMeta.ResettableRESTMapper
 }
 
 type DataSourceData struct {
-	Client  dynamic.Interface
-	Offline bool
+	Client     dynamic.Interface
+	Context    string
+	Offline    bool
+	RESTMapper apiMeta.ResettableRESTMapper
 }
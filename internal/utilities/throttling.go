@@ -0,0 +1,36 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	flowcontrol "k8s.io/api/flowcontrol/v1"
+	"net/http"
+)
+
+// LogThrottling wraps the given round tripper so that requests rejected by the API Priority and Fairness feature of
+// the Kubernetes API server are logged together with the matched flow schema and priority level.
+func LogThrottling(next http.RoundTripper) http.RoundTripper {
+	return &throttlingLogger{next: next}
+}
+
+type throttlingLogger struct {
+	next http.RoundTripper
+}
+
+func (l *throttlingLogger) RoundTrip(request *http.Request) (*http.Response, error) {
+	response, err := l.next.RoundTrip(request)
+	if err == nil && response.StatusCode == http.StatusTooManyRequests {
+		tflog.Warn(request.Context(), "Request throttled by Kubernetes API server", map[string]interface{}{
+			"method":             request.Method,
+			"path":               request.URL.Path,
+			"flow_schema_uid":    response.Header.Get(flowcontrol.ResponseHeaderMatchedFlowSchemaUID),
+			"priority_level_uid": response.Header.Get(flowcontrol.ResponseHeaderMatchedPriorityLevelConfigurationUID),
+			"retry_after":        response.Header.Get("Retry-After"),
+		})
+	}
+	return response, err
+}
@@ -6,8 +6,18 @@
 package utilities
 
 import (
+	"context"
+	"errors"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 	"time"
 )
 
@@ -28,3 +38,255 @@ func DeterminePollInterval(attributes map[string]attr.Value) time.Duration {
 	}
 	return time.Second * 5
 }
+
+// WaitForUpsert blocks until every jsonpath condition configured in 'wait_for_upsert' matches on the live object.
+func WaitForUpsert(ctx context.Context, client dynamic.ResourceInterface, name string, waitForUpsert types.List) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, element := range waitForUpsert.Elements() {
+		if condition, ok := element.(types.Object); ok {
+			if diagnostic := waitForJSONPath(ctx, client, name, condition.Attributes()); diagnostic != nil {
+				diags.Append(diagnostic)
+				return diags
+			}
+		}
+	}
+	return diags
+}
+
+// WaitForDelete blocks until the named object can no longer be found using the 'wait_for_delete' configuration.
+func WaitForDelete(ctx context.Context, client dynamic.ResourceInterface, name string, waitForDelete map[string]attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	timeout := DetermineTimeout(waitForDelete)
+	pollInterval := DeterminePollInterval(waitForDelete)
+
+	tflog.Debug(ctx, "Waiting for deletion", map[string]interface{}{
+		"name":    name,
+		"timeout": timeout.String(),
+	})
+
+	err := WaitForObject(ctx, client, name, timeout, pollInterval, func(object *unstructured.Unstructured) (bool, error) {
+		return object == nil, nil
+	})
+	// a zero timeout only checks once without failing the deletion
+	if errors.Is(err, errWaitTimeout) && timeout <= 0 {
+		return diags
+	}
+	if err != nil {
+		diags.Append(waitError(err, WaitTimeoutExceeded()))
+	}
+	return diags
+}
+
+// WaitForReady blocks until the live object is ready according to the readiness rule of its kind. Waits up to
+// 5 minutes unless another timeout in seconds is given.
+func WaitForReady(ctx context.Context, client dynamic.ResourceInterface, name string, timeout types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	duration := 5 * time.Minute
+	if !timeout.IsNull() && !timeout.IsUnknown() {
+		duration = time.Second * time.Duration(timeout.ValueInt64())
+	}
+
+	tflog.Debug(ctx, "Waiting for readiness", map[string]interface{}{
+		"name":    name,
+		"timeout": duration.String(),
+	})
+
+	reason := ""
+	var readinessErr error
+	err := WaitForObject(ctx, client, name, duration, 5*time.Second, func(object *unstructured.Unstructured) (bool, error) {
+		ready, notReadyReason, err := IsReady(object)
+		reason = notReadyReason
+		readinessErr = err
+		return ready, err
+	})
+	if readinessErr != nil {
+		diags.Append(NotReadyError(readinessErr))
+	} else if err != nil {
+		diags.Append(waitError(err, WaitForReadyTimeoutExceeded(reason)))
+	}
+	return diags
+}
+
+func waitForJSONPath(ctx context.Context, client dynamic.ResourceInterface, name string, attributes map[string]attr.Value) diag.Diagnostic {
+	expression := stringAttribute(attributes, "jsonpath")
+	expected := stringAttribute(attributes, "value")
+	timeout := DetermineTimeout(attributes)
+	pollInterval := DeterminePollInterval(attributes)
+
+	jsonPath, err := NewJSONPathParser(expression)
+	if err != nil {
+		return InvalidJSONPathError(expression, err)
+	}
+	if expected != "" {
+		_, expected, err = ProcessJSONPathInput(expression, expected)
+		if err != nil {
+			return InvalidJSONPathError(expression, err)
+		}
+	}
+
+	tflog.Debug(ctx, "Waiting for jsonpath condition", map[string]interface{}{
+		"jsonpath": expression,
+		"value":    expected,
+		"timeout":  timeout.String(),
+	})
+
+	observed := ""
+	var evaluationErr error
+	err = WaitForObject(ctx, client, name, timeout, pollInterval, func(object *unstructured.Unstructured) (bool, error) {
+		if object == nil {
+			observed = ""
+			return false, nil
+		}
+		met, value, err := JSONPathConditionMet(jsonPath, object.UnstructuredContent(), expected)
+		observed = value
+		evaluationErr = err
+		return met, err
+	})
+	if evaluationErr != nil {
+		return InvalidJSONPathError(expression, evaluationErr)
+	}
+	if err != nil {
+		return waitError(err, WaitForUpsertTimeoutExceeded(expression, expected, observed))
+	}
+	return nil
+}
+
+// ObjectCondition is evaluated for each observed state of an object. The given object is nil in case the object does
+// not exist (anymore).
+type ObjectCondition func(object *unstructured.Unstructured) (bool, error)
+
+var errWaitTimeout = errors.New("timed out waiting for condition")
+
+// WaitForObject observes the named object until the given condition is met, the timeout expires, or the context is
+// cancelled. Changes are observed by watching the object. In case the watch cannot be established (e.g. due to missing
+// permissions), the object is polled every pollInterval instead. Closed watches are re-established after waiting for
+// pollInterval as well. A timeout of zero checks the condition exactly once.
+func WaitForObject(ctx context.Context, client dynamic.ResourceInterface, name string, timeout time.Duration, pollInterval time.Duration, condition ObjectCondition) error {
+	object, err := getObject(ctx, client, name)
+	if err != nil {
+		return err
+	}
+	if met, err := condition(object); err != nil || met {
+		return err
+	}
+	if timeout <= 0 {
+		return errWaitTimeout
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	resourceVersion := ""
+	if object != nil {
+		resourceVersion = object.GetResourceVersion()
+	}
+	for {
+		met, watched, err := watchObject(waitCtx, client, name, resourceVersion, condition)
+		if err != nil || met {
+			return contextError(ctx, err)
+		}
+		if !watched {
+			tflog.Debug(ctx, "Unable to watch object, falling back to polling", map[string]interface{}{
+				"name":          name,
+				"poll_interval": pollInterval.String(),
+			})
+		}
+		select {
+		case <-waitCtx.Done():
+			return contextError(ctx, waitCtx.Err())
+		case <-time.After(pollInterval):
+		}
+
+		object, err = getObject(waitCtx, client, name)
+		if err != nil {
+			return contextError(ctx, err)
+		}
+		if met, err := condition(object); err != nil || met {
+			return err
+		}
+		resourceVersion = ""
+		if object != nil {
+			resourceVersion = object.GetResourceVersion()
+		}
+	}
+}
+
+// watchObject evaluates the condition for every change of the named object until the watch is closed. The returned
+// bool 'watched' is false in case no watch could be established at all.
+func watchObject(ctx context.Context, client dynamic.ResourceInterface, name string, resourceVersion string, condition ObjectCondition) (bool, bool, error) {
+	watcher, err := client.Watch(ctx, meta.ListOptions{
+		FieldSelector:   fields.OneTermEqualSelector("metadata.name", name).String(),
+		ResourceVersion: resourceVersion,
+	})
+	if err != nil {
+		tflog.Debug(ctx, "Unable to watch object", map[string]interface{}{
+			"name":  name,
+			"error": err.Error(),
+		})
+		return false, false, ctx.Err()
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false, true, ctx.Err()
+		case event, open := <-watcher.ResultChan():
+			if !open {
+				return false, true, nil
+			}
+			if event.Type == watch.Error {
+				tflog.Debug(ctx, "Watch of object failed", map[string]interface{}{
+					"name":  name,
+					"error": k8sErrors.FromObject(event.Object).Error(),
+				})
+				return false, true, nil
+			}
+			object, ok := event.Object.(*unstructured.Unstructured)
+			if !ok || object.GetName() != name {
+				continue
+			}
+			if event.Type == watch.Deleted {
+				object = nil
+			}
+			if met, err := condition(object); err != nil || met {
+				return met, true, err
+			}
+		}
+	}
+}
+
+func getObject(ctx context.Context, client dynamic.ResourceInterface, name string) (*unstructured.Unstructured, error) {
+	object, err := client.Get(ctx, name, meta.GetOptions{})
+	if k8sErrors.IsNotFound(err) || k8sErrors.IsGone(err) {
+		return nil, nil
+	}
+	return object, err
+}
+
+// contextError reports an expired wait timeout as errWaitTimeout while keeping cancellations of the parent context.
+func contextError(parent context.Context, err error) error {
+	if errors.Is(err, context.DeadlineExceeded) && parent.Err() == nil {
+		return errWaitTimeout
+	}
+	return err
+}
+
+func waitError(err error, timeoutDiagnostic diag.Diagnostic) diag.Diagnostic {
+	if errors.Is(err, errWaitTimeout) {
+		return timeoutDiagnostic
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return WaitCancelledError()
+	}
+	return genericGetError(err)
+}
+
+func stringAttribute(attributes map[string]attr.Value, name string) string {
+	if value, exists := attributes[name]; exists {
+		if valueString, typed := value.(types.String); typed {
+			return valueString.ValueString()
+		}
+	}
+	return ""
+}
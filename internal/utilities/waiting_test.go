@@ -0,0 +1,122 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package utilities
+
+import (
+	"context"
+	"errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sTesting "k8s.io/client-go/testing"
+	"testing"
+	"time"
+)
+
+var configMaps = k8sSchema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+func newFakeClient() *dynamicfake.FakeDynamicClient {
+	object := &unstructured.Unstructured{}
+	object.SetAPIVersion("v1")
+	object.SetKind("ConfigMap")
+	object.SetNamespace("default")
+	object.SetName("example")
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[k8sSchema.GroupVersionResource]string{
+		configMaps: "ConfigMapList",
+	}, object)
+}
+
+func deleted(object *unstructured.Unstructured) (bool, error) {
+	return object == nil, nil
+}
+
+func deleteLater(client *dynamicfake.FakeDynamicClient) {
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		_ = client.Resource(configMaps).Namespace("default").Delete(context.Background(), "example", meta.DeleteOptions{})
+	}()
+}
+
+func TestWaitForObject_Watch(t *testing.T) {
+	client := newFakeClient()
+	deleteLater(client)
+
+	err := WaitForObject(context.Background(), client.Resource(configMaps).Namespace("default"), "example", 5*time.Second, time.Hour, deleted)
+
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+}
+
+func TestWaitForObject_PollingFallback(t *testing.T) {
+	client := newFakeClient()
+	client.PrependWatchReactor("configmaps", func(action k8sTesting.Action) (bool, watch.Interface, error) {
+		return true, nil, errors.New("watch is forbidden")
+	})
+	deleteLater(client)
+
+	err := WaitForObject(context.Background(), client.Resource(configMaps).Namespace("default"), "example", 5*time.Second, 50*time.Millisecond, deleted)
+
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+}
+
+func TestWaitForObject_ClosedWatch(t *testing.T) {
+	client := newFakeClient()
+	watches := 0
+	client.PrependWatchReactor("configmaps", func(action k8sTesting.Action) (bool, watch.Interface, error) {
+		watches++
+		return true, watch.NewEmptyWatch(), nil
+	})
+
+	err := WaitForObject(context.Background(), client.Resource(configMaps).Namespace("default"), "example", 300*time.Millisecond, 100*time.Millisecond, deleted)
+
+	if !errors.Is(err, errWaitTimeout) {
+		t.Fatalf("expected timeout error, got: %v", err)
+	}
+	if watches > 4 {
+		t.Errorf("expected closed watches to be re-established once per poll interval, got %d watches", watches)
+	}
+}
+
+func TestWaitForObject_Timeout(t *testing.T) {
+	client := newFakeClient()
+
+	err := WaitForObject(context.Background(), client.Resource(configMaps).Namespace("default"), "example", 200*time.Millisecond, 50*time.Millisecond, deleted)
+
+	if !errors.Is(err, errWaitTimeout) {
+		t.Fatalf("expected timeout error, got: %v", err)
+	}
+}
+
+func TestWaitForObject_ZeroTimeout(t *testing.T) {
+	client := newFakeClient()
+
+	err := WaitForObject(context.Background(), client.Resource(configMaps).Namespace("default"), "example", 0, 50*time.Millisecond, deleted)
+
+	if !errors.Is(err, errWaitTimeout) {
+		t.Fatalf("expected timeout error, got: %v", err)
+	}
+}
+
+func TestWaitForObject_Cancelled(t *testing.T) {
+	client := newFakeClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		cancel()
+	}()
+
+	err := WaitForObject(ctx, client.Resource(configMaps).Namespace("default"), "example", 5*time.Second, 50*time.Millisecond, deleted)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected cancellation error, got: %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	utilValidation "k8s.io/apimachinery/pkg/util/validation"
+)
+
+type finalizerValidator struct{}
+
+var _ validator.List = finalizerValidator{}
+
+func FinalizerValidator() validator.List {
+	return finalizerValidator{}
+}
+
+func (validator finalizerValidator) Description(_ context.Context) string {
+	return "finalizers must match upstream k8s spec"
+}
+
+func (validator finalizerValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator finalizerValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	for index, value := range req.ConfigValue.Elements() {
+		val, isString := value.(types.String)
+		if !isString {
+			resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(
+				req.Path.AtListIndex(index),
+				"Invalid Type in Finalizers",
+				fmt.Sprintf("Finalizers must be types.String but was %s", value.Type(ctx).String()),
+			))
+			continue
+		}
+		if val.IsNull() || val.IsUnknown() {
+			continue
+		}
+		for _, msg := range utilValidation.IsQualifiedName(val.ValueString()) {
+			resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+				req.Path.AtListIndex(index),
+				fmt.Sprintf("Invalid Finalizer '%s'", val.ValueString()),
+				msg,
+			))
+		}
+	}
+}
@@ -0,0 +1,79 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package validators
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestFinalizerValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.List
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"null list": {
+			val:         types.ListNull(types.StringType),
+			expectError: false,
+		},
+		"unknown list": {
+			val:         types.ListUnknown(types.StringType),
+			expectError: false,
+		},
+		"valid finalizers": {
+			val: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("kubernetes"),
+				types.StringValue("example.com/cleanup"),
+			}),
+			expectError: false,
+		},
+		"unknown finalizer": {
+			val: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringUnknown(),
+			}),
+			expectError: false,
+		},
+		"invalid finalizer": {
+			val: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("/example.com/cleanup"),
+			}),
+			expectError: true,
+		},
+		"wrong value type": {
+			val: types.ListValueMust(types.Int64Type, []attr.Value{
+				types.Int64Value(123),
+			}),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.ListResponse{}
+			FinalizerValidator().ValidateList(context.TODO(), request, &response)
+
+			if !response.Diagnostics.HasError() && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if response.Diagnostics.HasError() && !test.expectError {
+				t.Fatalf("got unexpected error: %s", response.Diagnostics)
+			}
+		})
+	}
+}
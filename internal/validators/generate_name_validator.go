@@ -0,0 +1,49 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package validators
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	apiValidation "k8s.io/apimachinery/pkg/api/validation"
+)
+
+type generateNameValidator struct{}
+
+var _ validator.String = &generateNameValidator{}
+
+func GenerateNameValidator() validator.String {
+	return generateNameValidator{}
+}
+
+func (validator generateNameValidator) Description(_ context.Context) string {
+	return "generate name does not match upstream k8s spec"
+}
+
+func (validator generateNameValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+func (validator generateNameValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if value == "" {
+		return
+	}
+
+	for _, msg := range apiValidation.NameIsDNSSubdomain(value, true) {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			msg,
+			value,
+		))
+	}
+}
@@ -73,6 +73,10 @@ generate: out/generate-sentinel ## generate the code
 .PHONY: docs
 docs: out/docs-sentinel ## generate the documentation
 
+.PHONY: import
+import: ## generate HCL for existing cluster objects, e.g. 'make import RESOURCES=apps/v1/deployments'
+	go run ./tools/importer --schema-dir ./schemas --openapi --crd --resources $(RESOURCES)
+
 .PHONY: coverage
 coverage: out/coverage.html ## generate coverage report
 
@@ -0,0 +1,136 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"github.com/metio/terraform-provider-k8s/tools/internal/generator"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+	"log"
+	"os"
+	"strings"
+)
+
+func main() {
+	schemaDir := flag.String("schema-dir", "", "relative or absolute path to the root directory for schemas")
+	parseOpenAPIv2 := flag.Bool("openapi", false, "Whether to parse OpenAPIv2 schemas")
+	parseCRDv1 := flag.Bool("crd", false, "Whether to parse CRDv1 schemas")
+	kubeconfig := flag.String("kubeconfig", "", "path to the kubeconfig file, defaults to the standard kubeconfig loading rules")
+	kubeContext := flag.String("context", "", "name of the kubeconfig context to use, defaults to the current context")
+	namespace := flag.String("namespace", "", "only import objects of this namespace, defaults to all namespaces")
+	resources := flag.String("resources", "", "comma separated list of resources to import in the form 'group/version/resource', e.g. 'apps/v1/deployments,v1/configmaps'")
+	output := flag.String("output", "", "path to the file to write the generated HCL to, defaults to stdout")
+	flag.Parse()
+
+	if *schemaDir == "" {
+		log.Fatalln("No --schema-dir specified!")
+	}
+	if *resources == "" {
+		log.Fatalln("No --resources specified!")
+	}
+
+	var data []*generator.TemplateData
+	if *parseOpenAPIv2 {
+		openapi := generator.ParseOpenAPIv2Files(fmt.Sprintf("%s/openapi_v2/", *schemaDir))
+		data = append(data, generator.ConvertOpenAPIv2(openapi)...)
+	}
+	if *parseCRDv1 {
+		crd := generator.ParseCRDv1Files(fmt.Sprintf("%s/crd_v1/", *schemaDir))
+		data = append(data, generator.ConvertCRDv1(crd)...)
+	}
+
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if *kubeconfig != "" {
+		loadingRules = &clientcmd.ClientConfigLoadingRules{ExplicitPath: *kubeconfig}
+	}
+	clientConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{
+		CurrentContext: *kubeContext,
+	}).ClientConfig()
+	if err != nil {
+		log.Fatalln(err)
+	}
+	client, err := dynamic.NewForConfig(clientConfig)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	var hcl []byte
+	for _, resource := range strings.Split(*resources, ",") {
+		gvr, err := parseGroupVersionResource(resource)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		templateData := generator.FindTemplateData(data, gvr)
+		if templateData == nil {
+			log.Fatalf("No generated resource found for %s, make sure to parse the matching schemas with --openapi or --crd", resource)
+		}
+
+		objects, err := listObjects(client, gvr, templateData.Namespaced, *namespace)
+		if err != nil {
+			log.Fatalf("Cannot list %s: %s", resource, err)
+		}
+		if len(objects) == 0 {
+			continue
+		}
+		if len(hcl) > 0 {
+			hcl = append(hcl, '\n')
+		}
+		hcl = append(hcl, generator.GenerateImportBlocks(templateData, objects)...)
+	}
+
+	if *output == "" {
+		_, err = os.Stdout.Write(hcl)
+	} else {
+		err = os.WriteFile(*output, hcl, 0644)
+	}
+	if err != nil {
+		log.Fatalln(err)
+	}
+}
+
+func parseGroupVersionResource(resource string) (k8sSchema.GroupVersionResource, error) {
+	parts := strings.Split(strings.TrimSpace(resource), "/")
+	switch len(parts) {
+	case 2:
+		return k8sSchema.GroupVersionResource{Version: parts[0], Resource: parts[1]}, nil
+	case 3:
+		return k8sSchema.GroupVersionResource{Group: parts[0], Version: parts[1], Resource: parts[2]}, nil
+	default:
+		return k8sSchema.GroupVersionResource{}, fmt.Errorf("expected resource with format 'group/version/resource' or 'version/resource' Got: %q", resource)
+	}
+}
+
+func listObjects(client dynamic.Interface, gvr k8sSchema.GroupVersionResource, namespaced bool, namespace string) ([]unstructured.Unstructured, error) {
+	var resourceClient dynamic.ResourceInterface = client.Resource(gvr)
+	if namespaced && namespace != "" {
+		resourceClient = client.Resource(gvr).Namespace(namespace)
+	}
+
+	var objects []unstructured.Unstructured
+	options := meta.ListOptions{Limit: 500}
+	for {
+		list, err := resourceClient.List(context.Background(), options)
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range list.Items {
+			// objects owned by controllers, e.g. the ReplicaSets of a Deployment, are managed through their owner
+			if meta.GetControllerOf(&object) == nil {
+				objects = append(objects, object)
+			}
+		}
+		if list.GetContinue() == "" {
+			return objects, nil
+		}
+		options.Continue = list.GetContinue()
+	}
+}
@@ -8,6 +8,7 @@ package generator
 import (
 	"fmt"
 	"github.com/iancoleman/strcase"
+	"github.com/metio/terraform-provider-k8s/internal/naming"
 	"regexp"
 	"strings"
 	"unicode"
@@ -35,6 +36,12 @@ type TemplateData struct {
 	// DataSourceWorkflowFile is the file name of the GitHub Actions workflow for the data source
 	DataSourceWorkflowFile string
 
+	// ListDataSourceFile is the file name of the list data source
+	ListDataSourceFile string
+
+	// ListDataSourceTestFile is the file name of the test for the list data source
+	ListDataSourceTestFile string
+
 	// ManifestFile is the file name of the manifest data source
 	ManifestFile string
 
@@ -68,6 +75,9 @@ type TemplateData struct {
 	// ResourceDataStruct is the CamelCase version of the name used by resources for the data struct
 	ResourceDataStruct string
 
+	// ResourceStatusStruct is the CamelCase version of the name used by resources for the status struct
+	ResourceStatusStruct string
+
 	// ResourceTypeStruct is the CamelCase version of the name used by tests for a resource
 	ResourceTypeTest string
 
@@ -80,6 +90,15 @@ type TemplateData struct {
 	// DataSourceTypeTest is the CamelCase version of the name used by tests for a data source
 	DataSourceTypeTest string
 
+	// ListDataSourceTypeStruct is the CamelCase version of the name used by list data sources for the Terraform type
+	ListDataSourceTypeStruct string
+
+	// ListDataSourceDataStruct is the CamelCase version of the name used by list data sources for the data struct
+	ListDataSourceDataStruct string
+
+	// ListDataSourceTypeTest is the CamelCase version of the name used by tests for a list data source
+	ListDataSourceTypeTest string
+
 	// ManifestTypeStruct is the CamelCase version of the name used by manifest data sources for the Terraform type
 	ManifestTypeStruct string
 
@@ -103,12 +122,24 @@ type TemplateData struct {
 	// FullDataSourceTypeName is the snake_case version of the name as used by Terraform with the provider prefix
 	FullDataSourceTypeName string
 
+	// ListDataSourceTypeName is the snake_case version of the name as used by Terraform without the provider prefix
+	ListDataSourceTypeName string
+
+	// FullListDataSourceTypeName is the snake_case version of the name as used by Terraform with the provider prefix
+	FullListDataSourceTypeName string
+
 	// ManifestTypeName is the snake_case version of the name as used by Terraform without the provider prefix
 	ManifestTypeName string
 
 	// FullManifestTypeName is the snake_case version of the name as used by Terraform with the provider prefix
 	FullManifestTypeName string
 
+	// Sensitive is true when at least one property holds sensitive data
+	Sensitive bool
+
+	// StatusProperties are the read-only properties of the status subresource
+	StatusProperties []*Property
+
 	Properties        []*Property
 	AdditionalImports AdditionalImports
 }
@@ -125,6 +156,14 @@ type AdditionalImports struct {
 	Path             bool
 	MathBig          bool
 	Normalized       bool
+
+	BoolPlanModifier    bool
+	Int64PlanModifier   bool
+	Float64PlanModifier bool
+	NumberPlanModifier  bool
+	ListPlanModifier    bool
+	MapPlanModifier     bool
+	ObjectPlanModifier  bool
 }
 
 type Property struct {
@@ -141,10 +180,14 @@ type Property struct {
 	Required               bool
 	Optional               bool
 	Computed               bool
+	Sensitive              bool
 	Properties             []*Property
 	ValidatorsType         string
 	ValidatorsPackage      string
 	Validators             []string
+	RequiresReplace        bool
+	PlanModifiersType      string
+	PlanModifiersPackage   string
 }
 
 func propertyPath(path string, attributeName string) string {
@@ -154,8 +197,6 @@ func propertyPath(path string, attributeName string) string {
 	return fmt.Sprintf("%s.%s", path, attributeName)
 }
 
-var matchFirstCap = regexp.MustCompile("(.)([A-Z][a-z]+)")
-var matchAllCap = regexp.MustCompile("([a-z0-9])([A-Z])")
 var matchBackticks = regexp.MustCompile(`\x60`)
 var matchDoubleQuotes = regexp.MustCompile("\"")
 var matchNewlines = regexp.MustCompile("\n")
@@ -166,16 +207,6 @@ var matchDots = regexp.MustCompile(`\.`)
 var matchSlashes = regexp.MustCompile("/")
 var matchColons = regexp.MustCompile(":")
 
-func toSnakeCase(str string) string {
-	snake := matchFirstCap.ReplaceAllString(str, "${1}_${2}")
-	snake = matchAllCap.ReplaceAllString(snake, "${1}_${2}")
-	snake = matchDashes.ReplaceAllString(snake, "_")
-	snake = matchDots.ReplaceAllString(snake, "_")
-	snake = matchSlashes.ReplaceAllString(snake, "_")
-	snake = matchColons.ReplaceAllString(snake, "_")
-	return strings.ToLower(snake)
-}
-
 func upperCaseFirstLetter(s string) string {
 	r := []rune(s)
 	r[0] = unicode.ToUpper(r[0])
@@ -206,6 +237,14 @@ func dataSourceWorkflowFile(group string, kind string, version string) string {
 	return githubActionTerratestFilename(group, kind, version, "data_source")
 }
 
+func listDataSourceFile(group string, kind string, version string) string {
+	return goFilename(group, kind, version, "list_data_source")
+}
+
+func listDataSourceTestFile(group string, kind string, version string) string {
+	return goFilename(group, kind, version, "list_data_source_test")
+}
+
 func manifestFile(group string, kind string, version string) string {
 	return goFilename(group, kind, version, "manifest")
 }
@@ -220,23 +259,23 @@ func manifestWorkflowFile(group string, kind string, version string) string {
 
 func goFilename(group string, kind string, version string, suffix string) string {
 	if len(group) > 0 {
-		return fmt.Sprintf("%s_%s_%s_%s.go", toSnakeCase(group), toSnakeCase(kind), version, suffix)
+		return fmt.Sprintf("%s_%s_%s_%s.go", naming.ToSnakeCase(group), naming.ToSnakeCase(kind), version, suffix)
 	}
-	return fmt.Sprintf("%s_%s_%s.go", toSnakeCase(kind), version, suffix)
+	return fmt.Sprintf("%s_%s_%s.go", naming.ToSnakeCase(kind), version, suffix)
 }
 
 func githubActionTerratestFilename(group string, kind string, version string, suffix string) string {
 	if len(group) > 0 {
-		return fmt.Sprintf("terratest-%s_%s_%s_%s.yml", toSnakeCase(group), toSnakeCase(kind), version, suffix)
+		return fmt.Sprintf("terratest-%s_%s_%s_%s.yml", naming.ToSnakeCase(group), naming.ToSnakeCase(kind), version, suffix)
 	}
-	return fmt.Sprintf("terratest-%s_%s_%s.yml", toSnakeCase(kind), version, suffix)
+	return fmt.Sprintf("terratest-%s_%s_%s.yml", naming.ToSnakeCase(kind), version, suffix)
 }
 
 func resourceTypeName(group string, kind string, version string) string {
 	if len(group) > 0 {
-		return fmt.Sprintf("%s_%s_%s", toSnakeCase(group), toSnakeCase(kind), version)
+		return fmt.Sprintf("%s_%s_%s", naming.ToSnakeCase(group), naming.ToSnakeCase(kind), version)
 	}
-	return fmt.Sprintf("%s_%s", toSnakeCase(kind), version)
+	return fmt.Sprintf("%s_%s", naming.ToSnakeCase(kind), version)
 }
 
 func resourceTypeStruct(group string, kind string, version string) string {
@@ -247,6 +286,10 @@ func resourceDataStruct(group string, kind string, version string) string {
 	return typeStruct(group, kind, version, "ResourceData")
 }
 
+func resourceStatusStruct(group string, kind string, version string) string {
+	return typeStruct(group, kind, version, "ResourceStatus")
+}
+
 func dataSourceTypeStruct(group string, kind string, version string) string {
 	return typeStruct(group, kind, version, "DataSource")
 }
@@ -255,6 +298,14 @@ func dataSourceDataStruct(group string, kind string, version string) string {
 	return typeStruct(group, kind, version, "DataSourceData")
 }
 
+func listDataSourceTypeStruct(group string, kind string, version string) string {
+	return typeStruct(group, kind, version, "ListDataSource")
+}
+
+func listDataSourceDataStruct(group string, kind string, version string) string {
+	return typeStruct(group, kind, version, "ListDataSourceData")
+}
+
 func manifestTypeStruct(group string, kind string, version string) string {
 	return typeStruct(group, kind, version, "Manifest")
 }
@@ -278,6 +329,10 @@ func dataSourceTypeTest(group string, kind string, version string) string {
 	return typeTest(group, kind, version, "DataSource")
 }
 
+func listDataSourceTypeTest(group string, kind string, version string) string {
+	return typeTest(group, kind, version, "ListDataSource")
+}
+
 func manifestTypeTest(group string, kind string, version string) string {
 	return typeTest(group, kind, version, "Manifest")
 }
@@ -298,29 +353,9 @@ func terraformModelType(group string, kind string, version string) string {
 
 func goPackageName(group string, version string) string {
 	if len(group) > 0 {
-		return toSnakeCase(fmt.Sprintf("%s_%s", goName(group), version))
-	}
-	return toSnakeCase(fmt.Sprintf("%s_%s", "core", version))
-}
-
-func terraformAttributeName(str string, rootPath bool) string {
-	clean := str
-	if rootPath && clean == "provisioner" {
-		clean = "k8s_provisioner"
-	}
-	if strings.HasPrefix(clean, "-") {
-		clean = strings.Replace(clean, "-", "", 1)
-	}
-	if strings.HasPrefix(clean, "3") {
-		clean = strings.Replace(clean, "3", "Three", 1)
+		return naming.ToSnakeCase(fmt.Sprintf("%s_%s", goName(group), version))
 	}
-	if strings.HasPrefix(clean, "$") {
-		clean = strings.Replace(clean, "$", "Dollar", 1)
-	}
-	clean = strings.ReplaceAll(clean, "URL", "Url")
-	clean = strings.ReplaceAll(clean, "CIDR", "Cidr")
-	clean = toSnakeCase(clean)
-	return clean
+	return naming.ToSnakeCase(fmt.Sprintf("%s_%s", "core", version))
 }
 
 func goName(s string) string {
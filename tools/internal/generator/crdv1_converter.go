@@ -7,6 +7,7 @@ package generator
 
 import (
 	"fmt"
+	"github.com/metio/terraform-provider-k8s/internal/naming"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/utils/strings/slices"
 	"sort"
@@ -29,6 +30,7 @@ func crdV1AsTemplateData(crd *apiextensionsv1.CustomResourceDefinition) *Templat
 	kind := crd.Spec.Names.Kind
 
 	schema := version.Schema.OpenAPIV3Schema
+	status, hasStatus := schema.Properties["status"]
 	// remove manually managed or otherwise ignored properties
 	delete(schema.Properties, "metadata")
 	delete(schema.Properties, "status")
@@ -41,6 +43,16 @@ func crdV1AsTemplateData(crd *apiextensionsv1.CustomResourceDefinition) *Templat
 
 	imports := AdditionalImports{}
 	typeName := resourceTypeName(group, kind, version.Name)
+	properties := crdV1Properties(schema, &imports, "", typeName)
+
+	var statusProperties []*Property
+	if hasStatus {
+		// validators are not generated for read-only attributes, so only type imports are kept
+		statusImports := AdditionalImports{}
+		statusProperties = crdV1Properties(&status, &statusImports, "status", typeName)
+		imports.MathBig = imports.MathBig || statusImports.MathBig
+		imports.Normalized = imports.Normalized || statusImports.Normalized
+	}
 
 	return &TemplateData{
 		BT:          "`",
@@ -59,6 +71,7 @@ func crdV1AsTemplateData(crd *apiextensionsv1.CustomResourceDefinition) *Templat
 		FullResourceTypeName: fmt.Sprintf("k8s_%s", typeName),
 		ResourceTypeStruct:   resourceTypeStruct(group, kind, version.Name),
 		ResourceDataStruct:   resourceDataStruct(group, kind, version.Name),
+		ResourceStatusStruct: resourceStatusStruct(group, kind, version.Name),
 		ResourceTypeTest:     resourceTypeTest(group, kind, version.Name),
 
 		DataSourceFile:         dataSourceFile(group, kind, version.Name),
@@ -70,6 +83,14 @@ func crdV1AsTemplateData(crd *apiextensionsv1.CustomResourceDefinition) *Templat
 		DataSourceDataStruct:   dataSourceDataStruct(group, kind, version.Name),
 		DataSourceTypeTest:     dataSourceTypeTest(group, kind, version.Name),
 
+		ListDataSourceFile:         listDataSourceFile(group, kind, version.Name),
+		ListDataSourceTestFile:     listDataSourceTestFile(group, kind, version.Name),
+		ListDataSourceTypeName:     fmt.Sprintf("%s_list", typeName),
+		FullListDataSourceTypeName: fmt.Sprintf("k8s_%s_list", typeName),
+		ListDataSourceTypeStruct:   listDataSourceTypeStruct(group, kind, version.Name),
+		ListDataSourceDataStruct:   listDataSourceDataStruct(group, kind, version.Name),
+		ListDataSourceTypeTest:     listDataSourceTypeTest(group, kind, version.Name),
+
 		ManifestFile:         manifestFile(group, kind, version.Name),
 		ManifestTestFile:     manifestTestFile(group, kind, version.Name),
 		ManifestWorkflowFile: manifestWorkflowFile(group, kind, version.Name),
@@ -82,7 +103,9 @@ func crdV1AsTemplateData(crd *apiextensionsv1.CustomResourceDefinition) *Templat
 		TerraformModelType: terraformModelType(group, kind, version.Name),
 
 		AdditionalImports: imports,
-		Properties:        crdV1Properties(schema, &imports, "", typeName),
+		Properties:        properties,
+		StatusProperties:  statusProperties,
+		Sensitive:         hasSensitiveProperties(properties),
 	}
 }
 
@@ -123,12 +146,17 @@ func crdV1Properties(schema *apiextensionsv1.JSONSchemaProps, imports *Additiona
 			imports:  imports,
 		}, terraformResourceName, propPath, imports)
 
+		requiresReplace := isImmutable(terraformResourceName, propPath) || hasImmutableRule(&prop)
+		if requiresReplace {
+			requiresReplaceImports(attributeType, imports)
+		}
+
 		props = append(props, &Property{
 			BT:                     "`",
 			Name:                   name,
 			GoName:                 goName(name),
 			GoType:                 goType,
-			TerraformAttributeName: terraformAttributeName(name, path == ""),
+			TerraformAttributeName: naming.TerraformAttributeName(name, path == ""),
 			TerraformAttributeType: attributeType,
 			TerraformElementType:   elementType,
 			TerraformCustomType:    customType,
@@ -137,10 +165,14 @@ func crdV1Properties(schema *apiextensionsv1.JSONSchemaProps, imports *Additiona
 			Required:               slices.Contains(schema.Required, name),
 			Optional:               !slices.Contains(schema.Required, name),
 			Computed:               false,
+			Sensitive:              isSensitive(terraformResourceName, propPath),
 			Properties:             nestedProperties,
 			ValidatorsType:         mapAttributeTypeToValidatorsType(attributeType),
 			ValidatorsPackage:      mapAttributeTypeToValidatorsPackage(attributeType),
 			Validators:             validators,
+			RequiresReplace:        requiresReplace,
+			PlanModifiersType:      mapAttributeTypeToPlanModifiersType(attributeType),
+			PlanModifiersPackage:   mapAttributeTypeToPlanModifiersPackage(attributeType),
 		})
 	}
 
@@ -13,70 +13,87 @@ import (
 )
 
 func GenerateExamples(templatePath string, outputPath string, data []*TemplateData) {
-	//exampleMainTemplate := ParseTemplates(fmt.Sprintf("%s/main.tf.tmpl", templatePath))
+	exampleMainTemplate := ParseTemplates(fmt.Sprintf("%s/main.tf.tmpl", templatePath))
 	exampleManifestMainTemplate := ParseTemplates(fmt.Sprintf("%s/manifest_main.tf.tmpl", templatePath))
-	//exampleResourceOutputsTemplate := ParseTemplates(fmt.Sprintf("%s/resource_outputs.tf.tmpl", templatePath))
+	exampleResourceOutputsTemplate := ParseTemplates(fmt.Sprintf("%s/resource_outputs.tf.tmpl", templatePath))
 	//exampleDataSourceOutputsTemplate := ParseTemplates(fmt.Sprintf("%s/data_source_outputs.tf.tmpl", templatePath))
+	exampleListDataSourceOutputsTemplate := ParseTemplates(fmt.Sprintf("%s/list_data_source_outputs.tf.tmpl", templatePath))
 	exampleManifestOutputsTemplate := ParseTemplates(fmt.Sprintf("%s/manifest_outputs.tf.tmpl", templatePath))
-	//exampleResourceTemplate := ParseTemplates(fmt.Sprintf("%s/resource.tf.tmpl", templatePath))
+	exampleResourceTemplate := ParseTemplates(fmt.Sprintf("%s/resource.tf.tmpl", templatePath))
 	//exampleDataSourceTemplate := ParseTemplates(fmt.Sprintf("%s/data_source.tf.tmpl", templatePath))
+	exampleListDataSourceTemplate := ParseTemplates(fmt.Sprintf("%s/list_data_source.tf.tmpl", templatePath))
 	exampleManifestTemplate := ParseTemplates(fmt.Sprintf("%s/manifest.tf.tmpl", templatePath))
-	//exampleImportTemplate := ParseTemplates(fmt.Sprintf("%s/import.sh.tmpl", templatePath))
+	exampleImportTemplate := ParseTemplates(fmt.Sprintf("%s/import.sh.tmpl", templatePath))
 
-	//resourceExamples := fmt.Sprintf("%s/resources", outputPath)
+	resourceExamples := fmt.Sprintf("%s/resources", outputPath)
 	dataSourceExamples := fmt.Sprintf("%s/data-sources", outputPath)
 
 	for _, resource := range data {
-		//resourceDirectory := fmt.Sprintf("%s/%s", resourceExamples, resource.FullResourceTypeName)
+		resourceDirectory := fmt.Sprintf("%s/%s", resourceExamples, resource.FullResourceTypeName)
 		//dataSourceDirectory := fmt.Sprintf("%s/%s", dataSourceExamples, resource.FullDataSourceTypeName)
+		listDataSourceDirectory := fmt.Sprintf("%s/%s", dataSourceExamples, resource.FullListDataSourceTypeName)
 		manifestDirectory := fmt.Sprintf("%s/%s", dataSourceExamples, resource.FullManifestTypeName)
-		//err := os.MkdirAll(resourceDirectory, os.ModePerm)
-		//if err != nil {
-		//	log.Fatal(err)
-		//}
+		err := os.MkdirAll(resourceDirectory, os.ModePerm)
+		if err != nil {
+			log.Fatal(err)
+		}
 		//err = os.MkdirAll(dataSourceDirectory, os.ModePerm)
 		//if err != nil {
 		//	log.Fatal(err)
 		//}
-		err := os.MkdirAll(manifestDirectory, os.ModePerm)
+		err = os.MkdirAll(listDataSourceDirectory, os.ModePerm)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = os.MkdirAll(manifestDirectory, os.ModePerm)
 		if err != nil {
 			log.Fatal(err)
 		}
 
-		//resourceMainFile := fmt.Sprintf("%s/main.tf", resourceDirectory)
+		resourceMainFile := fmt.Sprintf("%s/main.tf", resourceDirectory)
 		//dataSourceMainFile := fmt.Sprintf("%s/main.tf", dataSourceDirectory)
+		listDataSourceMainFile := fmt.Sprintf("%s/main.tf", listDataSourceDirectory)
 		manifestMainFile := fmt.Sprintf("%s/main.tf", manifestDirectory)
-		//generateCode(resourceMainFile, exampleMainTemplate, nil)
+		generateCode(resourceMainFile, exampleMainTemplate, nil)
 		//generateCode(dataSourceMainFile, exampleMainTemplate, nil)
+		generateCode(listDataSourceMainFile, exampleMainTemplate, nil)
 		generateCode(manifestMainFile, exampleManifestMainTemplate, nil)
 
-		//resourceOutputsFile := fmt.Sprintf("%s/outputs.tf", resourceDirectory)
+		resourceOutputsFile := fmt.Sprintf("%s/outputs.tf", resourceDirectory)
 		//dataSourceOutputsFile := fmt.Sprintf("%s/outputs.tf", dataSourceDirectory)
+		listDataSourceOutputsFile := fmt.Sprintf("%s/outputs.tf", listDataSourceDirectory)
 		manifestOutputsFile := fmt.Sprintf("%s/outputs.tf", manifestDirectory)
-		//if _, err := os.Stat(resourceOutputsFile); errors.Is(err, os.ErrNotExist) {
-		//	generateCode(resourceOutputsFile, exampleResourceOutputsTemplate, resource)
-		//}
+		if _, err := os.Stat(resourceOutputsFile); errors.Is(err, os.ErrNotExist) {
+			generateCode(resourceOutputsFile, exampleResourceOutputsTemplate, resource)
+		}
 		//if _, err := os.Stat(dataSourceOutputsFile); errors.Is(err, os.ErrNotExist) {
 		//	generateCode(dataSourceOutputsFile, exampleDataSourceOutputsTemplate, resource)
 		//}
+		if _, err := os.Stat(listDataSourceOutputsFile); errors.Is(err, os.ErrNotExist) {
+			generateCode(listDataSourceOutputsFile, exampleListDataSourceOutputsTemplate, resource)
+		}
 		if _, err := os.Stat(manifestOutputsFile); errors.Is(err, os.ErrNotExist) {
 			generateCode(manifestOutputsFile, exampleManifestOutputsTemplate, resource)
 		}
 
-		//resourceTfFile := fmt.Sprintf("%s/resource.tf", resourceDirectory)
+		resourceTfFile := fmt.Sprintf("%s/resource.tf", resourceDirectory)
 		//dataSourceTfFile := fmt.Sprintf("%s/data-source.tf", dataSourceDirectory)
+		listDataSourceTfFile := fmt.Sprintf("%s/data-source.tf", listDataSourceDirectory)
 		manifestTfFile := fmt.Sprintf("%s/data-source.tf", manifestDirectory)
-		//if _, err := os.Stat(resourceTfFile); errors.Is(err, os.ErrNotExist) {
-		//	generateCode(resourceTfFile, exampleResourceTemplate, resource)
-		//}
+		if _, err := os.Stat(resourceTfFile); errors.Is(err, os.ErrNotExist) {
+			generateCode(resourceTfFile, exampleResourceTemplate, resource)
+		}
 		//if _, err := os.Stat(dataSourceTfFile); errors.Is(err, os.ErrNotExist) {
 		//	generateCode(dataSourceTfFile, exampleDataSourceTemplate, resource)
 		//}
+		if _, err := os.Stat(listDataSourceTfFile); errors.Is(err, os.ErrNotExist) {
+			generateCode(listDataSourceTfFile, exampleListDataSourceTemplate, resource)
+		}
 		if _, err := os.Stat(manifestTfFile); errors.Is(err, os.ErrNotExist) {
 			generateCode(manifestTfFile, exampleManifestTemplate, resource)
 		}
 
-		//importFile := fmt.Sprintf("%s/import.sh", resourceDirectory)
-		//generateCode(importFile, exampleImportTemplate, nil)
+		importFile := fmt.Sprintf("%s/import.sh", resourceDirectory)
+		generateCode(importFile, exampleImportTemplate, resource)
 	}
 }
@@ -0,0 +1,206 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	"regexp"
+)
+
+// ignoredImportAnnotations are set by clients and controllers and should not be managed by Terraform after an import
+var ignoredImportAnnotations = []string{
+	"kubectl.kubernetes.io/last-applied-configuration",
+	"deployment.kubernetes.io/revision",
+}
+
+var invalidLabelCharacters = regexp.MustCompile("[^a-zA-Z0-9_-]")
+
+// FindTemplateData returns the template data of the resource which manages objects of the given GVR.
+func FindTemplateData(data []*TemplateData, gvr k8sSchema.GroupVersionResource) *TemplateData {
+	for _, resource := range data {
+		if resource.Group == gvr.Group && resource.Version == gvr.Version && resource.PluralKind == gvr.Resource {
+			return resource
+		}
+	}
+	return nil
+}
+
+// GenerateImportBlocks emits a 'resource' block for each of the given objects along with an 'import' block that adopts
+// the existing object into the Terraform state. Kubernetes field names are translated into the snake_case attribute
+// names of the generated resource and fields without a matching attribute are dropped.
+func GenerateImportBlocks(resource *TemplateData, objects []unstructured.Unstructured) []byte {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+
+	for index, object := range objects {
+		if index > 0 {
+			body.AppendNewline()
+		}
+
+		label := resourceLabel(object)
+		resourceBlock := body.AppendNewBlock("resource", []string{resource.FullResourceTypeName, label})
+		resourceBlock.Body().SetAttributeValue("metadata", importedMetadata(object, resource.Namespaced))
+		for _, prop := range resource.Properties {
+			if value, ok := object.Object[prop.Name]; ok && value != nil {
+				resourceBlock.Body().SetAttributeValue(prop.TerraformAttributeName, importedValue(prop, value))
+			}
+		}
+
+		body.AppendNewline()
+		importBlock := body.AppendNewBlock("import", nil)
+		importBlock.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: resource.FullResourceTypeName},
+			hcl.TraverseAttr{Name: label},
+		})
+		importBlock.Body().SetAttributeValue("id", cty.StringVal(importID(object, resource.Namespaced)))
+	}
+
+	return file.Bytes()
+}
+
+func resourceLabel(object unstructured.Unstructured) string {
+	name := object.GetName()
+	if object.GetNamespace() != "" {
+		name = fmt.Sprintf("%s_%s", object.GetNamespace(), name)
+	}
+	label := invalidLabelCharacters.ReplaceAllString(name, "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') || label[0] == '-' {
+		label = "_" + label
+	}
+	return label
+}
+
+func importID(object unstructured.Unstructured, namespaced bool) string {
+	if namespaced {
+		return fmt.Sprintf("%s/%s", object.GetNamespace(), object.GetName())
+	}
+	return object.GetName()
+}
+
+func importedMetadata(object unstructured.Unstructured, namespaced bool) cty.Value {
+	metadata := map[string]cty.Value{
+		"name": cty.StringVal(object.GetName()),
+	}
+	if namespaced {
+		metadata["namespace"] = cty.StringVal(object.GetNamespace())
+	}
+	if labels := object.GetLabels(); len(labels) > 0 {
+		metadata["labels"] = stringMapValue(labels)
+	}
+	annotations := object.GetAnnotations()
+	for _, ignored := range ignoredImportAnnotations {
+		delete(annotations, ignored)
+	}
+	if len(annotations) > 0 {
+		metadata["annotations"] = stringMapValue(annotations)
+	}
+	return cty.ObjectVal(metadata)
+}
+
+func importedValue(prop *Property, value interface{}) cty.Value {
+	switch prop.TerraformAttributeType {
+	case "schema.SingleNestedAttribute":
+		if fields, ok := value.(map[string]interface{}); ok && len(prop.Properties) > 0 {
+			return importedObject(prop.Properties, fields)
+		}
+	case "schema.ListNestedAttribute":
+		if items, ok := value.([]interface{}); ok {
+			values := make([]cty.Value, 0, len(items))
+			for _, item := range items {
+				if fields, ok := item.(map[string]interface{}); ok {
+					values = append(values, importedObject(prop.Properties, fields))
+				}
+			}
+			return cty.TupleVal(values)
+		}
+	case "schema.StringAttribute":
+		return cty.StringVal(stringValue(value))
+	case "schema.MapAttribute":
+		if prop.TerraformElementType == "types.StringType" {
+			if fields, ok := value.(map[string]interface{}); ok {
+				values := make(map[string]string, len(fields))
+				for key, field := range fields {
+					values[key] = stringValue(field)
+				}
+				return stringMapValue(values)
+			}
+		}
+	}
+	return genericValue(value)
+}
+
+func importedObject(props []*Property, fields map[string]interface{}) cty.Value {
+	values := make(map[string]cty.Value)
+	for _, prop := range props {
+		if value, ok := fields[prop.Name]; ok && value != nil {
+			values[prop.TerraformAttributeName] = importedValue(prop, value)
+		}
+	}
+	return cty.ObjectVal(values)
+}
+
+func stringMapValue(values map[string]string) cty.Value {
+	if len(values) == 0 {
+		return cty.EmptyObjectVal
+	}
+	mapped := make(map[string]cty.Value, len(values))
+	for key, value := range values {
+		mapped[key] = cty.StringVal(value)
+	}
+	return cty.ObjectVal(mapped)
+}
+
+func stringValue(value interface{}) string {
+	switch typed := value.(type) {
+	case string:
+		return typed
+	case map[string]interface{}, []interface{}:
+		bytes, err := json.Marshal(typed)
+		if err != nil {
+			return fmt.Sprint(typed)
+		}
+		return string(bytes)
+	default:
+		return fmt.Sprint(typed)
+	}
+}
+
+func genericValue(value interface{}) cty.Value {
+	switch typed := value.(type) {
+	case nil:
+		return cty.NullVal(cty.DynamicPseudoType)
+	case string:
+		return cty.StringVal(typed)
+	case bool:
+		return cty.BoolVal(typed)
+	case int64:
+		return cty.NumberIntVal(typed)
+	case float64:
+		return cty.NumberFloatVal(typed)
+	case map[string]interface{}:
+		values := make(map[string]cty.Value, len(typed))
+		for key, item := range typed {
+			if item != nil {
+				values[key] = genericValue(item)
+			}
+		}
+		return cty.ObjectVal(values)
+	case []interface{}:
+		values := make([]cty.Value, 0, len(typed))
+		for _, item := range typed {
+			values = append(values, genericValue(item))
+		}
+		return cty.TupleVal(values)
+	default:
+		return cty.StringVal(fmt.Sprint(typed))
+	}
+}
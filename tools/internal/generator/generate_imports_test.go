@@ -0,0 +1,107 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package generator
+
+import (
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	"testing"
+)
+
+func Test_FindTemplateData(t *testing.T) {
+	deployment := &TemplateData{Group: "apps", Version: "v1", PluralKind: "deployments"}
+	configMap := &TemplateData{Group: "", Version: "v1", PluralKind: "configmaps"}
+	data := []*TemplateData{deployment, configMap}
+
+	assert.Equal(t, deployment, FindTemplateData(data, k8sSchema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}))
+	assert.Equal(t, configMap, FindTemplateData(data, k8sSchema.GroupVersionResource{Version: "v1", Resource: "configmaps"}))
+	assert.Nil(t, FindTemplateData(data, k8sSchema.GroupVersionResource{Group: "apps", Version: "v1beta1", Resource: "deployments"}))
+}
+
+func Test_GenerateImportBlocks(t *testing.T) {
+	resource := &TemplateData{
+		FullResourceTypeName: "k8s_apps_deployment_v1",
+		Namespaced:           true,
+		Properties: []*Property{
+			{
+				Name:                   "spec",
+				TerraformAttributeName: "spec",
+				TerraformAttributeType: "schema.SingleNestedAttribute",
+				Properties: []*Property{
+					{Name: "replicas", TerraformAttributeName: "replicas", TerraformAttributeType: "schema.Int64Attribute"},
+					{Name: "minReadySeconds", TerraformAttributeName: "min_ready_seconds", TerraformAttributeType: "schema.Int64Attribute"},
+					{
+						Name:                   "selector",
+						TerraformAttributeName: "selector",
+						TerraformAttributeType: "schema.SingleNestedAttribute",
+						Properties: []*Property{
+							{Name: "matchLabels", TerraformAttributeName: "match_labels", TerraformAttributeType: "schema.MapAttribute", TerraformElementType: "types.StringType"},
+						},
+					},
+				},
+			},
+		},
+	}
+	object := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "web",
+			"namespace": "default",
+			"uid":       "1234",
+			"annotations": map[string]interface{}{
+				"deployment.kubernetes.io/revision": "3",
+			},
+		},
+		"spec": map[string]interface{}{
+			"replicas":        int64(2),
+			"minReadySeconds": int64(5),
+			"selector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{"app": "web"},
+			},
+		},
+		"status": map[string]interface{}{
+			"replicas": int64(2),
+		},
+	}}
+
+	expected := `resource "k8s_apps_deployment_v1" "default_web" {
+  metadata = {
+    name      = "web"
+    namespace = "default"
+  }
+  spec = {
+    min_ready_seconds = 5
+    replicas          = 2
+    selector = {
+      match_labels = {
+        app = "web"
+      }
+    }
+  }
+}
+
+import {
+  to = k8s_apps_deployment_v1.default_web
+  id = "default/web"
+}
+`
+	assert.Equal(t, expected, string(GenerateImportBlocks(resource, []unstructured.Unstructured{object})))
+}
+
+func Test_resourceLabel(t *testing.T) {
+	named := func(namespace string, name string) unstructured.Unstructured {
+		object := unstructured.Unstructured{Object: map[string]interface{}{}}
+		object.SetNamespace(namespace)
+		object.SetName(name)
+		return object
+	}
+
+	assert.Equal(t, "default_web", resourceLabel(named("default", "web")))
+	assert.Equal(t, "system_node-critical", resourceLabel(named("", "system:node-critical")))
+	assert.Equal(t, "_1_example_com", resourceLabel(named("", "1.example.com")))
+}
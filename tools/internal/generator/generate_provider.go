@@ -8,6 +8,7 @@ package generator
 import (
 	"fmt"
 	"k8s.io/utils/strings/slices"
+	"sort"
 )
 
 func GenerateProvider(templatePath string, outputPath string, data []*TemplateData) {
@@ -17,6 +18,7 @@ func GenerateProvider(templatePath string, outputPath string, data []*TemplateDa
 	value := providerTemplateData{
 		Resources: data,
 		Packages:  uniquePackages(data),
+		Groups:    groupedResources(data),
 	}
 	dataSourcesTarget := fmt.Sprintf("%s/provider_data_sources.go", outputPath)
 	resourcesTarget := fmt.Sprintf("%s/provider_resources.go", outputPath)
@@ -36,7 +38,36 @@ func uniquePackages(data []*TemplateData) []string {
 	return packages
 }
 
+func groupedResources(data []*TemplateData) []*providerGroupData {
+	groups := make([]*providerGroupData, 0)
+	byName := make(map[string]*providerGroupData)
+	for _, d := range data {
+		name := d.Group
+		if name == "" {
+			name = "core"
+		}
+		group, exists := byName[name]
+		if !exists {
+			group = &providerGroupData{Name: name}
+			byName[name] = group
+			groups = append(groups, group)
+		}
+		group.Resources = append(group.Resources, d)
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].Name < groups[j].Name
+	})
+	return groups
+}
+
 type providerTemplateData struct {
 	Resources []*TemplateData
 	Packages  []string
+	Groups    []*providerGroupData
+}
+
+type providerGroupData struct {
+	// Name is the API group of the contained resources or 'core' for the legacy core group
+	Name      string
+	Resources []*TemplateData
 }
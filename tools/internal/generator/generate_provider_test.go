@@ -0,0 +1,31 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package generator
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_groupedResources(t *testing.T) {
+	data := []*TemplateData{
+		{Group: "apps", Kind: "Deployment"},
+		{Group: "", Kind: "ConfigMap"},
+		{Group: "apps", Kind: "StatefulSet"},
+		{Group: "cert-manager.io", Kind: "Certificate"},
+	}
+
+	groups := groupedResources(data)
+
+	if assert.Len(t, groups, 3) {
+		assert.Equal(t, "apps", groups[0].Name)
+		assert.Equal(t, []*TemplateData{data[0], data[2]}, groups[0].Resources)
+		assert.Equal(t, "cert-manager.io", groups[1].Name)
+		assert.Equal(t, []*TemplateData{data[3]}, groups[1].Resources)
+		assert.Equal(t, "core", groups[2].Name)
+		assert.Equal(t, []*TemplateData{data[1]}, groups[2].Resources)
+	}
+}
@@ -10,16 +10,22 @@ import (
 )
 
 func GenerateResources(templatePath string, outputPath string, data []*TemplateData) {
-	//resourceTemplate := ParseTemplates(
-	//	fmt.Sprintf("%s/resource.go.tmpl", templatePath),
-	//	fmt.Sprintf("%s/read_write_schema_attribute.go.tmpl", templatePath),
-	//	fmt.Sprintf("%s/json_attribute.go.tmpl", templatePath),
-	//)
+	resourceTemplate := ParseTemplates(
+		fmt.Sprintf("%s/resource.go.tmpl", templatePath),
+		fmt.Sprintf("%s/read_write_schema_attribute.go.tmpl", templatePath),
+		fmt.Sprintf("%s/read_only_schema_attribute.go.tmpl", templatePath),
+		fmt.Sprintf("%s/json_attribute.go.tmpl", templatePath),
+	)
 	//dataSourceTemplate := ParseTemplates(
 	//	fmt.Sprintf("%s/data_source.go.tmpl", templatePath),
 	//	fmt.Sprintf("%s/read_only_schema_attribute.go.tmpl", templatePath),
 	//	fmt.Sprintf("%s/json_attribute.go.tmpl", templatePath),
 	//)
+	listDataSourceTemplate := ParseTemplates(
+		fmt.Sprintf("%s/list_data_source.go.tmpl", templatePath),
+		fmt.Sprintf("%s/read_only_schema_attribute.go.tmpl", templatePath),
+		fmt.Sprintf("%s/json_attribute.go.tmpl", templatePath),
+	)
 	manifestTemplate := ParseTemplates(
 		fmt.Sprintf("%s/manifest.go.tmpl", templatePath),
 		fmt.Sprintf("%s/read_write_schema_attribute.go.tmpl", templatePath),
@@ -27,14 +33,17 @@ func GenerateResources(templatePath string, outputPath string, data []*TemplateD
 	)
 
 	for _, resource := range data {
-		//resourceTargetFile := fmt.Sprintf("%s/%s/%s", outputPath, resource.Package, resource.ResourceFile)
+		resourceTargetFile := fmt.Sprintf("%s/%s/%s", outputPath, resource.Package, resource.ResourceFile)
 		//dataSourceTargetFile := fmt.Sprintf("%s/%s/%s", outputPath, resource.Package, resource.DataSourceFile)
+		listDataSourceTargetFile := fmt.Sprintf("%s/%s/%s", outputPath, resource.Package, resource.ListDataSourceFile)
 		manifestTargetFile := fmt.Sprintf("%s/%s/%s", outputPath, resource.Package, resource.ManifestFile)
-		//resourceGeneratedFile := generateCode(resourceTargetFile, resourceTemplate, resource)
+		resourceGeneratedFile := generateCode(resourceTargetFile, resourceTemplate, resource)
 		//dataSourceGeneratedFile := generateCode(dataSourceTargetFile, dataSourceTemplate, resource)
+		listDataSourceGeneratedFile := generateCode(listDataSourceTargetFile, listDataSourceTemplate, resource)
 		manifestGeneratedFile := generateCode(manifestTargetFile, manifestTemplate, resource)
-		//formatCode(resourceGeneratedFile)
+		formatCode(resourceGeneratedFile)
 		//formatCode(dataSourceGeneratedFile)
+		formatCode(listDataSourceGeneratedFile)
 		formatCode(manifestGeneratedFile)
 	}
 }
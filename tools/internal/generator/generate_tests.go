@@ -10,19 +10,23 @@ import (
 )
 
 func GenerateTests(templatePath string, outputPath string, data []*TemplateData) {
-	//resourceTestTemplate := ParseTemplates(fmt.Sprintf("%s/resource_test.go.tmpl", templatePath))
+	resourceTestTemplate := ParseTemplates(fmt.Sprintf("%s/resource_test.go.tmpl", templatePath))
 	//dataSourceTestTemplate := ParseTemplates(fmt.Sprintf("%s/data_source_test.go.tmpl", templatePath))
+	listDataSourceTestTemplate := ParseTemplates(fmt.Sprintf("%s/list_data_source_test.go.tmpl", templatePath))
 	manifestTestTemplate := ParseTemplates(fmt.Sprintf("%s/manifest_test.go.tmpl", templatePath))
 
 	for _, resource := range data {
-		//resourceTargetFile := fmt.Sprintf("%s/%s/%s", outputPath, resource.Package, resource.ResourceTestFile)
+		resourceTargetFile := fmt.Sprintf("%s/%s/%s", outputPath, resource.Package, resource.ResourceTestFile)
 		//dataSourceTargetFile := fmt.Sprintf("%s/%s/%s", outputPath, resource.Package, resource.DataSourceTestFile)
+		listDataSourceTargetFile := fmt.Sprintf("%s/%s/%s", outputPath, resource.Package, resource.ListDataSourceTestFile)
 		manifestTargetFile := fmt.Sprintf("%s/%s/%s", outputPath, resource.Package, resource.ManifestTestFile)
-		//resourceGeneratedFile := generateCode(resourceTargetFile, resourceTestTemplate, resource)
+		resourceGeneratedFile := generateCode(resourceTargetFile, resourceTestTemplate, resource)
 		//dataSourceGeneratedFile := generateCode(dataSourceTargetFile, dataSourceTestTemplate, resource)
+		listDataSourceGeneratedFile := generateCode(listDataSourceTargetFile, listDataSourceTestTemplate, resource)
 		manifestGeneratedFile := generateCode(manifestTargetFile, manifestTestTemplate, resource)
-		//formatCode(resourceGeneratedFile)
+		formatCode(resourceGeneratedFile)
 		//formatCode(dataSourceGeneratedFile)
+		formatCode(listDataSourceGeneratedFile)
 		formatCode(manifestGeneratedFile)
 	}
 }
@@ -0,0 +1,155 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package generator
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"slices"
+	"strings"
+)
+
+var immutableAttributes = map[string][]string{
+	"apps_daemon_set_v1": {
+		"spec.selector",
+	},
+	"apps_deployment_v1": {
+		"spec.selector",
+	},
+	"apps_replica_set_v1": {
+		"spec.selector",
+	},
+	"apps_stateful_set_v1": {
+		"spec.podManagementPolicy",
+		"spec.selector",
+		"spec.serviceName",
+		"spec.volumeClaimTemplates",
+	},
+	"batch_job_v1": {
+		"spec.selector",
+		"spec.template",
+	},
+	"networking_k8s_io_ingress_class_v1": {
+		"spec.controller",
+	},
+	"persistent_volume_claim_v1": {
+		"spec.accessModes",
+		"spec.dataSource",
+		"spec.dataSourceRef",
+		"spec.selector",
+		"spec.storageClassName",
+		"spec.volumeMode",
+		"spec.volumeName",
+	},
+	"rbac_authorization_k8s_io_cluster_role_binding_v1": {
+		"roleRef",
+	},
+	"rbac_authorization_k8s_io_role_binding_v1": {
+		"roleRef",
+	},
+	"scheduling_k8s_io_priority_class_v1": {
+		"preemptionPolicy",
+		"value",
+	},
+	"secret_v1": {
+		"type",
+	},
+	"storage_k8s_io_storage_class_v1": {
+		"parameters",
+		"provisioner",
+		"reclaimPolicy",
+		"volumeBindingMode",
+	},
+	"storage_k8s_io_volume_attachment_v1": {
+		"spec",
+	},
+}
+
+func isImmutable(terraformResourceName string, propPath string) bool {
+	if immutable, ok := immutableAttributes[terraformResourceName]; ok {
+		return slices.Contains(immutable, propPath)
+	}
+	return false
+}
+
+// hasImmutableRule detects CEL validation rules which forbid any change of a property, e.g. 'self == oldSelf'.
+func hasImmutableRule(prop *apiextensionsv1.JSONSchemaProps) bool {
+	for _, validation := range prop.XValidations {
+		rule := strings.Join(strings.Fields(validation.Rule), "")
+		if rule == "self==oldSelf" || rule == "oldSelf==self" {
+			return true
+		}
+	}
+	return false
+}
+
+func requiresReplaceImports(attributeType string, imports *AdditionalImports) {
+	switch attributeType {
+	case "schema.BoolAttribute":
+		imports.BoolPlanModifier = true
+	case "schema.Int64Attribute":
+		imports.Int64PlanModifier = true
+	case "schema.Float64Attribute":
+		imports.Float64PlanModifier = true
+	case "schema.NumberAttribute":
+		imports.NumberPlanModifier = true
+	case "schema.MapAttribute":
+		imports.MapPlanModifier = true
+	case "schema.SingleNestedAttribute":
+		imports.ObjectPlanModifier = true
+	case "schema.ListAttribute", "schema.ListNestedAttribute":
+		imports.ListPlanModifier = true
+	}
+}
+
+func mapAttributeTypeToPlanModifiersType(attributeType string) string {
+	switch attributeType {
+	case "schema.BoolAttribute":
+		return "planmodifier.Bool"
+	case "schema.StringAttribute":
+		return "planmodifier.String"
+	case "schema.Int64Attribute":
+		return "planmodifier.Int64"
+	case "schema.Float64Attribute":
+		return "planmodifier.Float64"
+	case "schema.NumberAttribute":
+		return "planmodifier.Number"
+	case "schema.MapAttribute":
+		return "planmodifier.Map"
+	case "schema.SingleNestedAttribute":
+		return "planmodifier.Object"
+	case "schema.ListAttribute":
+		return "planmodifier.List"
+	case "schema.ListNestedAttribute":
+		return "planmodifier.List"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func mapAttributeTypeToPlanModifiersPackage(attributeType string) string {
+	switch attributeType {
+	case "schema.BoolAttribute":
+		return "boolplanmodifier"
+	case "schema.StringAttribute":
+		return "stringplanmodifier"
+	case "schema.Int64Attribute":
+		return "int64planmodifier"
+	case "schema.Float64Attribute":
+		return "float64planmodifier"
+	case "schema.NumberAttribute":
+		return "numberplanmodifier"
+	case "schema.MapAttribute":
+		return "mapplanmodifier"
+	case "schema.SingleNestedAttribute":
+		return "objectplanmodifier"
+	case "schema.ListAttribute":
+		return "listplanmodifier"
+	case "schema.ListNestedAttribute":
+		return "listplanmodifier"
+	default:
+		return "UNKNOWN"
+	}
+}
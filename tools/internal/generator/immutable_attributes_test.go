@@ -0,0 +1,40 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package generator
+
+import (
+	"github.com/stretchr/testify/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"testing"
+)
+
+func Test_isImmutable(t *testing.T) {
+	assert.True(t, isImmutable("apps_deployment_v1", "spec.selector"))
+	assert.True(t, isImmutable("storage_k8s_io_storage_class_v1", "provisioner"))
+	assert.False(t, isImmutable("apps_deployment_v1", "spec.replicas"))
+	assert.False(t, isImmutable("config_map_v1", "data"))
+}
+
+func Test_hasImmutableRule(t *testing.T) {
+	assert.False(t, hasImmutableRule(&apiextensionsv1.JSONSchemaProps{}))
+	assert.True(t, hasImmutableRule(&apiextensionsv1.JSONSchemaProps{
+		XValidations: apiextensionsv1.ValidationRules{{Rule: "self == oldSelf", Message: "Value is immutable"}},
+	}))
+	assert.True(t, hasImmutableRule(&apiextensionsv1.JSONSchemaProps{
+		XValidations: apiextensionsv1.ValidationRules{{Rule: "oldSelf==self"}},
+	}))
+	assert.False(t, hasImmutableRule(&apiextensionsv1.JSONSchemaProps{
+		XValidations: apiextensionsv1.ValidationRules{{Rule: "self.size() > 0"}},
+	}))
+}
+
+func Test_requiresReplaceImports(t *testing.T) {
+	imports := &AdditionalImports{}
+	requiresReplaceImports("schema.ListNestedAttribute", imports)
+	requiresReplaceImports("schema.SingleNestedAttribute", imports)
+	requiresReplaceImports("schema.StringAttribute", imports)
+	assert.Equal(t, &AdditionalImports{ListPlanModifier: true, ObjectPlanModifier: true}, imports)
+}
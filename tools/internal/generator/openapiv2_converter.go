@@ -7,6 +7,7 @@ package generator
 
 import (
 	"fmt"
+	"github.com/metio/terraform-provider-k8s/internal/naming"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	v2high "github.com/pb33f/libopenapi/datamodel/high/v2"
 	"k8s.io/utils/strings/slices"
@@ -47,6 +48,7 @@ func openAPIv2AsTemplateData(definition *base.SchemaProxy, namespaced bool) *Tem
 		return nil
 	}
 	schema := definition.Schema()
+	status := schema.Properties.GetOrZero("status")
 	// remove manually managed or otherwise ignored properties
 	schema.Properties.Delete("metadata")
 	schema.Properties.Delete("status")
@@ -55,6 +57,16 @@ func openAPIv2AsTemplateData(definition *base.SchemaProxy, namespaced bool) *Tem
 
 	imports := AdditionalImports{}
 	typeName := resourceTypeName(group, kind, version)
+	properties := openAPIv2Properties(schema, &imports, "", typeName)
+
+	var statusProperties []*Property
+	if status != nil {
+		// validators are not generated for read-only attributes, so only type imports are kept
+		statusImports := AdditionalImports{}
+		statusProperties = openAPIv2Properties(status.Schema(), &statusImports, "status", typeName)
+		imports.MathBig = imports.MathBig || statusImports.MathBig
+		imports.Normalized = imports.Normalized || statusImports.Normalized
+	}
 
 	return &TemplateData{
 		BT:          "`",
@@ -73,6 +85,7 @@ func openAPIv2AsTemplateData(definition *base.SchemaProxy, namespaced bool) *Tem
 		FullResourceTypeName: fmt.Sprintf("k8s_%s", typeName),
 		ResourceTypeStruct:   resourceTypeStruct(group, kind, version),
 		ResourceDataStruct:   resourceDataStruct(group, kind, version),
+		ResourceStatusStruct: resourceStatusStruct(group, kind, version),
 		ResourceTypeTest:     resourceTypeTest(group, kind, version),
 
 		DataSourceFile:         dataSourceFile(group, kind, version),
@@ -84,6 +97,14 @@ func openAPIv2AsTemplateData(definition *base.SchemaProxy, namespaced bool) *Tem
 		DataSourceDataStruct:   dataSourceDataStruct(group, kind, version),
 		DataSourceTypeTest:     dataSourceTypeTest(group, kind, version),
 
+		ListDataSourceFile:         listDataSourceFile(group, kind, version),
+		ListDataSourceTestFile:     listDataSourceTestFile(group, kind, version),
+		ListDataSourceTypeName:     fmt.Sprintf("%s_list", typeName),
+		FullListDataSourceTypeName: fmt.Sprintf("k8s_%s_list", typeName),
+		ListDataSourceTypeStruct:   listDataSourceTypeStruct(group, kind, version),
+		ListDataSourceDataStruct:   listDataSourceDataStruct(group, kind, version),
+		ListDataSourceTypeTest:     listDataSourceTypeTest(group, kind, version),
+
 		ManifestFile:         manifestFile(group, kind, version),
 		ManifestTestFile:     manifestTestFile(group, kind, version),
 		ManifestWorkflowFile: manifestWorkflowFile(group, kind, version),
@@ -96,7 +117,9 @@ func openAPIv2AsTemplateData(definition *base.SchemaProxy, namespaced bool) *Tem
 		TerraformModelType: terraformModelType(group, kind, version),
 
 		AdditionalImports: imports,
-		Properties:        openAPIv2Properties(schema, &imports, "", typeName),
+		Properties:        properties,
+		StatusProperties:  statusProperties,
+		Sensitive:         hasSensitiveProperties(properties),
 	}
 }
 
@@ -135,12 +158,17 @@ func openAPIv2Properties(schema *base.Schema, imports *AdditionalImports, path s
 					imports.MathBig = true
 				}
 
+				requiresReplace := isImmutable(terraformResourceName, propPath)
+				if requiresReplace {
+					requiresReplaceImports(attributeType, imports)
+				}
+
 				props = append(props, &Property{
 					BT:                     "`",
 					Name:                   name,
 					GoName:                 goName(name),
 					GoType:                 goType,
-					TerraformAttributeName: terraformAttributeName(name, path == ""),
+					TerraformAttributeName: naming.TerraformAttributeName(name, path == ""),
 					TerraformAttributeType: attributeType,
 					TerraformElementType:   elementType,
 					TerraformCustomType:    customType,
@@ -149,10 +177,14 @@ func openAPIv2Properties(schema *base.Schema, imports *AdditionalImports, path s
 					Required:               slices.Contains(schema.Required, name),
 					Optional:               !slices.Contains(schema.Required, name),
 					Computed:               false,
+					Sensitive:              isSensitive(terraformResourceName, propPath),
 					Properties:             nestedProperties,
 					ValidatorsType:         mapAttributeTypeToValidatorsType(attributeType),
 					ValidatorsPackage:      mapAttributeTypeToValidatorsPackage(attributeType),
 					Validators:             validators,
+					RequiresReplace:        requiresReplace,
+					PlanModifiersType:      mapAttributeTypeToPlanModifiersType(attributeType),
+					PlanModifiersPackage:   mapAttributeTypeToPlanModifiersPackage(attributeType),
 				})
 			}
 		}
@@ -0,0 +1,31 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package generator
+
+import "slices"
+
+var sensitiveAttributes = map[string][]string{
+	"secret_v1": {
+		"data",
+		"stringData",
+	},
+}
+
+func isSensitive(terraformResourceName string, propPath string) bool {
+	if sensitive, ok := sensitiveAttributes[terraformResourceName]; ok {
+		return slices.Contains(sensitive, propPath)
+	}
+	return false
+}
+
+func hasSensitiveProperties(props []*Property) bool {
+	for _, prop := range props {
+		if prop.Sensitive || hasSensitiveProperties(prop.Properties) {
+			return true
+		}
+	}
+	return false
+}
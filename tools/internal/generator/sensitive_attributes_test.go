@@ -0,0 +1,25 @@
+/*
+ * SPDX-FileCopyrightText: The terraform-provider-k8s Authors
+ * SPDX-License-Identifier: 0BSD
+ */
+
+package generator
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_isSensitive(t *testing.T) {
+	assert.True(t, isSensitive("secret_v1", "data"))
+	assert.True(t, isSensitive("secret_v1", "stringData"))
+	assert.False(t, isSensitive("secret_v1", "type"))
+	assert.False(t, isSensitive("config_map_v1", "data"))
+}
+
+func Test_hasSensitiveProperties(t *testing.T) {
+	assert.False(t, hasSensitiveProperties(nil))
+	assert.False(t, hasSensitiveProperties([]*Property{{Name: "type"}}))
+	assert.True(t, hasSensitiveProperties([]*Property{{Name: "type"}, {Name: "data", Sensitive: true}}))
+	assert.True(t, hasSensitiveProperties([]*Property{{Name: "spec", Properties: []*Property{{Name: "password", Sensitive: true}}}}))
+}